@@ -57,6 +57,36 @@ declare module "jungletv:chat" {
         messageID: string;
     }
 
+    /** Arguments to the 'messageedited' event */
+    export interface MessageEditedEventArgs extends EventArgs {
+        /** Guaranteed to be `messageedited`. */
+        type: "messageedited";
+
+        /** The message, with its new content. */
+        message: ChatMessage;
+    }
+
+    /** Arguments to the 'reactionupdated' event */
+    export interface ReactionUpdatedEventArgs extends EventArgs {
+        /** Guaranteed to be `reactionupdated`. */
+        type: "reactionupdated";
+
+        /** The ID of the message whose reactions changed. */
+        messageID: string;
+
+        /** The reaction that was added or removed: either an emoji or a chat emote tag. */
+        reaction: string;
+
+        /** Whether the reaction was added (true) or removed (false). */
+        added: boolean;
+
+        /** The number of users who reacted to the message with this {@link reaction}, after the change. */
+        count: number;
+
+        /** The user who added or removed the reaction. */
+        user: Author;
+    }
+
     /** A relation between event types and the arguments passed to the respective listeners */
     export interface ChatEventMap {
         /** This event is fired when the chat is enabled after having been disabled. */
@@ -70,6 +100,12 @@ declare module "jungletv:chat" {
 
         /** This event is fired when a chat message is deleted. */
         "messagedeleted": MessageDeletedEventArgs;
+
+        /** This event is fired when the author of a chat message edits it, even if that message is shadowbanned. */
+        "messageedited": MessageEditedEventArgs;
+
+        /** This event is fired when a user adds or removes a reaction to a chat message, even if that message is shadowbanned. */
+        "reactionupdated": ReactionUpdatedEventArgs;
     }
     /**
      * Registers a function to be called whenever the specified event occurs.
@@ -99,9 +135,11 @@ declare module "jungletv:chat" {
      * @param [referenceID] An optional string containing the ID of another message to which this one is a reply.
      * The message must not be a system message.
      * This message reference may be removed from the message at a later point, if the referenced message is deleted.
+     * @param [channelID] An optional string containing the ID of the chat channel in which to create the message.
+     * Defaults to the channel of the referenced message, if any, or to the `main` channel otherwise.
      * @returns A {@link ChatMessage} representing the created chat message.
      */
-    export function createMessage(content: string, referenceID?: string): ChatMessage;
+    export function createMessage(content: string, referenceID?: string, channelID?: string): ChatMessage;
 
     /**
      * Similar to {@link createMessage}, creates a new chat message including an application page as an attachment.
@@ -119,25 +157,65 @@ declare module "jungletv:chat" {
      * @param referenceID An optional string containing the ID of another message to which this one is a reply.
      * The message must not be a system message.
      * This message reference may be removed from the message at a later point, if the referenced message is deleted.
+     * @param [channelID] An optional string containing the ID of the chat channel in which to create the message.
+     * Defaults to the channel of the referenced message, if any, or to the `main` channel otherwise.
      * @returns A {@link ChatMessage} representing the created chat message.
      */
-    export function createMessageWithPageAttachment(content: string, pageID: string, height: number, referenceID?: string): ChatMessage;
+    export function createMessageWithPageAttachment(content: string, pageID: string, height: number, referenceID?: string, channelID?: string): ChatMessage;
+
+    /**
+     * Creates a new chat message containing a poll, which users can vote on until it closes.
+     * The message will appear as having been sent by the application, with the {@link nickname} that is currently set.
+     * Once the poll closes, its result is announced through a system message in the same chat channel.
+     * @param question A string containing the question of the poll, up to 200 characters long.
+     * @param options An array of between 2 and 10 strings containing the options of the poll, each up to 100 characters long.
+     * @param durationMs The number of milliseconds for which the poll will accept votes.
+     * Must be between 60000 (one minute) and 604800000 (seven days).
+     * @param [pointsPerVote] An optional number of points users must spend on each vote.
+     * When omitted or zero, each user can cast a single vote.
+     * When positive, users can cast as many votes as they want, on as many options as they want, spending points on each.
+     * @param [channelID] An optional string containing the ID of the chat channel in which to create the poll. Defaults to the `main` channel.
+     * @returns A {@link ChatMessage} representing the created chat message, which includes a {@link PollAttachment}.
+     */
+    export function createPoll(question: string, options: string[], durationMs: number, pointsPerVote?: number, channelID?: string): ChatMessage;
 
     /**
      * Creates a new chat message with the appearance of a system message (centered content within a rectangle, without an identified author), that is immediately sent to all connected chat clients and registered in the chat message history.
      * @param content A string containing the content of the message. The content will be parsed as {@link https://github.github.com/gfm/ | GitHub Flavored Markdown} by the JungleTV clients. Consider escaping any characters that may unintentionally constitute Markdown formatting. System message contents do not have an explicit length limit.
+     * @param [channelID] An optional string containing the ID of the chat channel in which to create the message. Defaults to the `main` channel.
      * @returns A {@link ChatMessage} representing the created chat message.
      */
-    export function createSystemMessage(content: string): ChatMessage;
+    export function createSystemMessage(content: string, channelID?: string): ChatMessage;
 
     /**
      * Retrieves chat messages created between two dates.
      * @param since A Date representing the start of the time range for which to retrieve chat messages.
      * @param until A Date representing the end of the time range for which to retrieve chat messages.
+     * @param [channelID] An optional string containing the ID of the chat channel from which to retrieve messages. Defaults to the `main` channel.
      * @returns An array of {@link ChatMessage} sent in the specified time range.
      * Shadowbanned messages are not included.
      */
-    export function getMessages(since: Date, until: Date): Promise<ChatMessage[]>;
+    export function getMessages(since: Date, until: Date, channelID?: string): Promise<ChatMessage[]>;
+
+    /**
+     * Temporarily prevents a user from participating in chat.
+     * Muted users can't send messages, edit messages, react to messages nor send direct messages.
+     * @param address The reward address of the user to mute.
+     * @param reason A string containing the reason for the mute, visible to the muted user and to moderators.
+     * @param [durationMs] An optional number of milliseconds for which the user should be muted.
+     * Must be one of 60000 (one minute), 600000 (ten minutes), 3600000 (one hour) or 86400000 (one day).
+     * When omitted, the duration is escalated according to how many times the user was muted in the past 30 days.
+     * @returns A Date representing when the mute will expire.
+     */
+    export function muteUser(address: string, reason: string, durationMs?: number): Date;
+
+    /**
+     * Lifts all chat mutes currently in effect for a user.
+     * @param address The reward address of the user to unmute.
+     * @param reason A string containing the reason for lifting the mute.
+     * @returns Whether the user was muted before the call.
+     */
+    export function unmuteUser(address: string, reason: string): boolean;
 
     /**
      * This writable property indicates whether the chat is enabled.
@@ -176,6 +254,12 @@ declare module "jungletv:chat" {
         /** Whether this message is shadowbanned, i.e. whether it should only be shown to its author. */
         shadowbanned: boolean;
 
+        /** When the message was last edited by its author. Not present if the message was never edited. */
+        editedAt?: Date;
+
+        /** The ID of the chat channel the message belongs to. */
+        channel: string;
+
         /** The author of the message, only present if the message has an author. Messages without an author are considered system messages. */
         author?: Author;
 
@@ -187,7 +271,19 @@ declare module "jungletv:chat" {
         reference?: Omit<Partial<ChatMessage> & { id: string, content: string }, "reference">;
 
         /** The list of message attachments. */
-        attachments: (TenorGifAttachment | AppPageAttachment)[];
+        attachments: (TenorGifAttachment | AppPageAttachment | PollAttachment)[];
+
+        /** The reactions to the message, ordered by when each reaction was first used. */
+        reactions: Reaction[];
+    }
+
+    /** Represents the aggregate of the reactions of a given kind to a {@link ChatMessage} */
+    export interface Reaction {
+        /** Either an emoji or a chat emote tag. */
+        reaction: string;
+
+        /** The number of users who reacted with this reaction. */
+        count: number;
     }
 
     /** Represents the author of a {@link ChatMessage} */
@@ -256,6 +352,46 @@ declare module "jungletv:chat" {
         /** The height of the application page in pixels as it would be displayed in the chat history. */
         height: number;
     }
+
+    /** Corresponds to an attached poll, e.g. as created using {@link createPoll} by this or other application. */
+    export interface PollAttachment extends Attachment {
+        /** Guaranteed to be `poll` for this type of attachment. */
+        type: "poll";
+
+        /** The ID of the poll. */
+        id: string;
+
+        /** The question of the poll. */
+        question: string;
+
+        /** The options of the poll, in the order they are presented to users, along with the number of votes cast on each. */
+        options: {
+            /** The text of the option. */
+            text: string;
+
+            /** The number of votes cast on this option. */
+            votes: number;
+        }[];
+
+        /**
+         * How votes are cast in this poll.
+         * In `single` polls, each user can cast a single vote.
+         * In `points` polls, users can cast as many votes as they want, spending {@link pointsPerVote} points on each.
+         */
+        votingMode: "single" | "points";
+
+        /** The number of points spent on each vote. Zero for `single` polls. */
+        pointsPerVote: number;
+
+        /** When the poll closes or closed. */
+        closesAt: Date;
+
+        /** Whether the poll is closed and no longer accepting votes. */
+        closed: boolean;
+
+        /** The total number of votes cast on all options. */
+        totalVotes: number;
+    }
 }
 
 /** Allows for serving application pages, which is web content that can be presented as stand-alone pages within the JungleTV website, or as part of the main JungleTV interface, with the help of the {@link "jungletv:configuration"} module. */
@@ -715,6 +851,7 @@ declare module "jungletv:points" {
         "skip_threshold_increase": {};
         "concealed_entry_enqueuing": ConcealedEntryEnqueuingExtraFields;
         "application_defined": ApplicationDefinedExtraFields;
+        "chat_poll_vote": ChatPollVoteExtraFields;
     }
 
     /** Extra object for the transaction type media_enqueued_reward */
@@ -759,6 +896,12 @@ declare module "jungletv:points" {
         media: string;
     }
 
+    /** Extra object for the transaction type chat_poll_vote */
+    export interface ChatPollVoteExtraFields {
+        /** The ID of the poll in which the votes were cast. */
+        poll: string;
+    }
+
     /** Extra object for the transaction type application_defined */
     export interface ApplicationDefinedExtraFields {
         /** The application that created the transaction. */
@@ -833,6 +976,63 @@ declare module "jungletv:configuration" {
     export function setSidebarTab(pageID?: string, beforeTabID?: string);
 }
 
+declare module "jungletv:settings" {
+    /** Value of an application setting, as declared in the `settings.json` application file */
+    export type SettingValue = string | number | boolean;
+
+    /** Arguments to a settings event */
+    export interface EventArgs {
+        type: keyof SettingsEventMap;
+    }
+
+    /** Arguments to the 'settingschanged' event */
+    export interface SettingsChangedEventArgs extends EventArgs {
+        /** Guaranteed to be `settingschanged`. */
+        type: "settingschanged";
+
+        /** The keys of the settings whose values were changed. */
+        keys: string[];
+    }
+
+    /** A relation between event types and the arguments passed to the respective listeners */
+    export interface SettingsEventMap {
+        /** This event is fired when an administrator changes the value of one or more settings of the application, or resets them to their default values. */
+        "settingschanged": SettingsChangedEventArgs;
+    }
+
+    /**
+     * Registers a function to be called whenever the specified event occurs.
+     * Depending on the event, the function may be invoked with arguments containing information about the event.
+     * Refer to the documentation about each event type for details.
+     * @param eventType A case-sensitive string representing the event to listen for.
+     * @param listener A function that will be called when an event of the specified type occurs.
+     */
+    export function addEventListener<K extends keyof SettingsEventMap>(eventType: K, listener: (this: unknown, args: SettingsEventMap[K]) => void): void;
+
+    /**
+     * Ceases calling a function previously registered with {@link Settings.addEventListener} whenever the specified event occurs.
+     * @param eventType A case-sensitive string corresponding to the event type from which to unsubscribe.
+     * @param listener The function previously passed to {@link Settings.addEventListener}, that should no longer be called whenever an event of the given {@param eventType} occurs.
+     */
+    export function removeEventListener<K extends keyof SettingsEventMap>(eventType: K, listener: (this: unknown, args: SettingsEventMap[K]) => void): void;
+
+    /**
+     * Returns the current value of an application setting.
+     * Settings are declared in the `settings.json` application file, which must contain a JSON array of setting definitions.
+     * Each definition has a `key`, a `type` (one of `string`, `number`, `integer` or `boolean`), a `default` value and, optionally, a `label`, a `description` and validation constraints (`minimum`, `maximum`, `minLength`, `maxLength`, `pattern` and `options`).
+     * Administrators can change the values of the settings while the application is running, without having to edit the application files.
+     * @param key The case-sensitive key of the setting, as declared in the `settings.json` application file.
+     * @returns The value set by an administrator or, if no valid value was set, the default value of the setting.
+     */
+    export function get(key: string): SettingValue;
+
+    /**
+     * Returns the current values of all the settings declared by the application.
+     * @returns An object whose keys are the keys of the settings and whose values are the respective current values.
+     */
+    export function getAll(): { [key: string]: SettingValue };
+}
+
 /**
  * Represents the permission level of the current user as provided by the client-side appbridge script.
  */
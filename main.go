@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"crypto/tls"
 	"encoding/base64"
@@ -16,6 +17,7 @@ import (
 	"net/http/pprof"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dyson/certman"
@@ -39,8 +41,10 @@ import (
 	"github.com/tnyim/jungletv/segcha/segchaproto"
 	"github.com/tnyim/jungletv/server"
 	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/appeditor"
 	"github.com/tnyim/jungletv/server/components/apprunner"
 	"github.com/tnyim/jungletv/server/components/configurationmanager"
+	"github.com/tnyim/jungletv/server/components/ipreputation"
 	"github.com/tnyim/jungletv/server/components/oauth"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/interceptors/version"
@@ -114,6 +118,14 @@ func main() {
 	}
 	mainLog.Println("Database opened")
 
+	if len(os.Args) > 1 && os.Args[1] == simulateRewardsCommand {
+		err = runRewardSimulation(ctx, os.Args[2:])
+		if err != nil {
+			mainLog.Fatalln(err)
+		}
+		return
+	}
+
 	statsClient, err := buildStatsClient()
 	if err != nil {
 		mainLog.Fatalln(err)
@@ -252,9 +264,75 @@ func main() {
 		ticketCheckPeriod = time.Duration(period) * time.Millisecond
 	}
 
+	ipReputationProviders := []ipreputation.Provider{}
 	ipCheckEndpoint, present := secrets.Get("ipCheckEndpoint")
 	if !present {
-		mainLog.Fatalln("IP check endpoint not present in keybox")
+		mainLog.Println("IP check endpoint not present in keybox, will only use local IP reputation providers")
+	} else {
+		ipReputationProviders = append(ipReputationProviders, ipreputation.NewEndpointProvider(apiLog, ipCheckEndpoint))
+	}
+
+	ipReputationWeights := make(map[string]float32)
+	var ipReputationCacheExpiration time.Duration
+	ipReputationKeybox, present := secrets.GetBox("ipReputation")
+	if present {
+		mmdbFiles, present := ipReputationKeybox.Get("mmdbFiles")
+		if present {
+			for _, mmdbFile := range strings.Split(mmdbFiles, ",") {
+				provider, err := ipreputation.NewMMDBProvider(strings.TrimSpace(mmdbFile))
+				if err != nil {
+					mainLog.Fatalln("error opening IP reputation MMDB file:", err)
+				}
+				ipReputationProviders = append(ipReputationProviders, provider)
+			}
+		}
+
+		cidrListFile, present := ipReputationKeybox.Get("cidrListFile")
+		if present {
+			provider, err := ipreputation.NewCIDRListProvider(cidrListFile)
+			if err != nil {
+				mainLog.Fatalln("error reading IP reputation CIDR list:", err)
+			}
+			ipReputationProviders = append(ipReputationProviders, provider)
+		}
+
+		weightsString, present := ipReputationKeybox.Get("providerWeights")
+		if present {
+			ipReputationWeights, err = ipreputation.ParseProviderWeights(weightsString)
+			if err != nil {
+				mainLog.Fatalln("invalid IP reputation provider weights:", err)
+			}
+		}
+
+		cacheExpirationString, present := ipReputationKeybox.Get("cacheExpiration")
+		if present {
+			ipReputationCacheExpiration, err = time.ParseDuration(cacheExpirationString)
+			if err != nil {
+				mainLog.Fatalln("invalid IP reputation cacheExpiration:", err)
+			}
+		}
+	}
+	if len(ipReputationProviders) == 0 {
+		mainLog.Fatalln("no IP reputation providers configured")
+	}
+
+	weightedIPReputationProviders := make([]ipreputation.WeightedProvider, len(ipReputationProviders))
+	ipReputationProviderNames := make(map[string]struct{})
+	for i, provider := range ipReputationProviders {
+		// weights are configured by name, so names must not be ambiguous
+		if _, present := ipReputationProviderNames[provider.Name()]; present {
+			mainLog.Fatalln("duplicate IP reputation provider name:", provider.Name())
+		}
+		ipReputationProviderNames[provider.Name()] = struct{}{}
+		weight, present := ipReputationWeights[provider.Name()]
+		if !present {
+			weight = 1
+		}
+		weightedIPReputationProviders[i] = ipreputation.WeightedProvider{
+			Provider: provider,
+			Weight:   weight,
+		}
+		mainLog.Printf("Using IP reputation provider %s with weight %.2f", provider.Name(), weight)
 	}
 
 	modLogWebhook, present := secrets.Get("modLogWebhook")
@@ -299,11 +377,56 @@ func main() {
 		mainLog.Fatalln("error building segcha image DB:", err)
 	}
 
+	var segchaFamilyWeights segcha.FamilyWeights
+	segchaFamilyWeightsString, present := segchaKeybox.Get("familyWeights")
+	if !present {
+		mainLog.Println("segcha puzzle family weights not present in keybox, all puzzle families will be used equally")
+	} else {
+		segchaFamilyWeights, err = segcha.ParseFamilyWeights(segchaFamilyWeightsString)
+		if err != nil {
+			mainLog.Fatalln("invalid segcha puzzle family weights:", err)
+		}
+	}
+
+	segchaPoolSize := 150
+	segchaPoolSizeString, present := segchaKeybox.Get("poolSize")
+	if present {
+		segchaPoolSize, err = strconv.Atoi(segchaPoolSizeString)
+		if err != nil || segchaPoolSize < 1 {
+			mainLog.Fatalln("invalid segcha poolSize:", segchaPoolSizeString)
+		}
+	}
+
+	segchaPoolMaxAge := 24 * time.Hour
+	segchaPoolMaxAgeString, present := segchaKeybox.Get("poolMaxAge")
+	if present {
+		segchaPoolMaxAge, err = time.ParseDuration(segchaPoolMaxAgeString)
+		if err != nil {
+			mainLog.Fatalln("invalid segcha poolMaxAge:", err)
+		}
+	}
+
+	segchaPoolFile, present := segchaKeybox.Get("poolFile")
+	if !present {
+		mainLog.Println("segcha pool file path not present in keybox, will not persist pre-generated challenges")
+	}
+
 	raffleSecretKey, present := secrets.Get("raffleSecretKey")
 	if !present {
 		mainLog.Fatalln("Raffle secret key not present in segcha keybox")
 	}
 
+	var spectatorSessionHashKey []byte
+	spectatorSessionHashKeyString, present := secrets.Get("spectatorSessionHashKey")
+	if present {
+		spectatorSessionHashKey, err = hex.DecodeString(spectatorSessionHashKeyString)
+		if err != nil || len(spectatorSessionHashKey) < 16 {
+			mainLog.Fatalln("invalid spectator session hash key")
+		}
+	} else {
+		mainLog.Println("Spectator session hash key not present in keybox, remote address hashes of spectator sessions will not be comparable across restarts")
+	}
+
 	oauthKeybox, present := secrets.GetBox("oauth")
 	if !present {
 		mainLog.Fatalln("OAuth keybox not present in keybox")
@@ -339,6 +462,33 @@ func main() {
 		mainLog.Fatalln("Cloudflare Turnstile Secret key not present in keybox")
 	}
 
+	// proof-of-work activity challenges stay disabled until clients are able to solve them
+	proofOfWorkChallengesEnabled := false
+	proofOfWorkChallengesEnabledString, present := secrets.Get("proofOfWorkChallengesEnabled")
+	if present {
+		proofOfWorkChallengesEnabled, err = strconv.ParseBool(proofOfWorkChallengesEnabledString)
+		if err != nil {
+			mainLog.Fatalln("invalid proofOfWorkChallengesEnabled:", err)
+		}
+	}
+
+	appBundleOptions := appeditor.BundleOptions{}
+	appBundlesKeybox, present := secrets.GetBox("applicationBundles")
+	if !present {
+		mainLog.Println("Application bundles keybox not present in keybox, will not be able to sign or import application bundles")
+	} else {
+		signingKeyStr, present := appBundlesKeybox.Get("signingKey")
+		if present {
+			signingKeySeed, err := hex.DecodeString(signingKeyStr)
+			if err != nil || len(signingKeySeed) != ed25519.SeedSize {
+				mainLog.Fatalln("invalid application bundle signing key")
+			}
+			appBundleOptions.SigningKey = ed25519.NewKeyFromSeed(signingKeySeed)
+		}
+		appBundleOptions.PublisherName, _ = appBundlesKeybox.Get("publisherName")
+		appBundleOptions.TrustedPublishersFile, _ = appBundlesKeybox.Get("trustedPublishersFile")
+	}
+
 	jwtManager = auth.NewJWTManager(jwtKey, map[auth.PermissionLevel]time.Duration{
 		auth.UserPermissionLevel:      180 * 24 * time.Hour,
 		auth.AppEditorPermissionLevel: 7 * 24 * time.Hour,
@@ -368,11 +518,17 @@ func main() {
 		JWTManager:                    jwtManager,
 		AuthInterceptor:               authInterceptor,
 		TicketCheckPeriod:             ticketCheckPeriod,
-		IPCheckEndpoint:               ipCheckEndpoint,
+		IPReputationProviders:         weightedIPReputationProviders,
+		IPReputationCacheExpiration:   ipReputationCacheExpiration,
 		YoutubeAPIkey:                 youtubeAPIkey,
 		RaffleSecretKey:               raffleSecretKey,
+		SpectatorSessionHashKey:       spectatorSessionHashKey,
 		ModLogWebhook:                 modLogWebhook,
 		SegchaClient:                  segchaClient,
+		SegchaFamilyWeights:           segchaFamilyWeights,
+		SegchaPoolSize:                segchaPoolSize,
+		SegchaPoolMaxAge:              segchaPoolMaxAge,
+		SegchaPoolFile:                segchaPoolFile,
 		CaptchaImageDB:                imageDB,
 		CaptchaFontPath:               segchaFontPath,
 		AutoEnqueueVideoListFile:      autoEnqueueVideoListFile,
@@ -384,6 +540,7 @@ func main() {
 		OAuthManager:                  oauthManager,
 		NanswapAPIKey:                 nanswapAPIKey,
 		TurnstileSecretKey:            turnstileSecretKey,
+		ProofOfWorkChallengesEnabled:  proofOfWorkChallengesEnabled,
 		ConfigManager:                 configManager,
 		AppRunner:                     apprunner.New(ctx, apiLog, configManager, appWalletBuilder),
 	}
@@ -31,6 +31,19 @@ type JungleTVClient interface {
 	ProduceSegchaChallenge(ctx context.Context, in *ProduceSegchaChallengeRequest, opts ...grpc.CallOption) (*ProduceSegchaChallengeResponse, error)
 	ConsumeChat(ctx context.Context, in *ConsumeChatRequest, opts ...grpc.CallOption) (JungleTV_ConsumeChatClient, error)
 	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
+	EditChatMessage(ctx context.Context, in *EditChatMessageRequest, opts ...grpc.CallOption) (*EditChatMessageResponse, error)
+	ChatMessageThread(ctx context.Context, in *ChatMessageThreadRequest, opts ...grpc.CallOption) (*ChatMessageThreadResponse, error)
+	ChatChannels(ctx context.Context, in *ChatChannelsRequest, opts ...grpc.CallOption) (*ChatChannelsResponse, error)
+	MarkChatRepliesAsRead(ctx context.Context, in *MarkChatRepliesAsReadRequest, opts ...grpc.CallOption) (*MarkChatRepliesAsReadResponse, error)
+	ConsumeDirectMessages(ctx context.Context, in *ConsumeDirectMessagesRequest, opts ...grpc.CallOption) (JungleTV_ConsumeDirectMessagesClient, error)
+	SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*SendDirectMessageResponse, error)
+	DirectMessageConversation(ctx context.Context, in *DirectMessageConversationRequest, opts ...grpc.CallOption) (*DirectMessageConversationResponse, error)
+	SetDirectMessagesEnabled(ctx context.Context, in *SetDirectMessagesEnabledRequest, opts ...grpc.CallOption) (*SetDirectMessagesEnabledResponse, error)
+	ReportDirectMessageConversation(ctx context.Context, in *ReportDirectMessageConversationRequest, opts ...grpc.CallOption) (*ReportDirectMessageConversationResponse, error)
+	AddChatMessageReaction(ctx context.Context, in *AddChatMessageReactionRequest, opts ...grpc.CallOption) (*AddChatMessageReactionResponse, error)
+	RemoveChatMessageReaction(ctx context.Context, in *RemoveChatMessageReactionRequest, opts ...grpc.CallOption) (*RemoveChatMessageReactionResponse, error)
+	ReportChatMessage(ctx context.Context, in *ReportChatMessageRequest, opts ...grpc.CallOption) (*ReportChatMessageResponse, error)
+	VoteInChatPoll(ctx context.Context, in *VoteInChatPollRequest, opts ...grpc.CallOption) (*VoteInChatPollResponse, error)
 	UserPermissionLevel(ctx context.Context, in *UserPermissionLevelRequest, opts ...grpc.CallOption) (*UserPermissionLevelResponse, error)
 	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*Document, error)
 	SetChatNickname(ctx context.Context, in *SetChatNicknameRequest, opts ...grpc.CallOption) (*SetChatNicknameResponse, error)
@@ -73,10 +86,19 @@ type JungleTVClient interface {
 	UserBans(ctx context.Context, in *UserBansRequest, opts ...grpc.CallOption) (*UserBansResponse, error)
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserResponse, error)
 	RemoveBan(ctx context.Context, in *RemoveBanRequest, opts ...grpc.CallOption) (*RemoveBanResponse, error)
+	ChatMutes(ctx context.Context, in *ChatMutesRequest, opts ...grpc.CallOption) (*ChatMutesResponse, error)
+	MuteChatUser(ctx context.Context, in *MuteChatUserRequest, opts ...grpc.CallOption) (*MuteChatUserResponse, error)
+	UnmuteChatUser(ctx context.Context, in *UnmuteChatUserRequest, opts ...grpc.CallOption) (*UnmuteChatUserResponse, error)
+	ResolveChatMessageReports(ctx context.Context, in *ResolveChatMessageReportsRequest, opts ...grpc.CallOption) (*ResolveChatMessageReportsResponse, error)
+	CreateChatPoll(ctx context.Context, in *CreateChatPollRequest, opts ...grpc.CallOption) (*CreateChatPollResponse, error)
 	UserVerifications(ctx context.Context, in *UserVerificationsRequest, opts ...grpc.CallOption) (*UserVerificationsResponse, error)
 	VerifyUser(ctx context.Context, in *VerifyUserRequest, opts ...grpc.CallOption) (*VerifyUserResponse, error)
 	RemoveUserVerification(ctx context.Context, in *RemoveUserVerificationRequest, opts ...grpc.CallOption) (*RemoveUserVerificationResponse, error)
 	UserChatMessages(ctx context.Context, in *UserChatMessagesRequest, opts ...grpc.CallOption) (*UserChatMessagesResponse, error)
+	SearchChatMessages(ctx context.Context, in *SearchChatMessagesRequest, opts ...grpc.CallOption) (*SearchChatMessagesResponse, error)
+	DirectMessageReports(ctx context.Context, in *DirectMessageReportsRequest, opts ...grpc.CallOption) (*DirectMessageReportsResponse, error)
+	ReportedDirectMessageConversation(ctx context.Context, in *ReportedDirectMessageConversationRequest, opts ...grpc.CallOption) (*ReportedDirectMessageConversationResponse, error)
+	ChatMessageContext(ctx context.Context, in *ChatMessageContextRequest, opts ...grpc.CallOption) (*ChatMessageContextResponse, error)
 	DisallowedMedia(ctx context.Context, in *DisallowedMediaRequest, opts ...grpc.CallOption) (*DisallowedMediaResponse, error)
 	AddDisallowedMedia(ctx context.Context, in *AddDisallowedMediaRequest, opts ...grpc.CallOption) (*AddDisallowedMediaResponse, error)
 	RemoveDisallowedMedia(ctx context.Context, in *RemoveDisallowedMediaRequest, opts ...grpc.CallOption) (*RemoveDisallowedMediaResponse, error)
@@ -90,6 +112,13 @@ type JungleTVClient interface {
 	SetMinimumPricesMultiplier(ctx context.Context, in *SetMinimumPricesMultiplierRequest, opts ...grpc.CallOption) (*SetMinimumPricesMultiplierResponse, error)
 	SetCrowdfundedSkippingEnabled(ctx context.Context, in *SetCrowdfundedSkippingEnabledRequest, opts ...grpc.CallOption) (*SetCrowdfundedSkippingEnabledResponse, error)
 	SetSkipPriceMultiplier(ctx context.Context, in *SetSkipPriceMultiplierRequest, opts ...grpc.CallOption) (*SetSkipPriceMultiplierResponse, error)
+	SetRewardDistributionPolicy(ctx context.Context, in *SetRewardDistributionPolicyRequest, opts ...grpc.CallOption) (*SetRewardDistributionPolicyResponse, error)
+	SimulateRewardDistribution(ctx context.Context, in *SimulateRewardDistributionRequest, opts ...grpc.CallOption) (*SimulateRewardDistributionResponse, error)
+	SetActivityChallengeSelectionPolicy(ctx context.Context, in *SetActivityChallengeSelectionPolicyRequest, opts ...grpc.CallOption) (*SetActivityChallengeSelectionPolicyResponse, error)
+	SegchaPuzzleStatistics(ctx context.Context, in *SegchaPuzzleStatisticsRequest, opts ...grpc.CallOption) (*SegchaPuzzleStatisticsResponse, error)
+	SetSegchaAdaptiveDifficultyEnabled(ctx context.Context, in *SetSegchaAdaptiveDifficultyEnabledRequest, opts ...grpc.CallOption) (*SetSegchaAdaptiveDifficultyEnabledResponse, error)
+	SpectatorActivity(ctx context.Context, in *SpectatorActivityRequest, opts ...grpc.CallOption) (*SpectatorActivityResponse, error)
+	SpectatorRetention(ctx context.Context, in *SpectatorRetentionRequest, opts ...grpc.CallOption) (*SpectatorRetentionResponse, error)
 	ConfirmRaffleWinner(ctx context.Context, in *ConfirmRaffleWinnerRequest, opts ...grpc.CallOption) (*ConfirmRaffleWinnerResponse, error)
 	CompleteRaffle(ctx context.Context, in *CompleteRaffleRequest, opts ...grpc.CallOption) (*CompleteRaffleResponse, error)
 	RedrawRaffle(ctx context.Context, in *RedrawRaffleRequest, opts ...grpc.CallOption) (*RedrawRaffleResponse, error)
@@ -112,6 +141,11 @@ type JungleTVClient interface {
 	TriggerClientReload(ctx context.Context, in *TriggerClientReloadRequest, opts ...grpc.CallOption) (*TriggerClientReloadResponse, error)
 	SetMulticurrencyPaymentsEnabled(ctx context.Context, in *SetMulticurrencyPaymentsEnabledRequest, opts ...grpc.CallOption) (*SetMulticurrencyPaymentsEnabledResponse, error)
 	InvalidateUserAuthTokens(ctx context.Context, in *InvalidateUserAuthTokensRequest, opts ...grpc.CallOption) (*InvalidateUserAuthTokensResponse, error)
+	ChatFilterRules(ctx context.Context, in *ChatFilterRulesRequest, opts ...grpc.CallOption) (*ChatFilterRulesResponse, error)
+	SetChatFilterRule(ctx context.Context, in *ChatFilterRule, opts ...grpc.CallOption) (*SetChatFilterRuleResponse, error)
+	RemoveChatFilterRule(ctx context.Context, in *RemoveChatFilterRuleRequest, opts ...grpc.CallOption) (*RemoveChatFilterRuleResponse, error)
+	SetChatChannel(ctx context.Context, in *ChatChannel, opts ...grpc.CallOption) (*SetChatChannelResponse, error)
+	RemoveChatChannel(ctx context.Context, in *RemoveChatChannelRequest, opts ...grpc.CallOption) (*RemoveChatChannelResponse, error)
 	// application editor endpoints
 	Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error)
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error)
@@ -131,7 +165,17 @@ type JungleTVClient interface {
 	EvaluateExpressionOnApplication(ctx context.Context, in *EvaluateExpressionOnApplicationRequest, opts ...grpc.CallOption) (*EvaluateExpressionOnApplicationResponse, error)
 	ExportApplication(ctx context.Context, in *ExportApplicationRequest, opts ...grpc.CallOption) (*ExportApplicationResponse, error)
 	ImportApplication(ctx context.Context, in *ImportApplicationRequest, opts ...grpc.CallOption) (*ImportApplicationResponse, error)
+	ExportApplicationBundle(ctx context.Context, in *ExportApplicationBundleRequest, opts ...grpc.CallOption) (*ExportApplicationBundleResponse, error)
+	PreviewApplicationBundleImport(ctx context.Context, in *PreviewApplicationBundleImportRequest, opts ...grpc.CallOption) (*PreviewApplicationBundleImportResponse, error)
+	ImportApplicationBundle(ctx context.Context, in *ImportApplicationBundleRequest, opts ...grpc.CallOption) (*ImportApplicationBundleResponse, error)
 	TypeScriptTypeDefinitions(ctx context.Context, in *TypeScriptTypeDefinitionsRequest, opts ...grpc.CallOption) (*TypeScriptTypeDefinitionsResponse, error)
+	DebugApplication(ctx context.Context, in *DebugApplicationRequest, opts ...grpc.CallOption) (JungleTV_DebugApplicationClient, error)
+	ApplicationDebuggerCommand(ctx context.Context, in *ApplicationDebuggerCommandRequest, opts ...grpc.CallOption) (*ApplicationDebuggerCommandResponse, error)
+	StartApplicationProfiler(ctx context.Context, in *StartApplicationProfilerRequest, opts ...grpc.CallOption) (*StartApplicationProfilerResponse, error)
+	StopApplicationProfiler(ctx context.Context, in *StopApplicationProfilerRequest, opts ...grpc.CallOption) (*StopApplicationProfilerResponse, error)
+	ApplicationHeapSnapshot(ctx context.Context, in *ApplicationHeapSnapshotRequest, opts ...grpc.CallOption) (*ApplicationHeapSnapshotResponse, error)
+	ApplicationSettings(ctx context.Context, in *ApplicationSettingsRequest, opts ...grpc.CallOption) (*ApplicationSettingsResponse, error)
+	UpdateApplicationSetting(ctx context.Context, in *UpdateApplicationSettingRequest, opts ...grpc.CallOption) (*UpdateApplicationSettingResponse, error)
 	// application runtime endpoints
 	ResolveApplicationPage(ctx context.Context, in *ResolveApplicationPageRequest, opts ...grpc.CallOption) (*ResolveApplicationPageResponse, error)
 	ConsumeApplicationEvents(ctx context.Context, in *ConsumeApplicationEventsRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationEventsClient, error)
@@ -402,6 +446,146 @@ func (c *jungleTVClient) SendChatMessage(ctx context.Context, in *SendChatMessag
 	return out, nil
 }
 
+func (c *jungleTVClient) EditChatMessage(ctx context.Context, in *EditChatMessageRequest, opts ...grpc.CallOption) (*EditChatMessageResponse, error) {
+	out := new(EditChatMessageResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/EditChatMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ChatMessageThread(ctx context.Context, in *ChatMessageThreadRequest, opts ...grpc.CallOption) (*ChatMessageThreadResponse, error) {
+	out := new(ChatMessageThreadResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ChatMessageThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ChatChannels(ctx context.Context, in *ChatChannelsRequest, opts ...grpc.CallOption) (*ChatChannelsResponse, error) {
+	out := new(ChatChannelsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ChatChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) MarkChatRepliesAsRead(ctx context.Context, in *MarkChatRepliesAsReadRequest, opts ...grpc.CallOption) (*MarkChatRepliesAsReadResponse, error) {
+	out := new(MarkChatRepliesAsReadResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/MarkChatRepliesAsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ConsumeDirectMessages(ctx context.Context, in *ConsumeDirectMessagesRequest, opts ...grpc.CallOption) (JungleTV_ConsumeDirectMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[6], "/jungletv.JungleTV/ConsumeDirectMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVConsumeDirectMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JungleTV_ConsumeDirectMessagesClient interface {
+	Recv() (*DirectMessagesUpdate, error)
+	grpc.ClientStream
+}
+
+type jungleTVConsumeDirectMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *jungleTVConsumeDirectMessagesClient) Recv() (*DirectMessagesUpdate, error) {
+	m := new(DirectMessagesUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jungleTVClient) SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*SendDirectMessageResponse, error) {
+	out := new(SendDirectMessageResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SendDirectMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) DirectMessageConversation(ctx context.Context, in *DirectMessageConversationRequest, opts ...grpc.CallOption) (*DirectMessageConversationResponse, error) {
+	out := new(DirectMessageConversationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DirectMessageConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetDirectMessagesEnabled(ctx context.Context, in *SetDirectMessagesEnabledRequest, opts ...grpc.CallOption) (*SetDirectMessagesEnabledResponse, error) {
+	out := new(SetDirectMessagesEnabledResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetDirectMessagesEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ReportDirectMessageConversation(ctx context.Context, in *ReportDirectMessageConversationRequest, opts ...grpc.CallOption) (*ReportDirectMessageConversationResponse, error) {
+	out := new(ReportDirectMessageConversationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ReportDirectMessageConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) AddChatMessageReaction(ctx context.Context, in *AddChatMessageReactionRequest, opts ...grpc.CallOption) (*AddChatMessageReactionResponse, error) {
+	out := new(AddChatMessageReactionResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/AddChatMessageReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RemoveChatMessageReaction(ctx context.Context, in *RemoveChatMessageReactionRequest, opts ...grpc.CallOption) (*RemoveChatMessageReactionResponse, error) {
+	out := new(RemoveChatMessageReactionResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveChatMessageReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ReportChatMessage(ctx context.Context, in *ReportChatMessageRequest, opts ...grpc.CallOption) (*ReportChatMessageResponse, error) {
+	out := new(ReportChatMessageResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ReportChatMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) VoteInChatPoll(ctx context.Context, in *VoteInChatPollRequest, opts ...grpc.CallOption) (*VoteInChatPollResponse, error) {
+	out := new(VoteInChatPollResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/VoteInChatPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) UserPermissionLevel(ctx context.Context, in *UserPermissionLevelRequest, opts ...grpc.CallOption) (*UserPermissionLevelResponse, error) {
 	out := new(UserPermissionLevelResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UserPermissionLevel", in, out, opts...)
@@ -610,7 +794,7 @@ func (c *jungleTVClient) ChatGifSearch(ctx context.Context, in *ChatGifSearchReq
 }
 
 func (c *jungleTVClient) ConvertBananoToPoints(ctx context.Context, in *ConvertBananoToPointsRequest, opts ...grpc.CallOption) (JungleTV_ConvertBananoToPointsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[6], "/jungletv.JungleTV/ConvertBananoToPoints", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[7], "/jungletv.JungleTV/ConvertBananoToPoints", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -678,7 +862,7 @@ func (c *jungleTVClient) CheckMediaEnqueuingPassword(ctx context.Context, in *Ch
 }
 
 func (c *jungleTVClient) MonitorMediaEnqueuingPermission(ctx context.Context, in *MonitorMediaEnqueuingPermissionRequest, opts ...grpc.CallOption) (JungleTV_MonitorMediaEnqueuingPermissionClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[7], "/jungletv.JungleTV/MonitorMediaEnqueuingPermission", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[8], "/jungletv.JungleTV/MonitorMediaEnqueuingPermission", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -719,7 +903,7 @@ func (c *jungleTVClient) InvalidateAuthTokens(ctx context.Context, in *Invalidat
 }
 
 func (c *jungleTVClient) AuthorizeApplication(ctx context.Context, in *AuthorizeApplicationRequest, opts ...grpc.CallOption) (JungleTV_AuthorizeApplicationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[8], "/jungletv.JungleTV/AuthorizeApplication", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[9], "/jungletv.JungleTV/AuthorizeApplication", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -840,6 +1024,51 @@ func (c *jungleTVClient) RemoveBan(ctx context.Context, in *RemoveBanRequest, op
 	return out, nil
 }
 
+func (c *jungleTVClient) ChatMutes(ctx context.Context, in *ChatMutesRequest, opts ...grpc.CallOption) (*ChatMutesResponse, error) {
+	out := new(ChatMutesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ChatMutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) MuteChatUser(ctx context.Context, in *MuteChatUserRequest, opts ...grpc.CallOption) (*MuteChatUserResponse, error) {
+	out := new(MuteChatUserResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/MuteChatUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UnmuteChatUser(ctx context.Context, in *UnmuteChatUserRequest, opts ...grpc.CallOption) (*UnmuteChatUserResponse, error) {
+	out := new(UnmuteChatUserResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UnmuteChatUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ResolveChatMessageReports(ctx context.Context, in *ResolveChatMessageReportsRequest, opts ...grpc.CallOption) (*ResolveChatMessageReportsResponse, error) {
+	out := new(ResolveChatMessageReportsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ResolveChatMessageReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) CreateChatPoll(ctx context.Context, in *CreateChatPollRequest, opts ...grpc.CallOption) (*CreateChatPollResponse, error) {
+	out := new(CreateChatPollResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/CreateChatPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) UserVerifications(ctx context.Context, in *UserVerificationsRequest, opts ...grpc.CallOption) (*UserVerificationsResponse, error) {
 	out := new(UserVerificationsResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UserVerifications", in, out, opts...)
@@ -876,6 +1105,42 @@ func (c *jungleTVClient) UserChatMessages(ctx context.Context, in *UserChatMessa
 	return out, nil
 }
 
+func (c *jungleTVClient) SearchChatMessages(ctx context.Context, in *SearchChatMessagesRequest, opts ...grpc.CallOption) (*SearchChatMessagesResponse, error) {
+	out := new(SearchChatMessagesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SearchChatMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) DirectMessageReports(ctx context.Context, in *DirectMessageReportsRequest, opts ...grpc.CallOption) (*DirectMessageReportsResponse, error) {
+	out := new(DirectMessageReportsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DirectMessageReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ReportedDirectMessageConversation(ctx context.Context, in *ReportedDirectMessageConversationRequest, opts ...grpc.CallOption) (*ReportedDirectMessageConversationResponse, error) {
+	out := new(ReportedDirectMessageConversationResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ReportedDirectMessageConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ChatMessageContext(ctx context.Context, in *ChatMessageContextRequest, opts ...grpc.CallOption) (*ChatMessageContextResponse, error) {
+	out := new(ChatMessageContextResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ChatMessageContext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) DisallowedMedia(ctx context.Context, in *DisallowedMediaRequest, opts ...grpc.CallOption) (*DisallowedMediaResponse, error) {
 	out := new(DisallowedMediaResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/DisallowedMedia", in, out, opts...)
@@ -993,6 +1258,69 @@ func (c *jungleTVClient) SetSkipPriceMultiplier(ctx context.Context, in *SetSkip
 	return out, nil
 }
 
+func (c *jungleTVClient) SetRewardDistributionPolicy(ctx context.Context, in *SetRewardDistributionPolicyRequest, opts ...grpc.CallOption) (*SetRewardDistributionPolicyResponse, error) {
+	out := new(SetRewardDistributionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetRewardDistributionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SimulateRewardDistribution(ctx context.Context, in *SimulateRewardDistributionRequest, opts ...grpc.CallOption) (*SimulateRewardDistributionResponse, error) {
+	out := new(SimulateRewardDistributionResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SimulateRewardDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetActivityChallengeSelectionPolicy(ctx context.Context, in *SetActivityChallengeSelectionPolicyRequest, opts ...grpc.CallOption) (*SetActivityChallengeSelectionPolicyResponse, error) {
+	out := new(SetActivityChallengeSelectionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetActivityChallengeSelectionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SegchaPuzzleStatistics(ctx context.Context, in *SegchaPuzzleStatisticsRequest, opts ...grpc.CallOption) (*SegchaPuzzleStatisticsResponse, error) {
+	out := new(SegchaPuzzleStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SegchaPuzzleStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetSegchaAdaptiveDifficultyEnabled(ctx context.Context, in *SetSegchaAdaptiveDifficultyEnabledRequest, opts ...grpc.CallOption) (*SetSegchaAdaptiveDifficultyEnabledResponse, error) {
+	out := new(SetSegchaAdaptiveDifficultyEnabledResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetSegchaAdaptiveDifficultyEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SpectatorActivity(ctx context.Context, in *SpectatorActivityRequest, opts ...grpc.CallOption) (*SpectatorActivityResponse, error) {
+	out := new(SpectatorActivityResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SpectatorActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SpectatorRetention(ctx context.Context, in *SpectatorRetentionRequest, opts ...grpc.CallOption) (*SpectatorRetentionResponse, error) {
+	out := new(SpectatorRetentionResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SpectatorRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) ConfirmRaffleWinner(ctx context.Context, in *ConfirmRaffleWinnerRequest, opts ...grpc.CallOption) (*ConfirmRaffleWinnerResponse, error) {
 	out := new(ConfirmRaffleWinnerResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ConfirmRaffleWinner", in, out, opts...)
@@ -1048,7 +1376,7 @@ func (c *jungleTVClient) ResetSpectatorStatus(ctx context.Context, in *ResetSpec
 }
 
 func (c *jungleTVClient) MonitorModerationStatus(ctx context.Context, in *MonitorModerationStatusRequest, opts ...grpc.CallOption) (JungleTV_MonitorModerationStatusClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[9], "/jungletv.JungleTV/MonitorModerationStatus", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[10], "/jungletv.JungleTV/MonitorModerationStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1214,6 +1542,51 @@ func (c *jungleTVClient) InvalidateUserAuthTokens(ctx context.Context, in *Inval
 	return out, nil
 }
 
+func (c *jungleTVClient) ChatFilterRules(ctx context.Context, in *ChatFilterRulesRequest, opts ...grpc.CallOption) (*ChatFilterRulesResponse, error) {
+	out := new(ChatFilterRulesResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ChatFilterRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetChatFilterRule(ctx context.Context, in *ChatFilterRule, opts ...grpc.CallOption) (*SetChatFilterRuleResponse, error) {
+	out := new(SetChatFilterRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetChatFilterRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RemoveChatFilterRule(ctx context.Context, in *RemoveChatFilterRuleRequest, opts ...grpc.CallOption) (*RemoveChatFilterRuleResponse, error) {
+	out := new(RemoveChatFilterRuleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveChatFilterRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) SetChatChannel(ctx context.Context, in *ChatChannel, opts ...grpc.CallOption) (*SetChatChannelResponse, error) {
+	out := new(SetChatChannelResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/SetChatChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) RemoveChatChannel(ctx context.Context, in *RemoveChatChannelRequest, opts ...grpc.CallOption) (*RemoveChatChannelResponse, error) {
+	out := new(RemoveChatChannelResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/RemoveChatChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jungleTVClient) Applications(ctx context.Context, in *ApplicationsRequest, opts ...grpc.CallOption) (*ApplicationsResponse, error) {
 	out := new(ApplicationsResponse)
 	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/Applications", in, out, opts...)
@@ -1332,7 +1705,7 @@ func (c *jungleTVClient) ApplicationLog(ctx context.Context, in *ApplicationLogR
 }
 
 func (c *jungleTVClient) ConsumeApplicationLog(ctx context.Context, in *ConsumeApplicationLogRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[10], "/jungletv.JungleTV/ConsumeApplicationLog", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[11], "/jungletv.JungleTV/ConsumeApplicationLog", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1364,7 +1737,7 @@ func (x *jungleTVConsumeApplicationLogClient) Recv() (*ApplicationLogEntryContai
 }
 
 func (c *jungleTVClient) MonitorRunningApplications(ctx context.Context, in *MonitorRunningApplicationsRequest, opts ...grpc.CallOption) (JungleTV_MonitorRunningApplicationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[11], "/jungletv.JungleTV/MonitorRunningApplications", opts...)
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[12], "/jungletv.JungleTV/MonitorRunningApplications", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1422,30 +1795,48 @@ func (c *jungleTVClient) ImportApplication(ctx context.Context, in *ImportApplic
 	return out, nil
 }
 
-func (c *jungleTVClient) TypeScriptTypeDefinitions(ctx context.Context, in *TypeScriptTypeDefinitionsRequest, opts ...grpc.CallOption) (*TypeScriptTypeDefinitionsResponse, error) {
-	out := new(TypeScriptTypeDefinitionsResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TypeScriptTypeDefinitions", in, out, opts...)
+func (c *jungleTVClient) ExportApplicationBundle(ctx context.Context, in *ExportApplicationBundleRequest, opts ...grpc.CallOption) (*ExportApplicationBundleResponse, error) {
+	out := new(ExportApplicationBundleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ExportApplicationBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) ResolveApplicationPage(ctx context.Context, in *ResolveApplicationPageRequest, opts ...grpc.CallOption) (*ResolveApplicationPageResponse, error) {
-	out := new(ResolveApplicationPageResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ResolveApplicationPage", in, out, opts...)
+func (c *jungleTVClient) PreviewApplicationBundleImport(ctx context.Context, in *PreviewApplicationBundleImportRequest, opts ...grpc.CallOption) (*PreviewApplicationBundleImportResponse, error) {
+	out := new(PreviewApplicationBundleImportResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/PreviewApplicationBundleImport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) ConsumeApplicationEvents(ctx context.Context, in *ConsumeApplicationEventsRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[12], "/jungletv.JungleTV/ConsumeApplicationEvents", opts...)
+func (c *jungleTVClient) ImportApplicationBundle(ctx context.Context, in *ImportApplicationBundleRequest, opts ...grpc.CallOption) (*ImportApplicationBundleResponse, error) {
+	out := new(ImportApplicationBundleResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ImportApplicationBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &jungleTVConsumeApplicationEventsClient{stream}
+	return out, nil
+}
+
+func (c *jungleTVClient) TypeScriptTypeDefinitions(ctx context.Context, in *TypeScriptTypeDefinitionsRequest, opts ...grpc.CallOption) (*TypeScriptTypeDefinitionsResponse, error) {
+	out := new(TypeScriptTypeDefinitionsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TypeScriptTypeDefinitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) DebugApplication(ctx context.Context, in *DebugApplicationRequest, opts ...grpc.CallOption) (JungleTV_DebugApplicationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[13], "/jungletv.JungleTV/DebugApplication", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVDebugApplicationClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1455,35 +1846,130 @@ func (c *jungleTVClient) ConsumeApplicationEvents(ctx context.Context, in *Consu
 	return x, nil
 }
 
-type JungleTV_ConsumeApplicationEventsClient interface {
-	Recv() (*ApplicationEventUpdate, error)
+type JungleTV_DebugApplicationClient interface {
+	Recv() (*ApplicationDebuggerEvent, error)
 	grpc.ClientStream
 }
 
-type jungleTVConsumeApplicationEventsClient struct {
+type jungleTVDebugApplicationClient struct {
 	grpc.ClientStream
 }
 
-func (x *jungleTVConsumeApplicationEventsClient) Recv() (*ApplicationEventUpdate, error) {
-	m := new(ApplicationEventUpdate)
+func (x *jungleTVDebugApplicationClient) Recv() (*ApplicationDebuggerEvent, error) {
+	m := new(ApplicationDebuggerEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *jungleTVClient) ApplicationServerMethod(ctx context.Context, in *ApplicationServerMethodRequest, opts ...grpc.CallOption) (*ApplicationServerMethodResponse, error) {
-	out := new(ApplicationServerMethodResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationServerMethod", in, out, opts...)
+func (c *jungleTVClient) ApplicationDebuggerCommand(ctx context.Context, in *ApplicationDebuggerCommandRequest, opts ...grpc.CallOption) (*ApplicationDebuggerCommandResponse, error) {
+	out := new(ApplicationDebuggerCommandResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationDebuggerCommand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *jungleTVClient) TriggerApplicationEvent(ctx context.Context, in *TriggerApplicationEventRequest, opts ...grpc.CallOption) (*TriggerApplicationEventResponse, error) {
-	out := new(TriggerApplicationEventResponse)
-	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TriggerApplicationEvent", in, out, opts...)
+func (c *jungleTVClient) StartApplicationProfiler(ctx context.Context, in *StartApplicationProfilerRequest, opts ...grpc.CallOption) (*StartApplicationProfilerResponse, error) {
+	out := new(StartApplicationProfilerResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/StartApplicationProfiler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) StopApplicationProfiler(ctx context.Context, in *StopApplicationProfilerRequest, opts ...grpc.CallOption) (*StopApplicationProfilerResponse, error) {
+	out := new(StopApplicationProfilerResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/StopApplicationProfiler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ApplicationHeapSnapshot(ctx context.Context, in *ApplicationHeapSnapshotRequest, opts ...grpc.CallOption) (*ApplicationHeapSnapshotResponse, error) {
+	out := new(ApplicationHeapSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationHeapSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ApplicationSettings(ctx context.Context, in *ApplicationSettingsRequest, opts ...grpc.CallOption) (*ApplicationSettingsResponse, error) {
+	out := new(ApplicationSettingsResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) UpdateApplicationSetting(ctx context.Context, in *UpdateApplicationSettingRequest, opts ...grpc.CallOption) (*UpdateApplicationSettingResponse, error) {
+	out := new(UpdateApplicationSettingResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/UpdateApplicationSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ResolveApplicationPage(ctx context.Context, in *ResolveApplicationPageRequest, opts ...grpc.CallOption) (*ResolveApplicationPageResponse, error) {
+	out := new(ResolveApplicationPageResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ResolveApplicationPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) ConsumeApplicationEvents(ctx context.Context, in *ConsumeApplicationEventsRequest, opts ...grpc.CallOption) (JungleTV_ConsumeApplicationEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JungleTV_ServiceDesc.Streams[14], "/jungletv.JungleTV/ConsumeApplicationEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jungleTVConsumeApplicationEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JungleTV_ConsumeApplicationEventsClient interface {
+	Recv() (*ApplicationEventUpdate, error)
+	grpc.ClientStream
+}
+
+type jungleTVConsumeApplicationEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jungleTVConsumeApplicationEventsClient) Recv() (*ApplicationEventUpdate, error) {
+	m := new(ApplicationEventUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jungleTVClient) ApplicationServerMethod(ctx context.Context, in *ApplicationServerMethodRequest, opts ...grpc.CallOption) (*ApplicationServerMethodResponse, error) {
+	out := new(ApplicationServerMethodResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/ApplicationServerMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jungleTVClient) TriggerApplicationEvent(ctx context.Context, in *TriggerApplicationEventRequest, opts ...grpc.CallOption) (*TriggerApplicationEventResponse, error) {
+	out := new(TriggerApplicationEventResponse)
+	err := c.cc.Invoke(ctx, "/jungletv.JungleTV/TriggerApplicationEvent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1507,6 +1993,19 @@ type JungleTVServer interface {
 	ProduceSegchaChallenge(context.Context, *ProduceSegchaChallengeRequest) (*ProduceSegchaChallengeResponse, error)
 	ConsumeChat(*ConsumeChatRequest, JungleTV_ConsumeChatServer) error
 	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
+	EditChatMessage(context.Context, *EditChatMessageRequest) (*EditChatMessageResponse, error)
+	ChatMessageThread(context.Context, *ChatMessageThreadRequest) (*ChatMessageThreadResponse, error)
+	ChatChannels(context.Context, *ChatChannelsRequest) (*ChatChannelsResponse, error)
+	MarkChatRepliesAsRead(context.Context, *MarkChatRepliesAsReadRequest) (*MarkChatRepliesAsReadResponse, error)
+	ConsumeDirectMessages(*ConsumeDirectMessagesRequest, JungleTV_ConsumeDirectMessagesServer) error
+	SendDirectMessage(context.Context, *SendDirectMessageRequest) (*SendDirectMessageResponse, error)
+	DirectMessageConversation(context.Context, *DirectMessageConversationRequest) (*DirectMessageConversationResponse, error)
+	SetDirectMessagesEnabled(context.Context, *SetDirectMessagesEnabledRequest) (*SetDirectMessagesEnabledResponse, error)
+	ReportDirectMessageConversation(context.Context, *ReportDirectMessageConversationRequest) (*ReportDirectMessageConversationResponse, error)
+	AddChatMessageReaction(context.Context, *AddChatMessageReactionRequest) (*AddChatMessageReactionResponse, error)
+	RemoveChatMessageReaction(context.Context, *RemoveChatMessageReactionRequest) (*RemoveChatMessageReactionResponse, error)
+	ReportChatMessage(context.Context, *ReportChatMessageRequest) (*ReportChatMessageResponse, error)
+	VoteInChatPoll(context.Context, *VoteInChatPollRequest) (*VoteInChatPollResponse, error)
 	UserPermissionLevel(context.Context, *UserPermissionLevelRequest) (*UserPermissionLevelResponse, error)
 	GetDocument(context.Context, *GetDocumentRequest) (*Document, error)
 	SetChatNickname(context.Context, *SetChatNicknameRequest) (*SetChatNicknameResponse, error)
@@ -1549,10 +2048,19 @@ type JungleTVServer interface {
 	UserBans(context.Context, *UserBansRequest) (*UserBansResponse, error)
 	BanUser(context.Context, *BanUserRequest) (*BanUserResponse, error)
 	RemoveBan(context.Context, *RemoveBanRequest) (*RemoveBanResponse, error)
+	ChatMutes(context.Context, *ChatMutesRequest) (*ChatMutesResponse, error)
+	MuteChatUser(context.Context, *MuteChatUserRequest) (*MuteChatUserResponse, error)
+	UnmuteChatUser(context.Context, *UnmuteChatUserRequest) (*UnmuteChatUserResponse, error)
+	ResolveChatMessageReports(context.Context, *ResolveChatMessageReportsRequest) (*ResolveChatMessageReportsResponse, error)
+	CreateChatPoll(context.Context, *CreateChatPollRequest) (*CreateChatPollResponse, error)
 	UserVerifications(context.Context, *UserVerificationsRequest) (*UserVerificationsResponse, error)
 	VerifyUser(context.Context, *VerifyUserRequest) (*VerifyUserResponse, error)
 	RemoveUserVerification(context.Context, *RemoveUserVerificationRequest) (*RemoveUserVerificationResponse, error)
 	UserChatMessages(context.Context, *UserChatMessagesRequest) (*UserChatMessagesResponse, error)
+	SearchChatMessages(context.Context, *SearchChatMessagesRequest) (*SearchChatMessagesResponse, error)
+	DirectMessageReports(context.Context, *DirectMessageReportsRequest) (*DirectMessageReportsResponse, error)
+	ReportedDirectMessageConversation(context.Context, *ReportedDirectMessageConversationRequest) (*ReportedDirectMessageConversationResponse, error)
+	ChatMessageContext(context.Context, *ChatMessageContextRequest) (*ChatMessageContextResponse, error)
 	DisallowedMedia(context.Context, *DisallowedMediaRequest) (*DisallowedMediaResponse, error)
 	AddDisallowedMedia(context.Context, *AddDisallowedMediaRequest) (*AddDisallowedMediaResponse, error)
 	RemoveDisallowedMedia(context.Context, *RemoveDisallowedMediaRequest) (*RemoveDisallowedMediaResponse, error)
@@ -1566,6 +2074,13 @@ type JungleTVServer interface {
 	SetMinimumPricesMultiplier(context.Context, *SetMinimumPricesMultiplierRequest) (*SetMinimumPricesMultiplierResponse, error)
 	SetCrowdfundedSkippingEnabled(context.Context, *SetCrowdfundedSkippingEnabledRequest) (*SetCrowdfundedSkippingEnabledResponse, error)
 	SetSkipPriceMultiplier(context.Context, *SetSkipPriceMultiplierRequest) (*SetSkipPriceMultiplierResponse, error)
+	SetRewardDistributionPolicy(context.Context, *SetRewardDistributionPolicyRequest) (*SetRewardDistributionPolicyResponse, error)
+	SimulateRewardDistribution(context.Context, *SimulateRewardDistributionRequest) (*SimulateRewardDistributionResponse, error)
+	SetActivityChallengeSelectionPolicy(context.Context, *SetActivityChallengeSelectionPolicyRequest) (*SetActivityChallengeSelectionPolicyResponse, error)
+	SegchaPuzzleStatistics(context.Context, *SegchaPuzzleStatisticsRequest) (*SegchaPuzzleStatisticsResponse, error)
+	SetSegchaAdaptiveDifficultyEnabled(context.Context, *SetSegchaAdaptiveDifficultyEnabledRequest) (*SetSegchaAdaptiveDifficultyEnabledResponse, error)
+	SpectatorActivity(context.Context, *SpectatorActivityRequest) (*SpectatorActivityResponse, error)
+	SpectatorRetention(context.Context, *SpectatorRetentionRequest) (*SpectatorRetentionResponse, error)
 	ConfirmRaffleWinner(context.Context, *ConfirmRaffleWinnerRequest) (*ConfirmRaffleWinnerResponse, error)
 	CompleteRaffle(context.Context, *CompleteRaffleRequest) (*CompleteRaffleResponse, error)
 	RedrawRaffle(context.Context, *RedrawRaffleRequest) (*RedrawRaffleResponse, error)
@@ -1588,6 +2103,11 @@ type JungleTVServer interface {
 	TriggerClientReload(context.Context, *TriggerClientReloadRequest) (*TriggerClientReloadResponse, error)
 	SetMulticurrencyPaymentsEnabled(context.Context, *SetMulticurrencyPaymentsEnabledRequest) (*SetMulticurrencyPaymentsEnabledResponse, error)
 	InvalidateUserAuthTokens(context.Context, *InvalidateUserAuthTokensRequest) (*InvalidateUserAuthTokensResponse, error)
+	ChatFilterRules(context.Context, *ChatFilterRulesRequest) (*ChatFilterRulesResponse, error)
+	SetChatFilterRule(context.Context, *ChatFilterRule) (*SetChatFilterRuleResponse, error)
+	RemoveChatFilterRule(context.Context, *RemoveChatFilterRuleRequest) (*RemoveChatFilterRuleResponse, error)
+	SetChatChannel(context.Context, *ChatChannel) (*SetChatChannelResponse, error)
+	RemoveChatChannel(context.Context, *RemoveChatChannelRequest) (*RemoveChatChannelResponse, error)
 	// application editor endpoints
 	Applications(context.Context, *ApplicationsRequest) (*ApplicationsResponse, error)
 	GetApplication(context.Context, *GetApplicationRequest) (*Application, error)
@@ -1607,7 +2127,17 @@ type JungleTVServer interface {
 	EvaluateExpressionOnApplication(context.Context, *EvaluateExpressionOnApplicationRequest) (*EvaluateExpressionOnApplicationResponse, error)
 	ExportApplication(context.Context, *ExportApplicationRequest) (*ExportApplicationResponse, error)
 	ImportApplication(context.Context, *ImportApplicationRequest) (*ImportApplicationResponse, error)
+	ExportApplicationBundle(context.Context, *ExportApplicationBundleRequest) (*ExportApplicationBundleResponse, error)
+	PreviewApplicationBundleImport(context.Context, *PreviewApplicationBundleImportRequest) (*PreviewApplicationBundleImportResponse, error)
+	ImportApplicationBundle(context.Context, *ImportApplicationBundleRequest) (*ImportApplicationBundleResponse, error)
 	TypeScriptTypeDefinitions(context.Context, *TypeScriptTypeDefinitionsRequest) (*TypeScriptTypeDefinitionsResponse, error)
+	DebugApplication(*DebugApplicationRequest, JungleTV_DebugApplicationServer) error
+	ApplicationDebuggerCommand(context.Context, *ApplicationDebuggerCommandRequest) (*ApplicationDebuggerCommandResponse, error)
+	StartApplicationProfiler(context.Context, *StartApplicationProfilerRequest) (*StartApplicationProfilerResponse, error)
+	StopApplicationProfiler(context.Context, *StopApplicationProfilerRequest) (*StopApplicationProfilerResponse, error)
+	ApplicationHeapSnapshot(context.Context, *ApplicationHeapSnapshotRequest) (*ApplicationHeapSnapshotResponse, error)
+	ApplicationSettings(context.Context, *ApplicationSettingsRequest) (*ApplicationSettingsResponse, error)
+	UpdateApplicationSetting(context.Context, *UpdateApplicationSettingRequest) (*UpdateApplicationSettingResponse, error)
 	// application runtime endpoints
 	ResolveApplicationPage(context.Context, *ResolveApplicationPageRequest) (*ResolveApplicationPageResponse, error)
 	ConsumeApplicationEvents(*ConsumeApplicationEventsRequest, JungleTV_ConsumeApplicationEventsServer) error
@@ -1659,6 +2189,45 @@ func (UnimplementedJungleTVServer) ConsumeChat(*ConsumeChatRequest, JungleTV_Con
 func (UnimplementedJungleTVServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
 }
+func (UnimplementedJungleTVServer) EditChatMessage(context.Context, *EditChatMessageRequest) (*EditChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditChatMessage not implemented")
+}
+func (UnimplementedJungleTVServer) ChatMessageThread(context.Context, *ChatMessageThreadRequest) (*ChatMessageThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatMessageThread not implemented")
+}
+func (UnimplementedJungleTVServer) ChatChannels(context.Context, *ChatChannelsRequest) (*ChatChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatChannels not implemented")
+}
+func (UnimplementedJungleTVServer) MarkChatRepliesAsRead(context.Context, *MarkChatRepliesAsReadRequest) (*MarkChatRepliesAsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkChatRepliesAsRead not implemented")
+}
+func (UnimplementedJungleTVServer) ConsumeDirectMessages(*ConsumeDirectMessagesRequest, JungleTV_ConsumeDirectMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConsumeDirectMessages not implemented")
+}
+func (UnimplementedJungleTVServer) SendDirectMessage(context.Context, *SendDirectMessageRequest) (*SendDirectMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDirectMessage not implemented")
+}
+func (UnimplementedJungleTVServer) DirectMessageConversation(context.Context, *DirectMessageConversationRequest) (*DirectMessageConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DirectMessageConversation not implemented")
+}
+func (UnimplementedJungleTVServer) SetDirectMessagesEnabled(context.Context, *SetDirectMessagesEnabledRequest) (*SetDirectMessagesEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDirectMessagesEnabled not implemented")
+}
+func (UnimplementedJungleTVServer) ReportDirectMessageConversation(context.Context, *ReportDirectMessageConversationRequest) (*ReportDirectMessageConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDirectMessageConversation not implemented")
+}
+func (UnimplementedJungleTVServer) AddChatMessageReaction(context.Context, *AddChatMessageReactionRequest) (*AddChatMessageReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMessageReaction not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveChatMessageReaction(context.Context, *RemoveChatMessageReactionRequest) (*RemoveChatMessageReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatMessageReaction not implemented")
+}
+func (UnimplementedJungleTVServer) ReportChatMessage(context.Context, *ReportChatMessageRequest) (*ReportChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportChatMessage not implemented")
+}
+func (UnimplementedJungleTVServer) VoteInChatPoll(context.Context, *VoteInChatPollRequest) (*VoteInChatPollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteInChatPoll not implemented")
+}
 func (UnimplementedJungleTVServer) UserPermissionLevel(context.Context, *UserPermissionLevelRequest) (*UserPermissionLevelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserPermissionLevel not implemented")
 }
@@ -1782,6 +2351,21 @@ func (UnimplementedJungleTVServer) BanUser(context.Context, *BanUserRequest) (*B
 func (UnimplementedJungleTVServer) RemoveBan(context.Context, *RemoveBanRequest) (*RemoveBanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBan not implemented")
 }
+func (UnimplementedJungleTVServer) ChatMutes(context.Context, *ChatMutesRequest) (*ChatMutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatMutes not implemented")
+}
+func (UnimplementedJungleTVServer) MuteChatUser(context.Context, *MuteChatUserRequest) (*MuteChatUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteChatUser not implemented")
+}
+func (UnimplementedJungleTVServer) UnmuteChatUser(context.Context, *UnmuteChatUserRequest) (*UnmuteChatUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteChatUser not implemented")
+}
+func (UnimplementedJungleTVServer) ResolveChatMessageReports(context.Context, *ResolveChatMessageReportsRequest) (*ResolveChatMessageReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveChatMessageReports not implemented")
+}
+func (UnimplementedJungleTVServer) CreateChatPoll(context.Context, *CreateChatPollRequest) (*CreateChatPollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChatPoll not implemented")
+}
 func (UnimplementedJungleTVServer) UserVerifications(context.Context, *UserVerificationsRequest) (*UserVerificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserVerifications not implemented")
 }
@@ -1794,6 +2378,18 @@ func (UnimplementedJungleTVServer) RemoveUserVerification(context.Context, *Remo
 func (UnimplementedJungleTVServer) UserChatMessages(context.Context, *UserChatMessagesRequest) (*UserChatMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserChatMessages not implemented")
 }
+func (UnimplementedJungleTVServer) SearchChatMessages(context.Context, *SearchChatMessagesRequest) (*SearchChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchChatMessages not implemented")
+}
+func (UnimplementedJungleTVServer) DirectMessageReports(context.Context, *DirectMessageReportsRequest) (*DirectMessageReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DirectMessageReports not implemented")
+}
+func (UnimplementedJungleTVServer) ReportedDirectMessageConversation(context.Context, *ReportedDirectMessageConversationRequest) (*ReportedDirectMessageConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportedDirectMessageConversation not implemented")
+}
+func (UnimplementedJungleTVServer) ChatMessageContext(context.Context, *ChatMessageContextRequest) (*ChatMessageContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatMessageContext not implemented")
+}
 func (UnimplementedJungleTVServer) DisallowedMedia(context.Context, *DisallowedMediaRequest) (*DisallowedMediaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisallowedMedia not implemented")
 }
@@ -1833,6 +2429,27 @@ func (UnimplementedJungleTVServer) SetCrowdfundedSkippingEnabled(context.Context
 func (UnimplementedJungleTVServer) SetSkipPriceMultiplier(context.Context, *SetSkipPriceMultiplierRequest) (*SetSkipPriceMultiplierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSkipPriceMultiplier not implemented")
 }
+func (UnimplementedJungleTVServer) SetRewardDistributionPolicy(context.Context, *SetRewardDistributionPolicyRequest) (*SetRewardDistributionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardDistributionPolicy not implemented")
+}
+func (UnimplementedJungleTVServer) SimulateRewardDistribution(context.Context, *SimulateRewardDistributionRequest) (*SimulateRewardDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRewardDistribution not implemented")
+}
+func (UnimplementedJungleTVServer) SetActivityChallengeSelectionPolicy(context.Context, *SetActivityChallengeSelectionPolicyRequest) (*SetActivityChallengeSelectionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActivityChallengeSelectionPolicy not implemented")
+}
+func (UnimplementedJungleTVServer) SegchaPuzzleStatistics(context.Context, *SegchaPuzzleStatisticsRequest) (*SegchaPuzzleStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SegchaPuzzleStatistics not implemented")
+}
+func (UnimplementedJungleTVServer) SetSegchaAdaptiveDifficultyEnabled(context.Context, *SetSegchaAdaptiveDifficultyEnabledRequest) (*SetSegchaAdaptiveDifficultyEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSegchaAdaptiveDifficultyEnabled not implemented")
+}
+func (UnimplementedJungleTVServer) SpectatorActivity(context.Context, *SpectatorActivityRequest) (*SpectatorActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpectatorActivity not implemented")
+}
+func (UnimplementedJungleTVServer) SpectatorRetention(context.Context, *SpectatorRetentionRequest) (*SpectatorRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpectatorRetention not implemented")
+}
 func (UnimplementedJungleTVServer) ConfirmRaffleWinner(context.Context, *ConfirmRaffleWinnerRequest) (*ConfirmRaffleWinnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRaffleWinner not implemented")
 }
@@ -1899,6 +2516,21 @@ func (UnimplementedJungleTVServer) SetMulticurrencyPaymentsEnabled(context.Conte
 func (UnimplementedJungleTVServer) InvalidateUserAuthTokens(context.Context, *InvalidateUserAuthTokensRequest) (*InvalidateUserAuthTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateUserAuthTokens not implemented")
 }
+func (UnimplementedJungleTVServer) ChatFilterRules(context.Context, *ChatFilterRulesRequest) (*ChatFilterRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatFilterRules not implemented")
+}
+func (UnimplementedJungleTVServer) SetChatFilterRule(context.Context, *ChatFilterRule) (*SetChatFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatFilterRule not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveChatFilterRule(context.Context, *RemoveChatFilterRuleRequest) (*RemoveChatFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatFilterRule not implemented")
+}
+func (UnimplementedJungleTVServer) SetChatChannel(context.Context, *ChatChannel) (*SetChatChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatChannel not implemented")
+}
+func (UnimplementedJungleTVServer) RemoveChatChannel(context.Context, *RemoveChatChannelRequest) (*RemoveChatChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatChannel not implemented")
+}
 func (UnimplementedJungleTVServer) Applications(context.Context, *ApplicationsRequest) (*ApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Applications not implemented")
 }
@@ -1953,9 +2585,39 @@ func (UnimplementedJungleTVServer) ExportApplication(context.Context, *ExportApp
 func (UnimplementedJungleTVServer) ImportApplication(context.Context, *ImportApplicationRequest) (*ImportApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportApplication not implemented")
 }
+func (UnimplementedJungleTVServer) ExportApplicationBundle(context.Context, *ExportApplicationBundleRequest) (*ExportApplicationBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportApplicationBundle not implemented")
+}
+func (UnimplementedJungleTVServer) PreviewApplicationBundleImport(context.Context, *PreviewApplicationBundleImportRequest) (*PreviewApplicationBundleImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewApplicationBundleImport not implemented")
+}
+func (UnimplementedJungleTVServer) ImportApplicationBundle(context.Context, *ImportApplicationBundleRequest) (*ImportApplicationBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportApplicationBundle not implemented")
+}
 func (UnimplementedJungleTVServer) TypeScriptTypeDefinitions(context.Context, *TypeScriptTypeDefinitionsRequest) (*TypeScriptTypeDefinitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TypeScriptTypeDefinitions not implemented")
 }
+func (UnimplementedJungleTVServer) DebugApplication(*DebugApplicationRequest, JungleTV_DebugApplicationServer) error {
+	return status.Errorf(codes.Unimplemented, "method DebugApplication not implemented")
+}
+func (UnimplementedJungleTVServer) ApplicationDebuggerCommand(context.Context, *ApplicationDebuggerCommandRequest) (*ApplicationDebuggerCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplicationDebuggerCommand not implemented")
+}
+func (UnimplementedJungleTVServer) StartApplicationProfiler(context.Context, *StartApplicationProfilerRequest) (*StartApplicationProfilerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartApplicationProfiler not implemented")
+}
+func (UnimplementedJungleTVServer) StopApplicationProfiler(context.Context, *StopApplicationProfilerRequest) (*StopApplicationProfilerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopApplicationProfiler not implemented")
+}
+func (UnimplementedJungleTVServer) ApplicationHeapSnapshot(context.Context, *ApplicationHeapSnapshotRequest) (*ApplicationHeapSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplicationHeapSnapshot not implemented")
+}
+func (UnimplementedJungleTVServer) ApplicationSettings(context.Context, *ApplicationSettingsRequest) (*ApplicationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplicationSettings not implemented")
+}
+func (UnimplementedJungleTVServer) UpdateApplicationSetting(context.Context, *UpdateApplicationSettingRequest) (*UpdateApplicationSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationSetting not implemented")
+}
 func (UnimplementedJungleTVServer) ResolveApplicationPage(context.Context, *ResolveApplicationPageRequest) (*ResolveApplicationPageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveApplicationPage not implemented")
 }
@@ -2233,319 +2895,556 @@ func _JungleTV_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserPermissionLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPermissionLevelRequest)
+func _JungleTV_EditChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditChatMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserPermissionLevel(ctx, in)
+		return srv.(JungleTVServer).EditChatMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserPermissionLevel",
+		FullMethod: "/jungletv.JungleTV/EditChatMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserPermissionLevel(ctx, req.(*UserPermissionLevelRequest))
+		return srv.(JungleTVServer).EditChatMessage(ctx, req.(*EditChatMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDocumentRequest)
+func _JungleTV_ChatMessageThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessageThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).GetDocument(ctx, in)
+		return srv.(JungleTVServer).ChatMessageThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/GetDocument",
+		FullMethod: "/jungletv.JungleTV/ChatMessageThread",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).GetDocument(ctx, req.(*GetDocumentRequest))
+		return srv.(JungleTVServer).ChatMessageThread(ctx, req.(*ChatMessageThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetChatNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetChatNicknameRequest)
+func _JungleTV_ChatChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetChatNickname(ctx, in)
+		return srv.(JungleTVServer).ChatChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetChatNickname",
+		FullMethod: "/jungletv.JungleTV/ChatChannels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetChatNickname(ctx, req.(*SetChatNicknameRequest))
+		return srv.(JungleTVServer).ChatChannels(ctx, req.(*ChatChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawRequest)
+func _JungleTV_MarkChatRepliesAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkChatRepliesAsReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).Withdraw(ctx, in)
+		return srv.(JungleTVServer).MarkChatRepliesAsRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/Withdraw",
+		FullMethod: "/jungletv.JungleTV/MarkChatRepliesAsRead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).Withdraw(ctx, req.(*WithdrawRequest))
+		return srv.(JungleTVServer).MarkChatRepliesAsRead(ctx, req.(*MarkChatRepliesAsReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_Leaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaderboardsRequest)
+func _JungleTV_ConsumeDirectMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConsumeDirectMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JungleTVServer).ConsumeDirectMessages(m, &jungleTVConsumeDirectMessagesServer{stream})
+}
+
+type JungleTV_ConsumeDirectMessagesServer interface {
+	Send(*DirectMessagesUpdate) error
+	grpc.ServerStream
+}
+
+type jungleTVConsumeDirectMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *jungleTVConsumeDirectMessagesServer) Send(m *DirectMessagesUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JungleTV_SendDirectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDirectMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).Leaderboards(ctx, in)
+		return srv.(JungleTVServer).SendDirectMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/Leaderboards",
+		FullMethod: "/jungletv.JungleTV/SendDirectMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).Leaderboards(ctx, req.(*LeaderboardsRequest))
+		return srv.(JungleTVServer).SendDirectMessage(ctx, req.(*SendDirectMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardHistoryRequest)
+func _JungleTV_DirectMessageConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectMessageConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RewardHistory(ctx, in)
+		return srv.(JungleTVServer).DirectMessageConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RewardHistory",
+		FullMethod: "/jungletv.JungleTV/DirectMessageConversation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RewardHistory(ctx, req.(*RewardHistoryRequest))
+		return srv.(JungleTVServer).DirectMessageConversation(ctx, req.(*DirectMessageConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_WithdrawalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalHistoryRequest)
+func _JungleTV_SetDirectMessagesEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDirectMessagesEnabledRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).WithdrawalHistory(ctx, in)
+		return srv.(JungleTVServer).SetDirectMessagesEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/WithdrawalHistory",
+		FullMethod: "/jungletv.JungleTV/SetDirectMessagesEnabled",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).WithdrawalHistory(ctx, req.(*WithdrawalHistoryRequest))
+		return srv.(JungleTVServer).SetDirectMessagesEnabled(ctx, req.(*SetDirectMessagesEnabledRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_OngoingRaffleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OngoingRaffleInfoRequest)
+func _JungleTV_ReportDirectMessageConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDirectMessageConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).OngoingRaffleInfo(ctx, in)
+		return srv.(JungleTVServer).ReportDirectMessageConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/OngoingRaffleInfo",
+		FullMethod: "/jungletv.JungleTV/ReportDirectMessageConversation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).OngoingRaffleInfo(ctx, req.(*OngoingRaffleInfoRequest))
+		return srv.(JungleTVServer).ReportDirectMessageConversation(ctx, req.(*ReportDirectMessageConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RaffleDrawings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaffleDrawingsRequest)
+func _JungleTV_AddChatMessageReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatMessageReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RaffleDrawings(ctx, in)
+		return srv.(JungleTVServer).AddChatMessageReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RaffleDrawings",
+		FullMethod: "/jungletv.JungleTV/AddChatMessageReaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RaffleDrawings(ctx, req.(*RaffleDrawingsRequest))
+		return srv.(JungleTVServer).AddChatMessageReaction(ctx, req.(*AddChatMessageReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_Connections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectionsRequest)
+func _JungleTV_RemoveChatMessageReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatMessageReactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).Connections(ctx, in)
+		return srv.(JungleTVServer).RemoveChatMessageReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/Connections",
+		FullMethod: "/jungletv.JungleTV/RemoveChatMessageReaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).Connections(ctx, req.(*ConnectionsRequest))
+		return srv.(JungleTVServer).RemoveChatMessageReaction(ctx, req.(*RemoveChatMessageReactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_CreateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConnectionRequest)
+func _JungleTV_ReportChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportChatMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).CreateConnection(ctx, in)
+		return srv.(JungleTVServer).ReportChatMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/CreateConnection",
+		FullMethod: "/jungletv.JungleTV/ReportChatMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).CreateConnection(ctx, req.(*CreateConnectionRequest))
+		return srv.(JungleTVServer).ReportChatMessage(ctx, req.(*ReportChatMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveConnectionRequest)
+func _JungleTV_VoteInChatPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteInChatPollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveConnection(ctx, in)
+		return srv.(JungleTVServer).VoteInChatPoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveConnection",
+		FullMethod: "/jungletv.JungleTV/VoteInChatPoll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveConnection(ctx, req.(*RemoveConnectionRequest))
+		return srv.(JungleTVServer).VoteInChatPoll(ctx, req.(*VoteInChatPollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserProfileRequest)
+func _JungleTV_UserPermissionLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPermissionLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserProfile(ctx, in)
+		return srv.(JungleTVServer).UserPermissionLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserProfile",
+		FullMethod: "/jungletv.JungleTV/UserPermissionLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserProfile(ctx, req.(*UserProfileRequest))
+		return srv.(JungleTVServer).UserPermissionLevel(ctx, req.(*UserPermissionLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserStatsRequest)
+func _JungleTV_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserStats(ctx, in)
+		return srv.(JungleTVServer).GetDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserStats",
+		FullMethod: "/jungletv.JungleTV/GetDocument",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserStats(ctx, req.(*UserStatsRequest))
+		return srv.(JungleTVServer).GetDocument(ctx, req.(*GetDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetProfileBiography_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetProfileBiographyRequest)
+func _JungleTV_SetChatNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatNicknameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetProfileBiography(ctx, in)
+		return srv.(JungleTVServer).SetChatNickname(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetProfileBiography",
+		FullMethod: "/jungletv.JungleTV/SetChatNickname",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetProfileBiography(ctx, req.(*SetProfileBiographyRequest))
+		return srv.(JungleTVServer).SetChatNickname(ctx, req.(*SetChatNicknameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetProfileFeaturedMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetProfileFeaturedMediaRequest)
+func _JungleTV_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetProfileFeaturedMedia(ctx, in)
+		return srv.(JungleTVServer).Withdraw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetProfileFeaturedMedia",
+		FullMethod: "/jungletv.JungleTV/Withdraw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetProfileFeaturedMedia(ctx, req.(*SetProfileFeaturedMediaRequest))
+		return srv.(JungleTVServer).Withdraw(ctx, req.(*WithdrawRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_PlayedMediaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlayedMediaHistoryRequest)
+func _JungleTV_Leaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).PlayedMediaHistory(ctx, in)
+		return srv.(JungleTVServer).Leaderboards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/PlayedMediaHistory",
+		FullMethod: "/jungletv.JungleTV/Leaderboards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).PlayedMediaHistory(ctx, req.(*PlayedMediaHistoryRequest))
+		return srv.(JungleTVServer).Leaderboards(ctx, req.(*LeaderboardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockUserRequest)
+func _JungleTV_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).BlockUser(ctx, in)
+		return srv.(JungleTVServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RewardHistory(ctx, req.(*RewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_WithdrawalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).WithdrawalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/WithdrawalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).WithdrawalHistory(ctx, req.(*WithdrawalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_OngoingRaffleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OngoingRaffleInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).OngoingRaffleInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/OngoingRaffleInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).OngoingRaffleInfo(ctx, req.(*OngoingRaffleInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RaffleDrawings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaffleDrawingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RaffleDrawings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RaffleDrawings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RaffleDrawings(ctx, req.(*RaffleDrawingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_Connections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).Connections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/Connections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).Connections(ctx, req.(*ConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_CreateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).CreateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/CreateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).CreateConnection(ctx, req.(*CreateConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RemoveConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveConnection(ctx, req.(*RemoveConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UserProfile(ctx, req.(*UserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UserStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UserStats(ctx, req.(*UserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetProfileBiography_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileBiographyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetProfileBiography(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetProfileBiography",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetProfileBiography(ctx, req.(*SetProfileBiographyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetProfileFeaturedMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileFeaturedMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetProfileFeaturedMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetProfileFeaturedMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetProfileFeaturedMedia(ctx, req.(*SetProfileFeaturedMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PlayedMediaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayedMediaHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PlayedMediaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PlayedMediaHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PlayedMediaHistory(ctx, req.(*PlayedMediaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -2854,200 +3753,362 @@ func _JungleTV_ForciblyEnqueueTicket_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveQueueEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveQueueEntryRequest)
+func _JungleTV_RemoveQueueEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveQueueEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveQueueEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveQueueEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveQueueEntry(ctx, req.(*RemoveQueueEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RemoveChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveChatMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveChatMessage(ctx, req.(*RemoveChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetChatSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetChatSettings(ctx, req.(*SetChatSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetMediaEnqueuingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMediaEnqueuingEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetMediaEnqueuingEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetMediaEnqueuingEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetMediaEnqueuingEnabled(ctx, req.(*SetMediaEnqueuingEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UserBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UserBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UserBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UserBans(ctx, req.(*UserBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RemoveBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveBan(ctx, req.(*RemoveBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ChatMutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ChatMutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ChatMutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ChatMutes(ctx, req.(*ChatMutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_MuteChatUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteChatUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).MuteChatUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/MuteChatUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).MuteChatUser(ctx, req.(*MuteChatUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UnmuteChatUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteChatUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveQueueEntry(ctx, in)
+		return srv.(JungleTVServer).UnmuteChatUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveQueueEntry",
+		FullMethod: "/jungletv.JungleTV/UnmuteChatUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveQueueEntry(ctx, req.(*RemoveQueueEntryRequest))
+		return srv.(JungleTVServer).UnmuteChatUser(ctx, req.(*UnmuteChatUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveChatMessageRequest)
+func _JungleTV_ResolveChatMessageReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveChatMessageReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveChatMessage(ctx, in)
+		return srv.(JungleTVServer).ResolveChatMessageReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveChatMessage",
+		FullMethod: "/jungletv.JungleTV/ResolveChatMessageReports",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveChatMessage(ctx, req.(*RemoveChatMessageRequest))
+		return srv.(JungleTVServer).ResolveChatMessageReports(ctx, req.(*ResolveChatMessageReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetChatSettingsRequest)
+func _JungleTV_CreateChatPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChatPollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetChatSettings(ctx, in)
+		return srv.(JungleTVServer).CreateChatPoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetChatSettings",
+		FullMethod: "/jungletv.JungleTV/CreateChatPoll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetChatSettings(ctx, req.(*SetChatSettingsRequest))
+		return srv.(JungleTVServer).CreateChatPoll(ctx, req.(*CreateChatPollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetMediaEnqueuingEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMediaEnqueuingEnabledRequest)
+func _JungleTV_UserVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserVerificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetMediaEnqueuingEnabled(ctx, in)
+		return srv.(JungleTVServer).UserVerifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetMediaEnqueuingEnabled",
+		FullMethod: "/jungletv.JungleTV/UserVerifications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetMediaEnqueuingEnabled(ctx, req.(*SetMediaEnqueuingEnabledRequest))
+		return srv.(JungleTVServer).UserVerifications(ctx, req.(*UserVerificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserBansRequest)
+func _JungleTV_VerifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserBans(ctx, in)
+		return srv.(JungleTVServer).VerifyUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserBans",
+		FullMethod: "/jungletv.JungleTV/VerifyUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserBans(ctx, req.(*UserBansRequest))
+		return srv.(JungleTVServer).VerifyUser(ctx, req.(*VerifyUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BanUserRequest)
+func _JungleTV_RemoveUserVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserVerificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).BanUser(ctx, in)
+		return srv.(JungleTVServer).RemoveUserVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/BanUser",
+		FullMethod: "/jungletv.JungleTV/RemoveUserVerification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).BanUser(ctx, req.(*BanUserRequest))
+		return srv.(JungleTVServer).RemoveUserVerification(ctx, req.(*RemoveUserVerificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveBanRequest)
+func _JungleTV_UserChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserChatMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveBan(ctx, in)
+		return srv.(JungleTVServer).UserChatMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveBan",
+		FullMethod: "/jungletv.JungleTV/UserChatMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveBan(ctx, req.(*RemoveBanRequest))
+		return srv.(JungleTVServer).UserChatMessages(ctx, req.(*UserChatMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserVerificationsRequest)
+func _JungleTV_SearchChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChatMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserVerifications(ctx, in)
+		return srv.(JungleTVServer).SearchChatMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserVerifications",
+		FullMethod: "/jungletv.JungleTV/SearchChatMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserVerifications(ctx, req.(*UserVerificationsRequest))
+		return srv.(JungleTVServer).SearchChatMessages(ctx, req.(*SearchChatMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_VerifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyUserRequest)
+func _JungleTV_DirectMessageReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectMessageReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).VerifyUser(ctx, in)
+		return srv.(JungleTVServer).DirectMessageReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/VerifyUser",
+		FullMethod: "/jungletv.JungleTV/DirectMessageReports",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).VerifyUser(ctx, req.(*VerifyUserRequest))
+		return srv.(JungleTVServer).DirectMessageReports(ctx, req.(*DirectMessageReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveUserVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveUserVerificationRequest)
+func _JungleTV_ReportedDirectMessageConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportedDirectMessageConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveUserVerification(ctx, in)
+		return srv.(JungleTVServer).ReportedDirectMessageConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveUserVerification",
+		FullMethod: "/jungletv.JungleTV/ReportedDirectMessageConversation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveUserVerification(ctx, req.(*RemoveUserVerificationRequest))
+		return srv.(JungleTVServer).ReportedDirectMessageConversation(ctx, req.(*ReportedDirectMessageConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_UserChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserChatMessagesRequest)
+func _JungleTV_ChatMessageContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessageContextRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).UserChatMessages(ctx, in)
+		return srv.(JungleTVServer).ChatMessageContext(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/UserChatMessages",
+		FullMethod: "/jungletv.JungleTV/ChatMessageContext",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).UserChatMessages(ctx, req.(*UserChatMessagesRequest))
+		return srv.(JungleTVServer).ChatMessageContext(ctx, req.(*ChatMessageContextRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3286,6 +4347,132 @@ func _JungleTV_SetSkipPriceMultiplier_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_SetRewardDistributionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRewardDistributionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetRewardDistributionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetRewardDistributionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetRewardDistributionPolicy(ctx, req.(*SetRewardDistributionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SimulateRewardDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRewardDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SimulateRewardDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SimulateRewardDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SimulateRewardDistribution(ctx, req.(*SimulateRewardDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetActivityChallengeSelectionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActivityChallengeSelectionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetActivityChallengeSelectionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetActivityChallengeSelectionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetActivityChallengeSelectionPolicy(ctx, req.(*SetActivityChallengeSelectionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SegchaPuzzleStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegchaPuzzleStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SegchaPuzzleStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SegchaPuzzleStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SegchaPuzzleStatistics(ctx, req.(*SegchaPuzzleStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetSegchaAdaptiveDifficultyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSegchaAdaptiveDifficultyEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetSegchaAdaptiveDifficultyEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetSegchaAdaptiveDifficultyEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetSegchaAdaptiveDifficultyEnabled(ctx, req.(*SetSegchaAdaptiveDifficultyEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SpectatorActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SpectatorActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SpectatorActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SpectatorActivity(ctx, req.(*SpectatorActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SpectatorRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectatorRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SpectatorRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SpectatorRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SpectatorRetention(ctx, req.(*SpectatorRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_ConfirmRaffleWinner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmRaffleWinnerRequest)
 	if err := dec(in); err != nil {
@@ -3577,110 +4764,200 @@ func _JungleTV_StopActivelyModerating_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_AdjustPointsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdjustPointsBalanceRequest)
+func _JungleTV_AdjustPointsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustPointsBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AdjustPointsBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AdjustPointsBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AdjustPointsBalance(ctx, req.(*AdjustPointsBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_AddVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVipUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).AddVipUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/AddVipUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).AddVipUser(ctx, req.(*AddVipUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_RemoveVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVipUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).RemoveVipUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/RemoveVipUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).RemoveVipUser(ctx, req.(*RemoveVipUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_TriggerClientReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerClientReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).TriggerClientReload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/TriggerClientReload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).TriggerClientReload(ctx, req.(*TriggerClientReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_SetMulticurrencyPaymentsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMulticurrencyPaymentsEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/SetMulticurrencyPaymentsEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, req.(*SetMulticurrencyPaymentsEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_InvalidateUserAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateUserAuthTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).AdjustPointsBalance(ctx, in)
+		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/AdjustPointsBalance",
+		FullMethod: "/jungletv.JungleTV/InvalidateUserAuthTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).AdjustPointsBalance(ctx, req.(*AdjustPointsBalanceRequest))
+		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, req.(*InvalidateUserAuthTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_AddVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVipUserRequest)
+func _JungleTV_ChatFilterRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatFilterRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).AddVipUser(ctx, in)
+		return srv.(JungleTVServer).ChatFilterRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/AddVipUser",
+		FullMethod: "/jungletv.JungleTV/ChatFilterRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).AddVipUser(ctx, req.(*AddVipUserRequest))
+		return srv.(JungleTVServer).ChatFilterRules(ctx, req.(*ChatFilterRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_RemoveVipUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveVipUserRequest)
+func _JungleTV_SetChatFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatFilterRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).RemoveVipUser(ctx, in)
+		return srv.(JungleTVServer).SetChatFilterRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/RemoveVipUser",
+		FullMethod: "/jungletv.JungleTV/SetChatFilterRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).RemoveVipUser(ctx, req.(*RemoveVipUserRequest))
+		return srv.(JungleTVServer).SetChatFilterRule(ctx, req.(*ChatFilterRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_TriggerClientReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerClientReloadRequest)
+func _JungleTV_RemoveChatFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatFilterRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).TriggerClientReload(ctx, in)
+		return srv.(JungleTVServer).RemoveChatFilterRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/TriggerClientReload",
+		FullMethod: "/jungletv.JungleTV/RemoveChatFilterRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).TriggerClientReload(ctx, req.(*TriggerClientReloadRequest))
+		return srv.(JungleTVServer).RemoveChatFilterRule(ctx, req.(*RemoveChatFilterRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_SetMulticurrencyPaymentsEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMulticurrencyPaymentsEnabledRequest)
+func _JungleTV_SetChatChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatChannel)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, in)
+		return srv.(JungleTVServer).SetChatChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/SetMulticurrencyPaymentsEnabled",
+		FullMethod: "/jungletv.JungleTV/SetChatChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).SetMulticurrencyPaymentsEnabled(ctx, req.(*SetMulticurrencyPaymentsEnabledRequest))
+		return srv.(JungleTVServer).SetChatChannel(ctx, req.(*ChatChannel))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JungleTV_InvalidateUserAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvalidateUserAuthTokensRequest)
+func _JungleTV_RemoveChatChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, in)
+		return srv.(JungleTVServer).RemoveChatChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/jungletv.JungleTV/InvalidateUserAuthTokens",
+		FullMethod: "/jungletv.JungleTV/RemoveChatChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JungleTVServer).InvalidateUserAuthTokens(ctx, req.(*InvalidateUserAuthTokensRequest))
+		return srv.(JungleTVServer).RemoveChatChannel(ctx, req.(*RemoveChatChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4015,6 +5292,60 @@ func _JungleTV_ImportApplication_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_ExportApplicationBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportApplicationBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ExportApplicationBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ExportApplicationBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ExportApplicationBundle(ctx, req.(*ExportApplicationBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_PreviewApplicationBundleImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewApplicationBundleImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).PreviewApplicationBundleImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/PreviewApplicationBundleImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).PreviewApplicationBundleImport(ctx, req.(*PreviewApplicationBundleImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ImportApplicationBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportApplicationBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ImportApplicationBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ImportApplicationBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ImportApplicationBundle(ctx, req.(*ImportApplicationBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_TypeScriptTypeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TypeScriptTypeDefinitionsRequest)
 	if err := dec(in); err != nil {
@@ -4033,6 +5364,135 @@ func _JungleTV_TypeScriptTypeDefinitions_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JungleTV_DebugApplication_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DebugApplicationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JungleTVServer).DebugApplication(m, &jungleTVDebugApplicationServer{stream})
+}
+
+type JungleTV_DebugApplicationServer interface {
+	Send(*ApplicationDebuggerEvent) error
+	grpc.ServerStream
+}
+
+type jungleTVDebugApplicationServer struct {
+	grpc.ServerStream
+}
+
+func (x *jungleTVDebugApplicationServer) Send(m *ApplicationDebuggerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JungleTV_ApplicationDebuggerCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDebuggerCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ApplicationDebuggerCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ApplicationDebuggerCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ApplicationDebuggerCommand(ctx, req.(*ApplicationDebuggerCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_StartApplicationProfiler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartApplicationProfilerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).StartApplicationProfiler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/StartApplicationProfiler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).StartApplicationProfiler(ctx, req.(*StartApplicationProfilerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_StopApplicationProfiler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopApplicationProfilerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).StopApplicationProfiler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/StopApplicationProfiler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).StopApplicationProfiler(ctx, req.(*StopApplicationProfilerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ApplicationHeapSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHeapSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ApplicationHeapSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ApplicationHeapSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ApplicationHeapSnapshot(ctx, req.(*ApplicationHeapSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_ApplicationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).ApplicationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/ApplicationSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).ApplicationSettings(ctx, req.(*ApplicationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JungleTV_UpdateApplicationSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JungleTVServer).UpdateApplicationSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jungletv.JungleTV/UpdateApplicationSetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JungleTVServer).UpdateApplicationSetting(ctx, req.(*UpdateApplicationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JungleTV_ResolveApplicationPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveApplicationPageRequest)
 	if err := dec(in); err != nil {
@@ -4143,6 +5603,54 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendChatMessage",
 			Handler:    _JungleTV_SendChatMessage_Handler,
 		},
+		{
+			MethodName: "EditChatMessage",
+			Handler:    _JungleTV_EditChatMessage_Handler,
+		},
+		{
+			MethodName: "ChatMessageThread",
+			Handler:    _JungleTV_ChatMessageThread_Handler,
+		},
+		{
+			MethodName: "ChatChannels",
+			Handler:    _JungleTV_ChatChannels_Handler,
+		},
+		{
+			MethodName: "MarkChatRepliesAsRead",
+			Handler:    _JungleTV_MarkChatRepliesAsRead_Handler,
+		},
+		{
+			MethodName: "SendDirectMessage",
+			Handler:    _JungleTV_SendDirectMessage_Handler,
+		},
+		{
+			MethodName: "DirectMessageConversation",
+			Handler:    _JungleTV_DirectMessageConversation_Handler,
+		},
+		{
+			MethodName: "SetDirectMessagesEnabled",
+			Handler:    _JungleTV_SetDirectMessagesEnabled_Handler,
+		},
+		{
+			MethodName: "ReportDirectMessageConversation",
+			Handler:    _JungleTV_ReportDirectMessageConversation_Handler,
+		},
+		{
+			MethodName: "AddChatMessageReaction",
+			Handler:    _JungleTV_AddChatMessageReaction_Handler,
+		},
+		{
+			MethodName: "RemoveChatMessageReaction",
+			Handler:    _JungleTV_RemoveChatMessageReaction_Handler,
+		},
+		{
+			MethodName: "ReportChatMessage",
+			Handler:    _JungleTV_ReportChatMessage_Handler,
+		},
+		{
+			MethodName: "VoteInChatPoll",
+			Handler:    _JungleTV_VoteInChatPoll_Handler,
+		},
 		{
 			MethodName: "UserPermissionLevel",
 			Handler:    _JungleTV_UserPermissionLevel_Handler,
@@ -4295,6 +5803,26 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBan",
 			Handler:    _JungleTV_RemoveBan_Handler,
 		},
+		{
+			MethodName: "ChatMutes",
+			Handler:    _JungleTV_ChatMutes_Handler,
+		},
+		{
+			MethodName: "MuteChatUser",
+			Handler:    _JungleTV_MuteChatUser_Handler,
+		},
+		{
+			MethodName: "UnmuteChatUser",
+			Handler:    _JungleTV_UnmuteChatUser_Handler,
+		},
+		{
+			MethodName: "ResolveChatMessageReports",
+			Handler:    _JungleTV_ResolveChatMessageReports_Handler,
+		},
+		{
+			MethodName: "CreateChatPoll",
+			Handler:    _JungleTV_CreateChatPoll_Handler,
+		},
 		{
 			MethodName: "UserVerifications",
 			Handler:    _JungleTV_UserVerifications_Handler,
@@ -4311,6 +5839,22 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserChatMessages",
 			Handler:    _JungleTV_UserChatMessages_Handler,
 		},
+		{
+			MethodName: "SearchChatMessages",
+			Handler:    _JungleTV_SearchChatMessages_Handler,
+		},
+		{
+			MethodName: "DirectMessageReports",
+			Handler:    _JungleTV_DirectMessageReports_Handler,
+		},
+		{
+			MethodName: "ReportedDirectMessageConversation",
+			Handler:    _JungleTV_ReportedDirectMessageConversation_Handler,
+		},
+		{
+			MethodName: "ChatMessageContext",
+			Handler:    _JungleTV_ChatMessageContext_Handler,
+		},
 		{
 			MethodName: "DisallowedMedia",
 			Handler:    _JungleTV_DisallowedMedia_Handler,
@@ -4363,6 +5907,34 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSkipPriceMultiplier",
 			Handler:    _JungleTV_SetSkipPriceMultiplier_Handler,
 		},
+		{
+			MethodName: "SetRewardDistributionPolicy",
+			Handler:    _JungleTV_SetRewardDistributionPolicy_Handler,
+		},
+		{
+			MethodName: "SimulateRewardDistribution",
+			Handler:    _JungleTV_SimulateRewardDistribution_Handler,
+		},
+		{
+			MethodName: "SetActivityChallengeSelectionPolicy",
+			Handler:    _JungleTV_SetActivityChallengeSelectionPolicy_Handler,
+		},
+		{
+			MethodName: "SegchaPuzzleStatistics",
+			Handler:    _JungleTV_SegchaPuzzleStatistics_Handler,
+		},
+		{
+			MethodName: "SetSegchaAdaptiveDifficultyEnabled",
+			Handler:    _JungleTV_SetSegchaAdaptiveDifficultyEnabled_Handler,
+		},
+		{
+			MethodName: "SpectatorActivity",
+			Handler:    _JungleTV_SpectatorActivity_Handler,
+		},
+		{
+			MethodName: "SpectatorRetention",
+			Handler:    _JungleTV_SpectatorRetention_Handler,
+		},
 		{
 			MethodName: "ConfirmRaffleWinner",
 			Handler:    _JungleTV_ConfirmRaffleWinner_Handler,
@@ -4447,6 +6019,26 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateUserAuthTokens",
 			Handler:    _JungleTV_InvalidateUserAuthTokens_Handler,
 		},
+		{
+			MethodName: "ChatFilterRules",
+			Handler:    _JungleTV_ChatFilterRules_Handler,
+		},
+		{
+			MethodName: "SetChatFilterRule",
+			Handler:    _JungleTV_SetChatFilterRule_Handler,
+		},
+		{
+			MethodName: "RemoveChatFilterRule",
+			Handler:    _JungleTV_RemoveChatFilterRule_Handler,
+		},
+		{
+			MethodName: "SetChatChannel",
+			Handler:    _JungleTV_SetChatChannel_Handler,
+		},
+		{
+			MethodName: "RemoveChatChannel",
+			Handler:    _JungleTV_RemoveChatChannel_Handler,
+		},
 		{
 			MethodName: "Applications",
 			Handler:    _JungleTV_Applications_Handler,
@@ -4511,10 +6103,46 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportApplication",
 			Handler:    _JungleTV_ImportApplication_Handler,
 		},
+		{
+			MethodName: "ExportApplicationBundle",
+			Handler:    _JungleTV_ExportApplicationBundle_Handler,
+		},
+		{
+			MethodName: "PreviewApplicationBundleImport",
+			Handler:    _JungleTV_PreviewApplicationBundleImport_Handler,
+		},
+		{
+			MethodName: "ImportApplicationBundle",
+			Handler:    _JungleTV_ImportApplicationBundle_Handler,
+		},
 		{
 			MethodName: "TypeScriptTypeDefinitions",
 			Handler:    _JungleTV_TypeScriptTypeDefinitions_Handler,
 		},
+		{
+			MethodName: "ApplicationDebuggerCommand",
+			Handler:    _JungleTV_ApplicationDebuggerCommand_Handler,
+		},
+		{
+			MethodName: "StartApplicationProfiler",
+			Handler:    _JungleTV_StartApplicationProfiler_Handler,
+		},
+		{
+			MethodName: "StopApplicationProfiler",
+			Handler:    _JungleTV_StopApplicationProfiler_Handler,
+		},
+		{
+			MethodName: "ApplicationHeapSnapshot",
+			Handler:    _JungleTV_ApplicationHeapSnapshot_Handler,
+		},
+		{
+			MethodName: "ApplicationSettings",
+			Handler:    _JungleTV_ApplicationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationSetting",
+			Handler:    _JungleTV_UpdateApplicationSetting_Handler,
+		},
 		{
 			MethodName: "ResolveApplicationPage",
 			Handler:    _JungleTV_ResolveApplicationPage_Handler,
@@ -4559,6 +6187,11 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JungleTV_ConsumeChat_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ConsumeDirectMessages",
+			Handler:       _JungleTV_ConsumeDirectMessages_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ConvertBananoToPoints",
 			Handler:       _JungleTV_ConvertBananoToPoints_Handler,
@@ -4589,6 +6222,11 @@ var JungleTV_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JungleTV_MonitorRunningApplications_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DebugApplication",
+			Handler:       _JungleTV_DebugApplication_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ConsumeApplicationEvents",
 			Handler:       _JungleTV_ConsumeApplicationEvents_Handler,
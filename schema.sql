@@ -1,3 +1,6 @@
+DROP TABLE IF EXISTS "spectator_session";
+DROP TABLE IF EXISTS "segcha_step_statistics";
+DROP TABLE IF EXISTS "application_setting";
 DROP TABLE IF EXISTS "application_value";
 DROP TABLE IF EXISTS "application_file";
 DROP TABLE IF EXISTS "application";
@@ -16,6 +19,8 @@ DROP TABLE IF EXISTS "connection";
 DROP TABLE IF EXISTS "connection_service";
 DROP TABLE IF EXISTS "counter";
 DROP TABLE IF EXISTS "banned_user";
+DROP TABLE IF EXISTS "chat_mute";
+DROP TABLE IF EXISTS "chat_mute_source";
 DROP TABLE IF EXISTS "raffle_drawing";
 DROP TABLE IF EXISTS "raffle_drawing_status";
 DROP TABLE IF EXISTS "crowdfunded_transaction";
@@ -24,11 +29,25 @@ DROP TABLE IF EXISTS "withdrawal";
 DROP TABLE IF EXISTS "pending_withdrawal";
 DROP TABLE IF EXISTS "reward_balance";
 DROP TABLE IF EXISTS "received_reward";
+DROP TABLE IF EXISTS "chat_poll_vote";
+DROP TABLE IF EXISTS "chat_poll";
+DROP TABLE IF EXISTS "chat_poll_voting_mode";
+DROP TABLE IF EXISTS "chat_message_report";
+DROP TABLE IF EXISTS "chat_message_report_reason";
+DROP TABLE IF EXISTS "chat_message_report_resolution";
+DROP TABLE IF EXISTS "chat_message_reaction";
+DROP TABLE IF EXISTS "chat_message_revision";
 DROP TABLE IF EXISTS "chat_message";
+DROP TABLE IF EXISTS "chat_channel";
+DROP TABLE IF EXISTS "direct_message_report";
+DROP TABLE IF EXISTS "direct_message";
 DROP TABLE IF EXISTS "chat_user";
 DROP TABLE IF EXISTS "document";
 DROP TABLE IF EXISTS "disallowed_media_collections";
 DROP TABLE IF EXISTS "media_collection_type";
+DROP TABLE IF EXISTS "chat_filter_rule";
+DROP TABLE IF EXISTS "chat_filter_rule_type";
+DROP TABLE IF EXISTS "chat_filter_action";
 DROP TABLE IF EXISTS "disallowed_media";
 DROP TABLE IF EXISTS "played_media";
 DROP TABLE IF EXISTS "media_type";
@@ -78,6 +97,27 @@ CREATE TABLE IF NOT EXISTS "disallowed_media_collection" (
     collection_title VARCHAR(150) NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS "chat_filter_rule_type" (
+    rule_type VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "chat_filter_rule_type" VALUES ('regex'), ('word_list'), ('link_domain'), ('mention_spam'), ('character_flood'), ('scam_address');
+
+CREATE TABLE IF NOT EXISTS "chat_filter_action" (
+    "action" VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "chat_filter_action" VALUES ('reject'), ('hide'), ('flag'), ('mute');
+
+CREATE TABLE IF NOT EXISTS "chat_filter_rule" (
+    id VARCHAR(36) PRIMARY KEY,
+    rule_type VARCHAR(20) NOT NULL REFERENCES chat_filter_rule_type (rule_type),
+    parameter TEXT NOT NULL,
+    "action" VARCHAR(10) NOT NULL REFERENCES chat_filter_action ("action"),
+    "description" TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_by VARCHAR(64) NOT NULL
+);
+
 CREATE TABLE IF NOT EXISTS "document" (
     id VARCHAR(36) NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
@@ -92,9 +132,23 @@ CREATE TABLE IF NOT EXISTS "chat_user" (
     "address" VARCHAR(64) PRIMARY KEY,
     permission_level VARCHAR(36) NOT NULL,
     nickname VARCHAR(32),
-    "application_id" VARCHAR(36)
+    "application_id" VARCHAR(36),
+    replies_read_at TIMESTAMP WITH TIME ZONE,
+    direct_messages_enabled BOOLEAN NOT NULL DEFAULT FALSE
 );
 
+CREATE TABLE IF NOT EXISTS "chat_channel" (
+    id VARCHAR(36) PRIMARY KEY,
+    title VARCHAR(64) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    slowmode BOOLEAN NOT NULL,
+    required_permission_level VARCHAR(36) NOT NULL,
+    application_id VARCHAR(36),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_by VARCHAR(64) NOT NULL
+);
+INSERT INTO "chat_channel" VALUES ('main', 'Main', TRUE, FALSE, '', NULL, NOW(), '');
+
 CREATE TABLE IF NOT EXISTS "chat_message" (
     id BIGINT PRIMARY KEY,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL,
@@ -102,16 +156,118 @@ CREATE TABLE IF NOT EXISTS "chat_message" (
     content TEXT NOT NULL,
     reference BIGINT REFERENCES chat_message (id),
     shadowbanned BOOLEAN NOT NULL,
-    attachments TEXT[] NOT NULL
+    attachments TEXT[] NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE,
+    channel VARCHAR(36) NOT NULL DEFAULT 'main' REFERENCES chat_channel (id)
 );
 CREATE INDEX index_created_at_on_chat_message ON chat_message USING BTREE (created_at);
+CREATE INDEX index_channel_created_at_on_chat_message ON chat_message USING BTREE (channel, created_at);
+CREATE INDEX index_reference_on_chat_message ON chat_message USING BTREE (reference);
+CREATE INDEX index_content_fts_on_chat_message ON chat_message USING GIN (to_tsvector('simple', content));
+
+CREATE TABLE IF NOT EXISTS "chat_message_reaction" (
+    message_id BIGINT NOT NULL REFERENCES chat_message (id) ON DELETE CASCADE,
+    reactor VARCHAR(64) NOT NULL,
+    reaction VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (message_id, reactor, reaction)
+);
+
+CREATE TABLE IF NOT EXISTS "direct_message" (
+    id BIGINT PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    author VARCHAR(64) NOT NULL REFERENCES chat_user ("address"),
+    recipient VARCHAR(64) NOT NULL,
+    content TEXT NOT NULL,
+    shadowbanned BOOLEAN NOT NULL
+);
+CREATE INDEX index_participants_on_direct_message ON direct_message USING BTREE (LEAST(author, recipient), GREATEST(author, recipient), created_at);
+CREATE INDEX index_recipient_on_direct_message ON direct_message USING BTREE (recipient);
+
+CREATE TABLE IF NOT EXISTS "direct_message_report" (
+    id VARCHAR(36) PRIMARY KEY,
+    reported_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reporter VARCHAR(64) NOT NULL,
+    reported VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    reviewed_by VARCHAR(64)
+);
+
+CREATE TABLE IF NOT EXISTS "chat_poll_voting_mode" (
+    voting_mode VARCHAR(10) PRIMARY KEY
+);
+INSERT INTO "chat_poll_voting_mode" VALUES ('single'), ('points');
+
+CREATE TABLE IF NOT EXISTS "chat_poll" (
+    id VARCHAR(36) PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by VARCHAR(64) NOT NULL,
+    channel VARCHAR(36) NOT NULL REFERENCES chat_channel (id) ON DELETE CASCADE,
+    message_id BIGINT, -- nullable, set once the message carrying the poll is created
+    question TEXT NOT NULL,
+    options TEXT[] NOT NULL,
+    voting_mode VARCHAR(10) NOT NULL REFERENCES chat_poll_voting_mode (voting_mode),
+    points_per_vote INTEGER NOT NULL,
+    closes_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    closed BOOLEAN NOT NULL
+);
+CREATE INDEX index_closed_closes_at_on_chat_poll ON chat_poll USING BTREE (closed, closes_at);
+
+CREATE TABLE IF NOT EXISTS "chat_poll_vote" (
+    poll_id VARCHAR(36) NOT NULL REFERENCES chat_poll (id) ON DELETE CASCADE,
+    voter VARCHAR(64) NOT NULL,
+    option_index INTEGER NOT NULL,
+    votes INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    single_vote BOOLEAN NOT NULL, -- whether the poll only allows each voter to vote once
+    PRIMARY KEY (poll_id, voter, option_index)
+);
+CREATE UNIQUE INDEX index_single_vote_on_chat_poll_vote ON chat_poll_vote USING BTREE (poll_id, voter) WHERE single_vote;
+
+CREATE TABLE IF NOT EXISTS "chat_message_report_reason" (
+    reason VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "chat_message_report_reason" VALUES ('spam'), ('harassment'), ('hate_speech'), ('scam'), ('inappropriate'), ('other');
+
+CREATE TABLE IF NOT EXISTS "chat_message_report_resolution" (
+    resolution VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "chat_message_report_resolution" VALUES ('dismissed'), ('deleted'), ('banned');
+
+-- reports do not reference chat_message so that they survive the deletion of the reported message
+CREATE TABLE IF NOT EXISTS "chat_message_report" (
+    id VARCHAR(36) PRIMARY KEY,
+    message_id BIGINT NOT NULL,
+    message_author VARCHAR(64) NOT NULL,
+    message_content TEXT NOT NULL,
+    reporter VARCHAR(64) NOT NULL,
+    reported_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason VARCHAR(20) NOT NULL REFERENCES chat_message_report_reason (reason),
+    comment TEXT NOT NULL,
+    trusted_reporter BOOLEAN NOT NULL,
+    caused_hiding BOOLEAN NOT NULL DEFAULT FALSE,
+    resolved_at TIMESTAMP WITH TIME ZONE, -- nullable
+    resolved_by VARCHAR(64), -- nullable
+    resolution VARCHAR(20) REFERENCES chat_message_report_resolution (resolution), -- nullable
+    UNIQUE (message_id, reporter)
+);
+CREATE INDEX index_unresolved_on_chat_message_report ON chat_message_report USING BTREE (message_id) WHERE resolved_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS "chat_message_revision" (
+    message_id BIGINT NOT NULL REFERENCES chat_message (id) ON DELETE CASCADE,
+    superseded_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    content TEXT NOT NULL,
+    PRIMARY KEY (message_id, superseded_at)
+);
 
 CREATE TABLE IF NOT EXISTS "received_reward" (
     id VARCHAR(36) PRIMARY KEY,
     rewards_address VARCHAR(64) NOT NULL,
     received_at TIMESTAMP WITH TIME ZONE NOT NULL,
     amount NUMERIC(39, 0) NOT NULL,
-    media VARCHAR(36) NOT NULL REFERENCES played_media (id)
+    media VARCHAR(36) NOT NULL REFERENCES played_media (id),
+    policy VARCHAR(32) NOT NULL DEFAULT 'equal_split'
 );
 CREATE INDEX index_rewards_address_on_received_reward ON received_reward USING HASH (rewards_address);
 CREATE INDEX index_received_at_on_received_reward ON received_reward USING BTREE (received_at);
@@ -230,6 +386,26 @@ CREATE TABLE IF NOT EXISTS "banned_user" (
     moderator_name VARCHAR(32) NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS "chat_mute_source" (
+    "source" VARCHAR(20) PRIMARY KEY
+);
+INSERT INTO "chat_mute_source" VALUES ('moderator'), ('content_filter'), ('application');
+
+CREATE TABLE IF NOT EXISTS "chat_mute" (
+    id VARCHAR(36) PRIMARY KEY,
+    "address" VARCHAR(64) NOT NULL,
+    muted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    muted_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    "source" VARCHAR(20) NOT NULL REFERENCES chat_mute_source ("source"),
+    reason TEXT NOT NULL,
+    moderator_address VARCHAR(64) NOT NULL,
+    moderator_name VARCHAR(64) NOT NULL,
+    lifted_at TIMESTAMP WITH TIME ZONE, -- nullable
+    lifted_by VARCHAR(64), -- nullable
+    lift_reason TEXT NOT NULL
+);
+CREATE INDEX index_address_muted_at_on_chat_mute ON chat_mute USING BTREE ("address", muted_at);
+
 CREATE TABLE IF NOT EXISTS "counter" (
     counter_name VARCHAR(36) PRIMARY KEY,
     counter_value INTEGER NOT NULL,
@@ -315,7 +491,8 @@ INSERT INTO "points_tx_type" VALUES
     (10, 'skip_threshold_reduction'),
     (11, 'skip_threshold_increase'),
     (12, 'concealed_entry_enqueuing'),
-    (13, 'application_defined');
+    (13, 'application_defined'),
+    (14, 'chat_poll_vote');
 
 CREATE TABLE IF NOT EXISTS "points_tx" (
     id BIGINT PRIMARY KEY,
@@ -381,8 +558,43 @@ CREATE TABLE IF NOT EXISTS "application_value" (
     PRIMARY KEY (application_id, "key")
 );
 
+CREATE TABLE IF NOT EXISTS "application_setting" (
+    application_id VARCHAR(36) NOT NULL,
+    "key" VARCHAR(128) NOT NULL,
+    "value" TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_by VARCHAR(64) NOT NULL,
+    PRIMARY KEY (application_id, "key")
+);
+
 CREATE TABLE IF NOT EXISTS "user_jwt_claim_season" (
     "address" VARCHAR(64) PRIMARY KEY,
     season INTEGER NOT NULL,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS "segcha_step_statistics" (
+    "day" DATE NOT NULL,
+    puzzle_family VARCHAR(64) NOT NULL,
+    picture_category VARCHAR(64) NOT NULL,
+    answered INTEGER NOT NULL,
+    correct INTEGER NOT NULL,
+    legitimate_answered INTEGER NOT NULL,
+    legitimate_correct INTEGER NOT NULL,
+    total_solve_time_ms BIGINT NOT NULL,
+    PRIMARY KEY ("day", puzzle_family)
+);
+
+CREATE TABLE IF NOT EXISTS "spectator_session" (
+    id VARCHAR(36) PRIMARY KEY,
+    rewards_address VARCHAR(64) NOT NULL,
+    remote_address_hash VARCHAR(64) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    media_watched INTEGER NOT NULL,
+    challenges_passed INTEGER NOT NULL,
+    challenges_failed INTEGER NOT NULL,
+    rewards_earned NUMERIC(39, 0) NOT NULL
+);
+CREATE INDEX index_started_at_on_spectator_session ON spectator_session USING BTREE (started_at);
+CREATE INDEX index_rewards_address_and_started_at_on_spectator_session ON spectator_session USING BTREE (rewards_address, started_at);
@@ -0,0 +1,57 @@
+package segcha
+
+// adaptedWeightScale multiplies the weights of families before they are reduced, so that reductions retain some
+// precision when the original weights are small
+const adaptedWeightScale = 100
+
+// AdaptiveWeightsParams controls how AdaptFamilyWeights reacts to the failure rates of legitimate users
+type AdaptiveWeightsParams struct {
+	// MinSamples is how many steps of a family must have been answered by legitimate users for its weight to change
+	MinSamples int
+	// ReweighFailureRate is the failure rate above which a family starts being picked less often
+	ReweighFailureRate float64
+	// DropFailureRate is the failure rate at which a family stops being picked
+	DropFailureRate float64
+}
+
+// DefaultAdaptiveWeightsParams are reasonable parameters for AdaptFamilyWeights
+var DefaultAdaptiveWeightsParams = AdaptiveWeightsParams{
+	MinSamples:         100,
+	ReweighFailureRate: 0.15,
+	DropFailureRate:    0.4,
+}
+
+// AdaptFamilyWeights returns new weights based on the provided ones (nil meaning all registered families are equally
+// likely), where the families that legitimate users fail too often are picked less often, in proportion to how far
+// their failure rate is between params.ReweighFailureRate and params.DropFailureRate, or not picked at all once it
+// reaches params.DropFailureRate.
+// If no family would remain pickable, the provided weights are returned unchanged
+func AdaptFamilyWeights(weights FamilyWeights, statistics map[string]FamilyStatistics, params AdaptiveWeightsParams) FamilyWeights {
+	adapted := make(FamilyWeights)
+	anyPickable := false
+	for _, name := range PuzzleFamilyNames() {
+		weight := 1
+		if weights != nil {
+			weight = weights[name]
+		}
+		weight *= adaptedWeightScale
+
+		if s, ok := statistics[name]; ok && s.LegitimateAnswered >= params.MinSamples {
+			rate := s.LegitimateFailureRate()
+			switch {
+			case rate >= params.DropFailureRate:
+				weight = 0
+			case rate > params.ReweighFailureRate:
+				factor := (params.DropFailureRate - rate) / (params.DropFailureRate - params.ReweighFailureRate)
+				weight = max(int(float64(weight)*factor), min(weight, 1))
+			}
+		}
+
+		adapted[name] = weight
+		anyPickable = anyPickable || weight > 0
+	}
+	if !anyPickable {
+		return weights
+	}
+	return adapted
+}
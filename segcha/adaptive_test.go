@@ -0,0 +1,52 @@
+package segcha_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tnyim/jungletv/segcha"
+)
+
+func TestAdaptFamilyWeights(t *testing.T) {
+	params := segcha.AdaptiveWeightsParams{
+		MinSamples:         100,
+		ReweighFailureRate: 0.25,
+		DropFailureRate:    0.75,
+	}
+	weights := segcha.FamilyWeights{
+		"simple_math":   2,
+		"select_photo":  1,
+		"select_cgi":    1,
+		"count_objects": 1,
+	}
+	statistics := map[string]segcha.FamilyStatistics{
+		// failure rate below the reweigh threshold
+		"simple_math": {LegitimateAnswered: 200, LegitimateCorrect: 180},
+		// failure rate halfway between the thresholds
+		"select_photo": {LegitimateAnswered: 100, LegitimateCorrect: 50},
+		// failure rate at the drop threshold
+		"select_cgi": {LegitimateAnswered: 100, LegitimateCorrect: 25},
+		// too few samples to judge, even though the failure rate is high
+		"count_objects": {LegitimateAnswered: 99, LegitimateCorrect: 0},
+		// failures by users who aren't believed to be legitimate don't count
+		"select_upright": {Answered: 1000, LegitimateAnswered: 0},
+	}
+
+	adapted := segcha.AdaptFamilyWeights(weights, statistics, params)
+	require.Len(t, adapted, len(segcha.PuzzleFamilyNames()))
+	require.Equal(t, 200, adapted["simple_math"])
+	require.Equal(t, 50, adapted["select_photo"])
+	require.Equal(t, 0, adapted["select_cgi"])
+	require.Equal(t, 100, adapted["count_objects"])
+	require.Equal(t, 0, adapted["select_upright"])
+
+	// without weights, all registered families start equally likely
+	adapted = segcha.AdaptFamilyWeights(nil, statistics, params)
+	require.Equal(t, 100, adapted["select_upright"])
+	require.Equal(t, 50, adapted["select_photo"])
+	require.Equal(t, 0, adapted["select_cgi"])
+
+	// the original weights are kept if every family would be dropped
+	weights = segcha.FamilyWeights{"select_cgi": 1}
+	require.Equal(t, weights, segcha.AdaptFamilyWeights(weights, statistics, params))
+}
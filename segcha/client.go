@@ -2,6 +2,9 @@ package segcha
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/segcha/segchaproto"
@@ -22,22 +25,73 @@ func NewClient(ctx context.Context, address string) (segchaproto.SegchaClient, f
 }
 
 // NewChallengeUsingClient requests the generation of a challenge using the provided client and returns it
-func NewChallengeUsingClient(ctx context.Context, steps int, client segchaproto.SegchaClient) (*Challenge, error) {
+func NewChallengeUsingClient(ctx context.Context, steps int, weights FamilyWeights, client segchaproto.SegchaClient) (*Challenge, error) {
 	response, err := client.GenerateChallenge(ctx, &segchaproto.GenerateChallengeRequest{
-		NumSteps: uint32(steps),
+		NumSteps:      uint32(steps),
+		FamilyWeights: weightsToProto(weights),
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
+	return challengeFromProto(response), nil
+}
+
+// FillPoolUsingClient requests the generation of a batch of up to count challenges using the provided client, adding
+// them to pool as they are received. It returns the number of challenges added to the pool, which may be non-zero even
+// when an error is returned
+func FillPoolUsingClient(ctx context.Context, steps int, weights FamilyWeights, count int, client segchaproto.SegchaClient, pool *Pool) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.GenerateChallengeBatch(ctx, &segchaproto.GenerateChallengeBatchRequest{
+		NumSteps:      uint32(steps),
+		FamilyWeights: weightsToProto(weights),
+		Count:         uint32(count),
+	})
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+
+	added := 0
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return added, nil
+		}
+		if err != nil {
+			return added, stacktrace.Propagate(err, "")
+		}
+		if !pool.Put(challengeFromProto(response)) {
+			// pool filled up in the meantime, stop generating challenges that would be discarded
+			return added, nil
+		}
+		added++
+	}
+}
+
+func weightsToProto(weights FamilyWeights) map[string]uint32 {
+	if weights == nil {
+		return nil
+	}
+	protoWeights := make(map[string]uint32, len(weights))
+	for name, weight := range weights {
+		protoWeights[name] = uint32(weight)
+	}
+	return protoWeights
+}
+
+func challengeFromProto(response *segchaproto.Challenge) *Challenge {
 	answers := make([]int, len(response.Answers))
 	for i := range response.Answers {
 		answers[i] = int(response.Answers[i])
 	}
 
 	return &Challenge{
-		id:      response.Id,
-		pics:    response.Pictures,
-		answers: answers,
-	}, nil
+		id:        response.Id,
+		pics:      response.Pictures,
+		answers:   answers,
+		families:  response.Families,
+		createdAt: time.Now(),
+	}
 }
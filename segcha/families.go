@@ -0,0 +1,422 @@
+package segcha
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anthonynsimon/bild/noise"
+	"github.com/disintegration/imaging"
+	"github.com/fogleman/gg"
+	"github.com/palantir/stacktrace"
+)
+
+// PuzzleEnvironment contains the resources puzzle families can use to create pictures
+type PuzzleEnvironment struct {
+	ImageDB  *ImageDatabase
+	FontPath string
+}
+
+// PuzzleFamily creates one kind of challenge step, where the user must pick one out of four pictures
+type PuzzleFamily interface {
+	// Name returns the unique identifier of the family
+	Name() string
+	// PictureCategory returns the category of the pictures users must recognize to solve the steps created by the
+	// family, for analytics purposes
+	PictureCategory() string
+	// CreatePictures returns the four pictures of a step and the instructions shown above them.
+	// The picture at index answer must be the only one satisfying the instructions.
+	// Pictures are subsequently scaled to 350 pixels on the shortest side, slightly rotated and cropped to the central
+	// 300x300 pixels, so anything relevant for the answer must be near their center
+	CreatePictures(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error)
+}
+
+// FamilyWeights maps puzzle family names to how often each should be picked, relative to the others
+type FamilyWeights map[string]int
+
+// ParseFamilyWeights parses weights in the format "family1=weight1,family2=weight2"
+func ParseFamilyWeights(s string) (FamilyWeights, error) {
+	weights := make(FamilyWeights)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weightString, found := strings.Cut(part, "=")
+		if !found {
+			return nil, stacktrace.NewError("missing weight for puzzle family %s", part)
+		}
+		name = strings.TrimSpace(name)
+		if _, ok := puzzleFamilies[name]; !ok {
+			return nil, stacktrace.NewError("unknown puzzle family %s", name)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(weightString))
+		if err != nil || weight < 0 {
+			return nil, stacktrace.NewError("invalid weight for puzzle family %s", name)
+		}
+		weights[name] = weight
+	}
+	return weights, nil
+}
+
+var puzzleFamilies = map[string]PuzzleFamily{}
+
+// RegisterPuzzleFamily makes a puzzle family available for use in challenges. Registering a family with the same name
+// as an existing one replaces the existing family
+func RegisterPuzzleFamily(family PuzzleFamily) {
+	puzzleFamilies[family.Name()] = family
+}
+
+// PickProbabilities returns the probability of each registered puzzle family being picked for a step
+func (w FamilyWeights) PickProbabilities() map[string]float64 {
+	families := pickableFamilies(w)
+	total := 0
+	for _, f := range families {
+		total += f.weight
+	}
+	probabilities := make(map[string]float64, len(families))
+	for _, f := range families {
+		probabilities[f.family.Name()] = float64(f.weight) / float64(total)
+	}
+	return probabilities
+}
+
+// PuzzleFamilyPictureCategory returns the picture category of the registered puzzle family with the given name
+func PuzzleFamilyPictureCategory(name string) (string, bool) {
+	family, ok := puzzleFamilies[name]
+	if !ok {
+		return "", false
+	}
+	return family.PictureCategory(), true
+}
+
+// PuzzleFamilyNames returns the names of all registered puzzle families, sorted
+func PuzzleFamilyNames() []string {
+	names := make([]string, 0, len(puzzleFamilies))
+	for name := range puzzleFamilies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type weightedFamily struct {
+	family PuzzleFamily
+	weight int
+}
+
+// pickableFamilies returns the registered families with a non-zero weight, in a deterministic order
+func pickableFamilies(weights FamilyWeights) []weightedFamily {
+	families := []weightedFamily{}
+	for _, name := range PuzzleFamilyNames() {
+		weight := 1
+		if weights != nil {
+			weight = weights[name]
+		}
+		if weight > 0 {
+			families = append(families, weightedFamily{puzzleFamilies[name], weight})
+		}
+	}
+	return families
+}
+
+func pickFamily(rng *rand.Rand, families []weightedFamily) PuzzleFamily {
+	total := 0
+	for _, f := range families {
+		total += f.weight
+	}
+	n := rng.Intn(total)
+	for _, f := range families {
+		if n < f.weight {
+			return f.family
+		}
+		n -= f.weight
+	}
+	return families[len(families)-1].family
+}
+
+type puzzleFamilyFunc struct {
+	name     string
+	category string
+	fn       func(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error)
+}
+
+func (f *puzzleFamilyFunc) Name() string {
+	return f.name
+}
+
+func (f *puzzleFamilyFunc) PictureCategory() string {
+	return f.category
+}
+
+func (f *puzzleFamilyFunc) CreatePictures(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	return f.fn(rng, env, answer)
+}
+
+// oddOneOut builds a family where the picture at the answer index comes from one source and the others from another
+func oddOneOut(name, category, instructions string,
+	answerPic func(*ImageDatabase, *rand.Rand) (image.Image, error),
+	otherPic func(*ImageDatabase, *rand.Rand) (image.Image, error)) PuzzleFamily {
+	return &puzzleFamilyFunc{
+		name:     name,
+		category: category,
+		fn: func(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+			pics := make([]image.Image, 4)
+			var err error
+			for i := 0; i < 4; i++ {
+				if i == answer {
+					pics[i], err = answerPic(env.ImageDB, rng)
+				} else {
+					pics[i], err = otherPic(env.ImageDB, rng)
+				}
+				if err != nil {
+					return nil, "", stacktrace.Propagate(err, "")
+				}
+			}
+			return pics, instructions, nil
+		},
+	}
+}
+
+func init() {
+	RegisterPuzzleFamily(oddOneOut("select_cgi", "cgi", "Select the picture that is NOT a photo.",
+		(*ImageDatabase).GetCGIPicture, (*ImageDatabase).GetPhotoPicture))
+	RegisterPuzzleFamily(oddOneOut("select_photo", "photo", "Select the picture that is a photo.",
+		(*ImageDatabase).GetPhotoPicture,
+		func(db *ImageDatabase, rng *rand.Rand) (image.Image, error) {
+			pic, err := db.GetCGIPicture(rng)
+			if err == nil && rng.Intn(3) < 1 {
+				pic = imaging.Invert(pic)
+			}
+			return pic, err
+		}))
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"select_upside_down", "orientable", selectUpsideDown})
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"select_upright", "orientable", selectUpright})
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"select_corrupted", "any", selectCorrupted})
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"simple_math", "number", simpleMath})
+	RegisterPuzzleFamily(oddOneOut("select_unbroken_glass", "glass", "Select the picture without broken glass.",
+		(*ImageDatabase).GetUnbrokenGlassPicture, (*ImageDatabase).GetBrokenGlassPicture))
+	RegisterPuzzleFamily(oddOneOut("select_broken_glass", "brokenglass", "Select the picture with broken glass.",
+		(*ImageDatabase).GetBrokenGlassPicture, (*ImageDatabase).GetUnbrokenGlassPicture))
+	RegisterPuzzleFamily(oddOneOut("select_glass_bottle", "glassbottle", "Select the unbroken glass bottle.",
+		(*ImageDatabase).GetGlassBottlePicture,
+		func(db *ImageDatabase, rng *rand.Rand) (image.Image, error) {
+			if rng.Intn(2) < 1 {
+				return db.GetGlassPicture(rng)
+			}
+			return db.GetBrokenGlassPicture(rng)
+		}))
+	RegisterPuzzleFamily(oddOneOut("select_glass_cup", "glass", "Select the unbroken glass cup.",
+		(*ImageDatabase).GetGlassPicture,
+		func(db *ImageDatabase, rng *rand.Rand) (image.Image, error) {
+			if rng.Intn(2) < 1 {
+				return db.GetGlassBottlePicture(rng)
+			}
+			return db.GetBrokenGlassPicture(rng)
+		}))
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"rotate_to_upright", "orientable", rotateToUpright})
+	RegisterPuzzleFamily(&puzzleFamilyFunc{"count_objects", "sprite", countObjects})
+}
+
+func selectUpsideDown(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	pics := make([]image.Image, 4)
+	for i := 0; i < 4; i++ {
+		var err error
+		pics[i], err = env.ImageDB.GetOrientablePicture(rng)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+		if i == answer {
+			pics[i] = imaging.Rotate180(pics[i])
+		}
+	}
+	return pics, "Select the picture that is upside down.", nil
+}
+
+func selectUpright(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	pics := make([]image.Image, 4)
+	for i := 0; i < 4; i++ {
+		var err error
+		pics[i], err = env.ImageDB.GetOrientablePicture(rng)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+		if i != answer {
+			pics[i] = imaging.Rotate180(pics[i])
+		}
+	}
+	return pics, "Select the picture that is NOT upside down.", nil
+}
+
+func selectCorrupted(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	pics := make([]image.Image, 4)
+	var err error
+	for i := 0; i < 4; i++ {
+		pics[i], err = env.ImageDB.GetAnyPicture(rng)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+		if i == answer {
+			pics[i] = imaging.Resize(pics[i], 600, 1000+rng.Intn(5000), imaging.NearestNeighbor)
+			for r := 0; r < 5; r++ {
+				var ov1 image.Image
+				if rng.Intn(4) == 0 {
+					ov1 = noise.Generate(300, 300, &noise.Options{Monochrome: false, NoiseFn: noise.Uniform})
+				} else {
+					ov1, err = env.ImageDB.GetAnyPicture(rng)
+					if err != nil {
+						return nil, "", stacktrace.Propagate(err, "")
+					}
+				}
+				ov1 = imaging.Rotate(ov1, rng.Float64()*360, color.Transparent)
+				ov1 = imaging.Resize(ov1, 500+rng.Intn(150), 1000+rng.Intn(5000), imaging.NearestNeighbor)
+				if rng.Intn(2) < 1 {
+					ov1 = imaging.Invert(imaging.Rotate(ov1, rng.Float64()*360, color.Transparent))
+				}
+				imaging.AdjustSaturation(ov1, -15.0+rng.Float64()*30.0)
+				imaging.AdjustBrightness(ov1, -10.0+rng.Float64()*20.0)
+				pics[i] = imaging.OverlayCenter(pics[i], ov1, 0.5+rng.Float64()*0.15)
+			}
+			if rng.Intn(2) < 1 {
+				pics[i] = imaging.Invert(pics[i])
+			}
+		}
+	}
+	return pics, "Select the picture that appears corrupted.", nil
+}
+
+func simpleMath(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	num1 := rng.Intn(10)
+	num2 := rng.Intn(10)
+	operator := []string{"+", "-"}[rng.Intn(2)]
+	result := num1 + num2
+	if operator == "-" {
+		result = num1 - num2
+		for result < 0 {
+			num2--
+			result = num1 - num2
+		}
+	}
+	instructions := fmt.Sprintf("How much is %d %s %d?", num1, operator, num2)
+
+	pics := make([]image.Image, 4)
+	for i := 0; i < 4; i++ {
+		var err error
+		if i == answer {
+			pics[i], err = env.NumberPicture(rng, result)
+		} else {
+			wrongResult := rng.Intn(20)
+			for wrongResult == result {
+				wrongResult = rng.Intn(20)
+			}
+			pics[i], err = env.NumberPicture(rng, wrongResult)
+		}
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+	}
+	return pics, instructions, nil
+}
+
+// rotateToUpright shows the same picture rotated in four different ways
+func rotateToUpright(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	pic, err := env.ImageDB.GetOrientablePicture(rng)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "")
+	}
+	// crop to a square so that the rotated versions are not distinguishable by their aspect ratio
+	bounds := pic.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	pic = imaging.CropCenter(pic, side, side)
+
+	rotations := []func(image.Image) *image.NRGBA{imaging.Rotate90, imaging.Rotate180, imaging.Rotate270}
+	rng.Shuffle(len(rotations), func(i, j int) {
+		rotations[i], rotations[j] = rotations[j], rotations[i]
+	})
+
+	pics := make([]image.Image, 4)
+	for i := 0; i < 4; i++ {
+		if i == answer {
+			pics[i] = pic
+		} else {
+			pics[i] = rotations[0](pic)
+			rotations = rotations[1:]
+		}
+	}
+	return pics, "Select the picture that is the right way up.", nil
+}
+
+// countObjects shows pictures with different numbers of sprites composited over a plain background.
+// Sprites come from the pictures tagged [sprite] in the image database, or are drawn as simple shapes when there are none
+func countObjects(rng *rand.Rand, env *PuzzleEnvironment, answer int) ([]image.Image, string, error) {
+	const maxObjects = 9
+	counts := rng.Perm(maxObjects)[:4]
+	for i := range counts {
+		counts[i]++
+	}
+
+	pics := make([]image.Image, 4)
+	for i := 0; i < 4; i++ {
+		var err error
+		pics[i], err = objectsPicture(rng, env, counts[i])
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+	}
+	objects := "objects"
+	if counts[answer] == 1 {
+		objects = "object"
+	}
+	return pics, fmt.Sprintf("Select the picture with exactly %d %s.", counts[answer], objects), nil
+}
+
+func objectsPicture(rng *rand.Rand, env *PuzzleEnvironment, count int) (image.Image, error) {
+	const size = 350
+	// objects are placed in the cells of a 3x3 grid, within the central area that is guaranteed to survive the
+	// rotation and cropping of the picture
+	const gridSize = 210
+	const cellSize = gridSize / 3
+	const objectSize = 52
+
+	background := color.NRGBA{uint8(150 + rng.Intn(100)), uint8(150 + rng.Intn(100)), uint8(150 + rng.Intn(100)), 255}
+	pic := image.Image(imaging.New(size, size, background))
+
+	useSprites := env.ImageDB.HasSpritePictures()
+	for _, cell := range rng.Perm(9)[:count] {
+		x := (size-gridSize)/2 + (cell%3)*cellSize + rng.Intn(cellSize-objectSize+1)
+		y := (size-gridSize)/2 + (cell/3)*cellSize + rng.Intn(cellSize-objectSize+1)
+
+		var object image.Image
+		if useSprites {
+			sprite, err := env.ImageDB.GetSpritePicture(rng)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "")
+			}
+			object = imaging.Fit(sprite, objectSize, objectSize, imaging.Lanczos)
+		} else {
+			object = shapePicture(rng, objectSize)
+		}
+		pic = imaging.Overlay(pic, object, image.Pt(x, y), 1)
+	}
+	return pic, nil
+}
+
+func shapePicture(rng *rand.Rand, size int) image.Image {
+	dc := gg.NewContext(size, size)
+	dc.SetRGB(rng.Float64()*0.6, rng.Float64()*0.6, rng.Float64()*0.6)
+	s := float64(size)
+	switch rng.Intn(3) {
+	case 0:
+		dc.DrawCircle(s/2, s/2, s/2-2)
+	case 1:
+		dc.DrawRectangle(4, 4, s-8, s-8)
+	default:
+		dc.DrawRegularPolygon(3, s/2, s/2+s/8, s/2-2, 0)
+	}
+	dc.Fill()
+	return dc.Image()
+}
@@ -0,0 +1,51 @@
+package segcha_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tnyim/jungletv/segcha"
+)
+
+func TestParseFamilyWeights(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]segcha.FamilyWeights{
+		"":                                {},
+		" , ":                             {},
+		"simple_math=3":                   {"simple_math": 3},
+		"simple_math=3,select_photo=0":    {"simple_math": 3, "select_photo": 0},
+		" simple_math = 3 , select_cgi=1": {"simple_math": 3, "select_cgi": 1},
+		"simple_math=1,simple_math=2":     {"simple_math": 2},
+		"count_objects=1,":                {"count_objects": 1},
+	}
+	for input, expected := range testCases {
+		weights, err := segcha.ParseFamilyWeights(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, weights, input)
+	}
+
+	invalidInputs := []string{
+		"simple_math",
+		"simple_math=",
+		"simple_math=-1",
+		"simple_math=one",
+		"unknown_family=1",
+		"=1",
+	}
+	for _, input := range invalidInputs {
+		_, err := segcha.ParseFamilyWeights(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestPickProbabilities(t *testing.T) {
+	weights := segcha.FamilyWeights{"simple_math": 3, "select_photo": 1, "select_cgi": 0}
+	require.Equal(t, map[string]float64{"simple_math": 0.75, "select_photo": 0.25}, weights.PickProbabilities())
+
+	// without weights, all registered families are equally likely
+	probabilities := segcha.FamilyWeights(nil).PickProbabilities()
+	require.Len(t, probabilities, len(segcha.PuzzleFamilyNames()))
+	for _, probability := range probabilities {
+		require.InDelta(t, 1/float64(len(probabilities)), probability, 1e-9)
+	}
+}
@@ -19,6 +19,7 @@ type ImageDatabase struct {
 	glassFilepaths         []string
 	unbrokenGlassFilepaths []string
 	brokenGlassFilepaths   []string
+	spriteFilepaths        []string
 	allFilepaths           []string
 }
 
@@ -53,6 +54,11 @@ func NewImageDatabase(imagesFolder string) (*ImageDatabase, error) {
 				if strings.Contains(info.Name(), "[brokenglass]") {
 					db.brokenGlassFilepaths = append(db.brokenGlassFilepaths, path)
 				}
+				if strings.Contains(info.Name(), "[sprite]") {
+					// sprites are meant to be composited over other pictures, they are not good pictures on their own
+					db.spriteFilepaths = append(db.spriteFilepaths, path)
+					return nil
+				}
 				db.allFilepaths = append(db.allFilepaths, path)
 			}
 			return nil
@@ -91,6 +97,14 @@ func (i *ImageDatabase) GetBrokenGlassPicture(rng *rand.Rand) (image.Image, erro
 	return i.pick(rng, i.brokenGlassFilepaths)
 }
 
+func (i *ImageDatabase) GetSpritePicture(rng *rand.Rand) (image.Image, error) {
+	return i.pick(rng, i.spriteFilepaths)
+}
+
+func (i *ImageDatabase) HasSpritePictures() bool {
+	return len(i.spriteFilepaths) > 0
+}
+
 func (i *ImageDatabase) GetAnyPicture(rng *rand.Rand) (image.Image, error) {
 	return i.pick(rng, i.allFilepaths)
 }
@@ -0,0 +1,168 @@
+package segcha
+
+import (
+	"encoding/gob"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+)
+
+// Pool holds pre-generated challenges, so that they can be served without waiting for their generation
+type Pool struct {
+	mu         sync.Mutex
+	challenges []*Challenge
+	capacity   int
+	maxAge     time.Duration
+}
+
+// NewPool returns a new empty Pool that holds up to capacity challenges, each for at most maxAge
+func NewPool(capacity int, maxAge time.Duration) *Pool {
+	return &Pool{
+		capacity: capacity,
+		maxAge:   maxAge,
+	}
+}
+
+// Capacity returns the maximum number of challenges the pool can hold
+func (p *Pool) Capacity() int {
+	return p.capacity
+}
+
+// Len returns the number of challenges in the pool
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.challenges)
+}
+
+// Missing returns how many challenges can still be added to the pool
+func (p *Pool) Missing() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.capacity - len(p.challenges)
+}
+
+// Put adds a challenge to the pool, returning false if the pool is full
+func (p *Pool) Put(c *Challenge) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.challenges) >= p.capacity {
+		return false
+	}
+	p.challenges = append(p.challenges, c)
+	return true
+}
+
+// Take removes the oldest challenge that has not expired from the pool and returns it
+func (p *Pool) Take() (*Challenge, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.removeExpired()
+	if len(p.challenges) == 0 {
+		return nil, false
+	}
+	c := p.challenges[0]
+	p.challenges[0] = nil
+	p.challenges = p.challenges[1:]
+	return c, true
+}
+
+// RemoveExpired removes the expired challenges from the pool, returning how many were removed
+func (p *Pool) RemoveExpired() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.removeExpired()
+}
+
+func (p *Pool) removeExpired() int {
+	// challenges are kept in the order they were added, so the expired ones are at the start
+	expired := 0
+	for expired < len(p.challenges) && time.Since(p.challenges[expired].createdAt) > p.maxAge {
+		expired++
+	}
+	p.challenges = p.challenges[expired:]
+	return expired
+}
+
+type persistedChallenge struct {
+	ID        string
+	Pictures  [][]byte
+	Answers   []int
+	Families  []string
+	CreatedAt time.Time
+}
+
+// SaveToFile writes the challenges in the pool to the specified file, replacing its contents
+func (p *Pool) SaveToFile(path string) error {
+	p.mu.Lock()
+	persisted := make([]persistedChallenge, len(p.challenges))
+	for i, c := range p.challenges {
+		persisted[i] = persistedChallenge{
+			ID:        c.id,
+			Pictures:  c.pics,
+			Answers:   c.answers,
+			Families:  c.families,
+			CreatedAt: c.createdAt,
+		}
+	}
+	p.mu.Unlock()
+
+	// write to a temporary file first so that a crash while writing doesn't leave a truncated file behind
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer os.Remove(f.Name())
+
+	err = gob.NewEncoder(f).Encode(persisted)
+	if err != nil {
+		f.Close()
+		return stacktrace.Propagate(err, "")
+	}
+	err = f.Close()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(os.Rename(f.Name(), path), "")
+}
+
+// LoadFromFile adds the challenges saved in the specified file to the pool, skipping the expired ones and those that
+// do not fit. It returns the number of challenges added. A missing file is not considered an error
+func (p *Pool) LoadFromFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, stacktrace.Propagate(err, "")
+	}
+	defer f.Close()
+
+	var persisted []persistedChallenge
+	err = gob.NewDecoder(f).Decode(&persisted)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+
+	added := 0
+	for _, c := range persisted {
+		if time.Since(c.CreatedAt) > p.maxAge {
+			continue
+		}
+		if !p.Put(&Challenge{
+			id:        c.ID,
+			pics:      c.Pictures,
+			answers:   c.Answers,
+			families:  c.Families,
+			createdAt: c.CreatedAt,
+		}) {
+			break
+		}
+		added++
+	}
+	return added, nil
+}
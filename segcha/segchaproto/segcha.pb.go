@@ -25,7 +25,8 @@ type GenerateChallengeRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumSteps uint32 `protobuf:"varint,1,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+	NumSteps      uint32            `protobuf:"varint,1,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+	FamilyWeights map[string]uint32 `protobuf:"bytes,2,rep,name=family_weights,json=familyWeights,proto3" json:"family_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *GenerateChallengeRequest) Reset() {
@@ -67,6 +68,76 @@ func (x *GenerateChallengeRequest) GetNumSteps() uint32 {
 	return 0
 }
 
+func (x *GenerateChallengeRequest) GetFamilyWeights() map[string]uint32 {
+	if x != nil {
+		return x.FamilyWeights
+	}
+	return nil
+}
+
+type GenerateChallengeBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumSteps      uint32            `protobuf:"varint,1,opt,name=num_steps,json=numSteps,proto3" json:"num_steps,omitempty"`
+	FamilyWeights map[string]uint32 `protobuf:"bytes,2,rep,name=family_weights,json=familyWeights,proto3" json:"family_weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Count         uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GenerateChallengeBatchRequest) Reset() {
+	*x = GenerateChallengeBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segcha_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateChallengeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateChallengeBatchRequest) ProtoMessage() {}
+
+func (x *GenerateChallengeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segcha_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateChallengeBatchRequest.ProtoReflect.Descriptor instead.
+func (*GenerateChallengeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_segcha_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateChallengeBatchRequest) GetNumSteps() uint32 {
+	if x != nil {
+		return x.NumSteps
+	}
+	return 0
+}
+
+func (x *GenerateChallengeBatchRequest) GetFamilyWeights() map[string]uint32 {
+	if x != nil {
+		return x.FamilyWeights
+	}
+	return nil
+}
+
+func (x *GenerateChallengeBatchRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type Challenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -75,12 +146,13 @@ type Challenge struct {
 	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Pictures [][]byte `protobuf:"bytes,2,rep,name=pictures,proto3" json:"pictures,omitempty"`
 	Answers  []uint32 `protobuf:"varint,3,rep,packed,name=answers,proto3" json:"answers,omitempty"`
+	Families []string `protobuf:"bytes,4,rep,name=families,proto3" json:"families,omitempty"`
 }
 
 func (x *Challenge) Reset() {
 	*x = Challenge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_segcha_proto_msgTypes[1]
+		mi := &file_segcha_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -93,7 +165,7 @@ func (x *Challenge) String() string {
 func (*Challenge) ProtoMessage() {}
 
 func (x *Challenge) ProtoReflect() protoreflect.Message {
-	mi := &file_segcha_proto_msgTypes[1]
+	mi := &file_segcha_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +178,7 @@ func (x *Challenge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
 func (*Challenge) Descriptor() ([]byte, []int) {
-	return file_segcha_proto_rawDescGZIP(), []int{1}
+	return file_segcha_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Challenge) GetId() string {
@@ -130,28 +202,68 @@ func (x *Challenge) GetAnswers() []uint32 {
 	return nil
 }
 
+func (x *Challenge) GetFamilies() []string {
+	if x != nil {
+		return x.Families
+	}
+	return nil
+}
+
 var File_segcha_proto protoreflect.FileDescriptor
 
 var file_segcha_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x22, 0x37, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22,
-	0x51, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x73, 0x32, 0x54, 0x0a, 0x06, 0x53, 0x65, 0x67, 0x63, 0x68, 0x61, 0x12, 0x4a, 0x0a, 0x11,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x22, 0xd5, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72,
 	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x43, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6e, 0x79, 0x69, 0x6d, 0x2f, 0x6a, 0x75, 0x6e,
-	0x67, 0x6c, 0x65, 0x74, 0x76, 0x2f, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2f, 0x73, 0x65, 0x67,
-	0x63, 0x68, 0x61, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x65, 0x70, 0x73,
+	0x12, 0x5a, 0x0a, 0x0e, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68,
+	0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x1a, 0x40, 0x0a, 0x12,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf5,
+	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x5f, 0x0a,
+	0x0e, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x12, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6d, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x69, 0x65, 0x73, 0x32, 0xac, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x67, 0x63, 0x68, 0x61,
+	0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61,
+	0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x16,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x6e, 0x79, 0x69, 0x6d, 0x2f, 0x6a, 0x75, 0x6e, 0x67, 0x6c, 0x65, 0x74,
+	0x76, 0x2f, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x2f, 0x73, 0x65, 0x67, 0x63, 0x68, 0x61, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -166,19 +278,26 @@ func file_segcha_proto_rawDescGZIP() []byte {
 	return file_segcha_proto_rawDescData
 }
 
-var file_segcha_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_segcha_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_segcha_proto_goTypes = []interface{}{
-	(*GenerateChallengeRequest)(nil), // 0: segcha.GenerateChallengeRequest
-	(*Challenge)(nil),                // 1: segcha.Challenge
+	(*GenerateChallengeRequest)(nil),      // 0: segcha.GenerateChallengeRequest
+	(*GenerateChallengeBatchRequest)(nil), // 1: segcha.GenerateChallengeBatchRequest
+	(*Challenge)(nil),                     // 2: segcha.Challenge
+	nil,                                   // 3: segcha.GenerateChallengeRequest.FamilyWeightsEntry
+	nil,                                   // 4: segcha.GenerateChallengeBatchRequest.FamilyWeightsEntry
 }
 var file_segcha_proto_depIdxs = []int32{
-	0, // 0: segcha.Segcha.GenerateChallenge:input_type -> segcha.GenerateChallengeRequest
-	1, // 1: segcha.Segcha.GenerateChallenge:output_type -> segcha.Challenge
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: segcha.GenerateChallengeRequest.family_weights:type_name -> segcha.GenerateChallengeRequest.FamilyWeightsEntry
+	4, // 1: segcha.GenerateChallengeBatchRequest.family_weights:type_name -> segcha.GenerateChallengeBatchRequest.FamilyWeightsEntry
+	0, // 2: segcha.Segcha.GenerateChallenge:input_type -> segcha.GenerateChallengeRequest
+	1, // 3: segcha.Segcha.GenerateChallengeBatch:input_type -> segcha.GenerateChallengeBatchRequest
+	2, // 4: segcha.Segcha.GenerateChallenge:output_type -> segcha.Challenge
+	2, // 5: segcha.Segcha.GenerateChallengeBatch:output_type -> segcha.Challenge
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_segcha_proto_init() }
@@ -200,6 +319,18 @@ func file_segcha_proto_init() {
 			}
 		}
 		file_segcha_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChallengeBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segcha_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Challenge); i {
 			case 0:
 				return &v.state
@@ -218,7 +349,7 @@ func file_segcha_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_segcha_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,6 +19,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SegchaClient interface {
 	GenerateChallenge(ctx context.Context, in *GenerateChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	GenerateChallengeBatch(ctx context.Context, in *GenerateChallengeBatchRequest, opts ...grpc.CallOption) (Segcha_GenerateChallengeBatchClient, error)
 }
 
 type segchaClient struct {
@@ -38,11 +39,44 @@ func (c *segchaClient) GenerateChallenge(ctx context.Context, in *GenerateChalle
 	return out, nil
 }
 
+func (c *segchaClient) GenerateChallengeBatch(ctx context.Context, in *GenerateChallengeBatchRequest, opts ...grpc.CallOption) (Segcha_GenerateChallengeBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Segcha_ServiceDesc.Streams[0], "/segcha.Segcha/GenerateChallengeBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &segchaGenerateChallengeBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Segcha_GenerateChallengeBatchClient interface {
+	Recv() (*Challenge, error)
+	grpc.ClientStream
+}
+
+type segchaGenerateChallengeBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *segchaGenerateChallengeBatchClient) Recv() (*Challenge, error) {
+	m := new(Challenge)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SegchaServer is the server API for Segcha service.
 // All implementations must embed UnimplementedSegchaServer
 // for forward compatibility
 type SegchaServer interface {
 	GenerateChallenge(context.Context, *GenerateChallengeRequest) (*Challenge, error)
+	GenerateChallengeBatch(*GenerateChallengeBatchRequest, Segcha_GenerateChallengeBatchServer) error
 	mustEmbedUnimplementedSegchaServer()
 }
 
@@ -53,6 +87,9 @@ type UnimplementedSegchaServer struct {
 func (UnimplementedSegchaServer) GenerateChallenge(context.Context, *GenerateChallengeRequest) (*Challenge, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateChallenge not implemented")
 }
+func (UnimplementedSegchaServer) GenerateChallengeBatch(*GenerateChallengeBatchRequest, Segcha_GenerateChallengeBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateChallengeBatch not implemented")
+}
 func (UnimplementedSegchaServer) mustEmbedUnimplementedSegchaServer() {}
 
 // UnsafeSegchaServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +121,27 @@ func _Segcha_GenerateChallenge_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Segcha_GenerateChallengeBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateChallengeBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SegchaServer).GenerateChallengeBatch(m, &segchaGenerateChallengeBatchServer{stream})
+}
+
+type Segcha_GenerateChallengeBatchServer interface {
+	Send(*Challenge) error
+	grpc.ServerStream
+}
+
+type segchaGenerateChallengeBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *segchaGenerateChallengeBatchServer) Send(m *Challenge) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Segcha_ServiceDesc is the grpc.ServiceDesc for Segcha service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -96,6 +154,12 @@ var Segcha_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Segcha_GenerateChallenge_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateChallengeBatch",
+			Handler:       _Segcha_GenerateChallengeBatch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "segcha.proto",
 }
@@ -66,9 +66,48 @@ type segchaServer struct {
 	fontPath string
 }
 
+// maxChallengeBatchSize is the maximum number of challenges that can be requested in a single batch
+const maxChallengeBatchSize = 100
+
 func (s *segchaServer) GenerateChallenge(ctx context.Context, r *segchaproto.GenerateChallengeRequest) (*segchaproto.Challenge, error) {
+	return s.generateChallenge(int(r.NumSteps), r.FamilyWeights)
+}
+
+func (s *segchaServer) GenerateChallengeBatch(r *segchaproto.GenerateChallengeBatchRequest, stream segchaproto.Segcha_GenerateChallengeBatchServer) error {
+	if r.Count > maxChallengeBatchSize {
+		return stacktrace.NewError("batch size exceeds maximum of %d", maxChallengeBatchSize)
+	}
+
+	t := time.Now()
+	for i := uint32(0); i < r.Count; i++ {
+		if stream.Context().Err() != nil {
+			return stacktrace.Propagate(stream.Context().Err(), "")
+		}
+		challenge, err := s.generateChallenge(int(r.NumSteps), r.FamilyWeights)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		err = stream.Send(challenge)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	mainLog.Printf("Generated batch of %d challenges in %v", r.Count, time.Since(t))
+	return nil
+}
+
+func (s *segchaServer) generateChallenge(numSteps int, protoWeights map[string]uint32) (*segchaproto.Challenge, error) {
 	t := time.Now()
-	challenge, err := segcha.NewChallenge(int(r.NumSteps), s.imageDB, s.fontPath)
+	var weights segcha.FamilyWeights
+	if len(protoWeights) > 0 {
+		weights = make(segcha.FamilyWeights, len(protoWeights))
+		for name, weight := range protoWeights {
+			weights[name] = int(weight)
+		}
+	}
+
+	challenge, err := segcha.NewChallenge(numSteps, s.imageDB, s.fontPath, weights)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -85,5 +124,6 @@ func (s *segchaServer) GenerateChallenge(ctx context.Context, r *segchaproto.Gen
 		Id:       challenge.ID(),
 		Pictures: challenge.Pictures(),
 		Answers:  answers,
+		Families: challenge.Families(),
 	}, nil
 }
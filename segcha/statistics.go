@@ -0,0 +1,98 @@
+package segcha
+
+import (
+	"sync"
+	"time"
+)
+
+// FamilyStatistics holds how users performed on the steps created by a puzzle family
+type FamilyStatistics struct {
+	Answered int
+	Correct  int
+	// LegitimateAnswered and LegitimateCorrect only count steps answered by users believed to be legitimate
+	LegitimateAnswered int
+	LegitimateCorrect  int
+	TotalSolveTime     time.Duration
+}
+
+// FailureRate returns the fraction of answered steps that were answered incorrectly
+func (f FamilyStatistics) FailureRate() float64 {
+	if f.Answered == 0 {
+		return 0
+	}
+	return float64(f.Answered-f.Correct) / float64(f.Answered)
+}
+
+// LegitimateFailureRate returns the fraction of steps answered by legitimate users that were answered incorrectly
+func (f FamilyStatistics) LegitimateFailureRate() float64 {
+	if f.LegitimateAnswered == 0 {
+		return 0
+	}
+	return float64(f.LegitimateAnswered-f.LegitimateCorrect) / float64(f.LegitimateAnswered)
+}
+
+// AverageSolveTime returns the average time users took to answer each step
+func (f FamilyStatistics) AverageSolveTime() time.Duration {
+	if f.Answered == 0 {
+		return 0
+	}
+	return f.TotalSolveTime / time.Duration(f.Answered)
+}
+
+// Statistics aggregates, in memory, the results of challenge steps per puzzle family
+type Statistics struct {
+	mu       sync.Mutex
+	families map[string]*FamilyStatistics
+}
+
+// NewStatistics returns a new, empty Statistics
+func NewStatistics() *Statistics {
+	return &Statistics{
+		families: make(map[string]*FamilyStatistics),
+	}
+}
+
+// RecordStep records the result of a step created by the given puzzle family, answered in solveTime
+func (s *Statistics) RecordStep(family string, correct, legitimate bool, solveTime time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.families[family]
+	if !ok {
+		f = &FamilyStatistics{}
+		s.families[family] = f
+	}
+	f.Answered++
+	f.TotalSolveTime += solveTime
+	if correct {
+		f.Correct++
+	}
+	if legitimate {
+		f.LegitimateAnswered++
+		if correct {
+			f.LegitimateCorrect++
+		}
+	}
+}
+
+// Snapshot returns a copy of the statistics of each puzzle family with recorded results
+func (s *Statistics) Snapshot() map[string]FamilyStatistics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]FamilyStatistics, len(s.families))
+	for name, f := range s.families {
+		snapshot[name] = *f
+	}
+	return snapshot
+}
+
+// Flush returns a copy of the statistics of each puzzle family with recorded results and clears them
+func (s *Statistics) Flush() map[string]FamilyStatistics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make(map[string]FamilyStatistics, len(s.families))
+	for name, f := range s.families {
+		snapshot[name] = *f
+	}
+	s.families = make(map[string]*FamilyStatistics)
+	return snapshot
+}
@@ -16,15 +16,17 @@ import (
 )
 
 type AppEditor struct {
-	log    *log.Logger
-	runner *apprunner.AppRunner
+	log           *log.Logger
+	runner        *apprunner.AppRunner
+	bundleOptions BundleOptions
 }
 
 // New returns a new initialized AppEditor
-func New(log *log.Logger, appRunner *apprunner.AppRunner) *AppEditor {
+func New(log *log.Logger, appRunner *apprunner.AppRunner, bundleOptions BundleOptions) *AppEditor {
 	return &AppEditor{
-		log:    log,
-		runner: appRunner,
+		log:           log,
+		runner:        appRunner,
+		bundleOptions: bundleOptions,
 	}
 }
 
@@ -44,18 +44,13 @@ func (*AppEditor) CreateApplicationZIP(ctxCtx context.Context, applicationID str
 	zipWriter := zip.NewWriter(buf)
 
 	for _, file := range files {
-		fileWriter, err := zipWriter.CreateHeader(&zip.FileHeader{
+		err = writeZIPFile(zipWriter, &zip.FileHeader{
 			Name:     file.Name,
 			Comment:  file.EditMessage,
 			Modified: file.UpdatedAt,
 			Extra:    buildZIPExtraFieldForFile(file),
 			Method:   zip.Deflate,
-		})
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "")
-		}
-
-		_, err = fileWriter.Write(file.Content)
+		}, file.Content)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "")
 		}
@@ -0,0 +1,620 @@
+package appeditor
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/apprunner"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// Application bundles are ZIP archives containing the files of an application at a specific version, along with a
+// manifest describing the bundle and a signature of the manifest made by the publisher of the bundle.
+// The manifest contains the checksum of every file in the bundle, so the signature covers the entire bundle.
+// Application file names can't begin with an asterisk, so the manifest and signature can't clash with them.
+
+const bundleManifestFileName = "*manifest.json"
+const bundleSignatureFileName = "*manifest.sig"
+
+// limits on the uncompressed size of bundle contents, checked before decompressing them, so that a small bundle can't
+// exhaust the server's memory
+const maxBundleManifestSize = 1024 * 1024
+const maxBundleSignatureSize = 1024
+const maxBundleContentsSize = 128 * 1024 * 1024
+
+// BundleFormatVersion is the version of the application bundle format produced by this server
+const BundleFormatVersion = 1
+
+// ErrBundleSigningNotConfigured is returned when attempting to create an application bundle without a signing key
+var ErrBundleSigningNotConfigured = errors.New("application bundle signing key not configured")
+
+// ErrBundleInvalid is returned when an application bundle is malformed or its contents do not match its manifest
+var ErrBundleInvalid = errors.New("invalid application bundle")
+
+// ErrBundleSignatureInvalid is returned when the signature of an application bundle does not verify
+var ErrBundleSignatureInvalid = errors.New("invalid application bundle signature")
+
+// ErrBundlePublisherNotTrusted is returned when an application bundle was signed by a key that is not in the keyring
+var ErrBundlePublisherNotTrusted = errors.New("application bundle publisher not trusted")
+
+// ErrBundleRuntimeVersionUnsupported is returned when an application bundle requires a newer application runtime
+var ErrBundleRuntimeVersionUnsupported = errors.New("application bundle requires an unsupported runtime version")
+
+// ErrBundleRuntimeDowngrade is returned when importing an application bundle would lower the runtime version of an
+// existing application
+var ErrBundleRuntimeDowngrade = errors.New("application bundle would downgrade the application runtime version")
+
+// ErrBundleChangedSincePreview is returned when the bundle being imported is not the one that was previewed
+var ErrBundleChangedSincePreview = errors.New("application bundle does not match the previewed bundle")
+
+// BundleOptions contains the configuration for signing and verifying application bundles
+type BundleOptions struct {
+	// SigningKey is used to sign exported bundles. If nil, bundles can't be exported
+	SigningKey ed25519.PrivateKey
+	// PublisherName is the author name included in exported bundles
+	PublisherName string
+	// TrustedPublishersFile is the path to the keyring file containing the keys of the trusted publishers, one per
+	// line, in the format "<hex-encoded ed25519 public key> <publisher name>". Lines starting with # are ignored
+	TrustedPublishersFile string
+}
+
+// BundleManifest describes the contents of an application bundle
+type BundleManifest struct {
+	FormatVersion      int                  `json:"formatVersion"`
+	ApplicationID      string               `json:"applicationID"`
+	ApplicationVersion time.Time            `json:"applicationVersion"`
+	Author             string               `json:"author"`
+	RuntimeVersion     int                  `json:"runtimeVersion"`
+	CreatedAt          time.Time            `json:"createdAt"`
+	PublisherKey       string               `json:"publisherKey"`
+	Files              []BundleManifestFile `json:"files"`
+}
+
+// BundleManifestFile describes a file in an application bundle
+type BundleManifestFile struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Public bool   `json:"public"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// verifiedBundle is an application bundle whose signature and contents were verified
+type verifiedBundle struct {
+	manifest      BundleManifest
+	publisherName string
+	checksum      string
+	files         map[string][]byte
+}
+
+// CreateApplicationBundle creates a signed bundle containing the current version of an application
+func (e *AppEditor) CreateApplicationBundle(ctxCtx context.Context, applicationID string) ([]byte, error) {
+	if e.bundleOptions.SigningKey == nil {
+		return nil, stacktrace.Propagate(ErrBundleSigningNotConfigured, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	applications, err := types.GetApplicationsWithIDs(ctx, []string{applicationID})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	application, ok := applications[applicationID]
+	if !ok {
+		return nil, stacktrace.NewError("application not found")
+	}
+
+	files, _, err := types.GetApplicationFilesForApplication[*types.ApplicationFile](ctx, applicationID, "", nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	manifest := BundleManifest{
+		FormatVersion:      BundleFormatVersion,
+		ApplicationID:      application.ID,
+		ApplicationVersion: time.Time(application.UpdatedAt).UTC(),
+		Author:             e.bundleOptions.PublisherName,
+		RuntimeVersion:     application.RuntimeVersion,
+		CreatedAt:          time.Now().UTC(),
+		PublisherKey:       hex.EncodeToString(e.bundleOptions.SigningKey.Public().(ed25519.PublicKey)),
+		Files:              make([]BundleManifestFile, len(files)),
+	}
+
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+
+	for i, file := range files {
+		checksum := sha256.Sum256(file.Content)
+		manifest.Files[i] = BundleManifestFile{
+			Name:   file.Name,
+			Type:   file.Type,
+			Public: file.Public,
+			Size:   int64(len(file.Content)),
+			SHA256: hex.EncodeToString(checksum[:]),
+		}
+
+		err = writeZIPFile(zipWriter, &zip.FileHeader{
+			Name:     file.Name,
+			Comment:  file.EditMessage,
+			Modified: file.UpdatedAt,
+			Extra:    buildZIPExtraFieldForFile(file),
+			Method:   zip.Deflate,
+		}, file.Content)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	manifestBytes, err := sonic.ConfigStd.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	signature := ed25519.Sign(e.bundleOptions.SigningKey, manifestBytes)
+
+	err = writeZIPFile(zipWriter, &zip.FileHeader{
+		Name:     bundleManifestFileName,
+		Modified: manifest.CreatedAt,
+		Method:   zip.Deflate,
+	}, manifestBytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = writeZIPFile(zipWriter, &zip.FileHeader{
+		Name:     bundleSignatureFileName,
+		Modified: manifest.CreatedAt,
+		Method:   zip.Store,
+	}, []byte(hex.EncodeToString(signature)))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = zipWriter.SetComment(fmt.Sprintf("Signed bundle of JungleTV application %s version %v", application.ID, manifest.ApplicationVersion))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeZIPFile(zipWriter *zip.Writer, header *zip.FileHeader, content []byte) error {
+	fileWriter, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	_, err = fileWriter.Write(content)
+	return stacktrace.Propagate(err, "")
+}
+
+// trustedPublishers reads the keyring, returning a map from hex-encoded public keys to publisher names
+func (e *AppEditor) trustedPublishers() (map[string]string, error) {
+	publishers := make(map[string]string)
+	if e.bundleOptions.TrustedPublishersFile == "" {
+		return publishers, nil
+	}
+
+	f, err := os.Open(e.bundleOptions.TrustedPublishersFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, name, _ := strings.Cut(line, " ")
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil || len(keyBytes) != ed25519.PublicKeySize {
+			return nil, stacktrace.NewError("invalid public key in trusted publishers file: %s", key)
+		}
+		publishers[strings.ToLower(key)] = strings.TrimSpace(name)
+	}
+	return publishers, stacktrace.Propagate(scanner.Err(), "")
+}
+
+// readZIPFile reads the contents of a file in a ZIP archive, failing if they are larger than maxSize bytes
+func readZIPFile(zipFile *zip.File, maxSize int64) ([]byte, error) {
+	if zipFile.UncompressedSize64 > uint64(maxSize) {
+		return nil, stacktrace.NewError("file %s is too large", zipFile.Name)
+	}
+
+	fileReader, err := zipFile.Open()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer fileReader.Close()
+
+	// the uncompressed size in the header can't be trusted, so don't read more than the maximum regardless
+	content, err := io.ReadAll(io.LimitReader(fileReader, maxSize+1))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if int64(len(content)) > maxSize {
+		return nil, stacktrace.NewError("file %s is too large", zipFile.Name)
+	}
+	return content, nil
+}
+
+// verifyBundle verifies the signature and integrity of an application bundle
+func (e *AppEditor) verifyBundle(bundleContents []byte) (*verifiedBundle, error) {
+	bundleChecksum := sha256.Sum256(bundleContents)
+	bundle := &verifiedBundle{
+		checksum: hex.EncodeToString(bundleChecksum[:]),
+		files:    make(map[string][]byte),
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(bundleContents), int64(len(bundleContents)))
+	if err != nil {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "%v", err)
+	}
+
+	// only the manifest and the signature are decompressed before the signature is verified.
+	// The remaining files are decompressed afterwards, up to the sizes listed in the manifest
+	zipFiles := make(map[string]*zip.File)
+	for _, zipFile := range zipReader.File {
+		if _, ok := zipFiles[zipFile.Name]; ok {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "duplicate file %s", zipFile.Name)
+		}
+		zipFiles[zipFile.Name] = zipFile
+	}
+	manifestZIPFile, hasManifest := zipFiles[bundleManifestFileName]
+	signatureZIPFile, hasSignature := zipFiles[bundleSignatureFileName]
+	if !hasManifest || !hasSignature {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "manifest or signature missing")
+	}
+	delete(zipFiles, bundleManifestFileName)
+	delete(zipFiles, bundleSignatureFileName)
+
+	manifestBytes, err := readZIPFile(manifestZIPFile, maxBundleManifestSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "%v", err)
+	}
+	signatureHex, err := readZIPFile(signatureZIPFile, maxBundleSignatureSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "%v", err)
+	}
+
+	err = sonic.Unmarshal(manifestBytes, &bundle.manifest)
+	if err != nil {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "%v", err)
+	}
+
+	publicKey, err := hex.DecodeString(bundle.manifest.PublisherKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "invalid publisher key")
+	}
+	signature, err := hex.DecodeString(strings.TrimSpace(string(signatureHex)))
+	if err != nil || !ed25519.Verify(publicKey, manifestBytes, signature) {
+		return nil, stacktrace.Propagate(ErrBundleSignatureInvalid, "")
+	}
+
+	publishers, err := e.trustedPublishers()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	publisherName, trusted := publishers[strings.ToLower(bundle.manifest.PublisherKey)]
+	if !trusted {
+		return nil, stacktrace.Propagate(ErrBundlePublisherNotTrusted, "")
+	}
+	bundle.publisherName = publisherName
+
+	if bundle.manifest.FormatVersion > BundleFormatVersion {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "unsupported bundle format version %d", bundle.manifest.FormatVersion)
+	}
+	if bundle.manifest.RuntimeVersion > apprunner.RuntimeVersion {
+		return nil, stacktrace.Propagate(ErrBundleRuntimeVersionUnsupported, "")
+	}
+
+	if len(bundle.manifest.Files) != len(zipFiles) {
+		return nil, stacktrace.Propagate(ErrBundleInvalid, "bundle contains files not listed in the manifest")
+	}
+	totalSize := int64(0)
+	for _, manifestFile := range bundle.manifest.Files {
+		if strings.HasPrefix(manifestFile.Name, "*") {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "invalid file name %s", manifestFile.Name)
+		}
+		zipFile, ok := zipFiles[manifestFile.Name]
+		if !ok {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "file %s missing from bundle", manifestFile.Name)
+		}
+		if _, ok := bundle.files[manifestFile.Name]; ok {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "duplicate file %s in manifest", manifestFile.Name)
+		}
+		totalSize += manifestFile.Size
+		if manifestFile.Size < 0 || totalSize > maxBundleContentsSize {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "bundle contents are too large")
+		}
+		if zipFile.UncompressedSize64 != uint64(manifestFile.Size) {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "size mismatch for file %s", manifestFile.Name)
+		}
+		content, err := readZIPFile(zipFile, manifestFile.Size)
+		if err != nil {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "%v", err)
+		}
+		bundle.files[manifestFile.Name] = content
+
+		checksum := sha256.Sum256(content)
+		if int64(len(content)) != manifestFile.Size || hex.EncodeToString(checksum[:]) != strings.ToLower(manifestFile.SHA256) {
+			return nil, stacktrace.Propagate(ErrBundleInvalid, "checksum mismatch for file %s", manifestFile.Name)
+		}
+	}
+
+	return bundle, nil
+}
+
+// BundleFileChangeType is the type of change made to an application file when importing a bundle
+type BundleFileChangeType int
+
+const (
+	BundleFileUnchanged BundleFileChangeType = iota
+	BundleFileAdded
+	BundleFileModified
+	BundleFileDeleted
+)
+
+// BundleFileChange describes the change made to an application file when importing a bundle
+type BundleFileChange struct {
+	Name          string
+	Change        BundleFileChangeType
+	TypeChanged   bool
+	PublicChanged bool
+	// Diff is a unified diff of the file contents, empty if the contents did not change or are not text
+	Diff string
+}
+
+// BundleImportPreview describes a verified application bundle and the changes importing it would cause
+type BundleImportPreview struct {
+	Manifest           BundleManifest
+	PublisherName      string
+	BundleChecksum     string
+	ApplicationExists  bool
+	CurrentVersion     types.ApplicationVersion
+	ApplicationRunning bool
+	FileChanges        []BundleFileChange
+}
+
+// PreviewApplicationBundleImport verifies an application bundle and computes the changes that importing it would
+// cause, without applying them
+func (e *AppEditor) PreviewApplicationBundleImport(ctxCtx context.Context, bundleContents []byte, deleteFilesNotInBundle bool) (BundleImportPreview, error) {
+	bundle, err := e.verifyBundle(bundleContents)
+	if err != nil {
+		return BundleImportPreview{}, stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return BundleImportPreview{}, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	applications, err := types.GetApplicationsWithIDs(ctx, []string{bundle.manifest.ApplicationID})
+	if err != nil {
+		return BundleImportPreview{}, stacktrace.Propagate(err, "")
+	}
+	application, exists := applications[bundle.manifest.ApplicationID]
+
+	preview := BundleImportPreview{
+		Manifest:          bundle.manifest,
+		PublisherName:     bundle.publisherName,
+		BundleChecksum:    bundle.checksum,
+		ApplicationExists: exists,
+	}
+	if exists {
+		if !application.AllowFileEditing {
+			return BundleImportPreview{}, stacktrace.NewError("application is currently read-only")
+		}
+		if bundle.manifest.RuntimeVersion < application.RuntimeVersion {
+			return BundleImportPreview{}, stacktrace.Propagate(ErrBundleRuntimeDowngrade, "")
+		}
+		preview.CurrentVersion = application.UpdatedAt
+		preview.ApplicationRunning, _, _ = e.runner.IsRunning(application.ID)
+	}
+
+	currentFiles := map[string]*types.ApplicationFile{}
+	if exists {
+		files, _, err := types.GetApplicationFilesForApplication[*types.ApplicationFile](ctx, application.ID, "", nil)
+		if err != nil {
+			return BundleImportPreview{}, stacktrace.Propagate(err, "")
+		}
+		for _, file := range files {
+			currentFiles[file.Name] = file
+		}
+	}
+
+	preview.FileChanges = computeBundleFileChanges(bundle, currentFiles, deleteFilesNotInBundle)
+	return preview, nil
+}
+
+func computeBundleFileChanges(bundle *verifiedBundle, currentFiles map[string]*types.ApplicationFile, deleteFilesNotInBundle bool) []BundleFileChange {
+	changes := []BundleFileChange{}
+	for _, manifestFile := range bundle.manifest.Files {
+		newContent := bundle.files[manifestFile.Name]
+		change := BundleFileChange{
+			Name: manifestFile.Name,
+		}
+		currentFile, ok := currentFiles[manifestFile.Name]
+		if !ok {
+			change.Change = BundleFileAdded
+			change.Diff = textDiff(manifestFile.Name, nil, newContent)
+		} else {
+			change.TypeChanged = currentFile.Type != manifestFile.Type
+			change.PublicChanged = currentFile.Public != manifestFile.Public
+			if !bytes.Equal(currentFile.Content, newContent) {
+				change.Change = BundleFileModified
+				change.Diff = textDiff(manifestFile.Name, currentFile.Content, newContent)
+			} else if change.TypeChanged || change.PublicChanged {
+				change.Change = BundleFileModified
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	if deleteFilesNotInBundle {
+		for name, currentFile := range currentFiles {
+			if _, ok := bundle.files[name]; !ok {
+				changes = append(changes, BundleFileChange{
+					Name:   name,
+					Change: BundleFileDeleted,
+					Diff:   textDiff(name, currentFile.Content, nil),
+				})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+func isText(content []byte) bool {
+	return utf8.Valid(content) && !bytes.ContainsRune(content, 0)
+}
+
+func textDiff(fileName string, oldContent, newContent []byte) string {
+	if !isText(oldContent) || !isText(newContent) {
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(oldContent)),
+		B:        difflib.SplitLines(string(newContent)),
+		FromFile: "a/" + fileName,
+		ToFile:   "b/" + fileName,
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+// ImportApplicationBundle verifies and imports an application bundle, creating the application if it does not exist.
+// expectedChecksum must be the checksum of the bundle as returned by PreviewApplicationBundleImport, ensuring that
+// the changes being applied are the ones that were previewed
+func (e *AppEditor) ImportApplicationBundle(ctxCtx context.Context, bundleContents []byte, expectedChecksum string, deleteFilesNotInBundle bool, importedBy auth.User) (BundleManifest, error) {
+	bundle, err := e.verifyBundle(bundleContents)
+	if err != nil {
+		return BundleManifest{}, stacktrace.Propagate(err, "")
+	}
+	if bundle.checksum != strings.ToLower(expectedChecksum) {
+		return BundleManifest{}, stacktrace.Propagate(ErrBundleChangedSincePreview, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return BundleManifest{}, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	applicationID := bundle.manifest.ApplicationID
+	applications, err := types.GetApplicationsWithIDs(ctx, []string{applicationID})
+	if err != nil {
+		return BundleManifest{}, stacktrace.Propagate(err, "")
+	}
+	now := time.Now()
+	editMessage := fmt.Sprintf("Import bundle by %s (version %v)", bundle.publisherName, bundle.manifest.ApplicationVersion)
+
+	application, exists := applications[applicationID]
+	if exists {
+		if !application.AllowFileEditing {
+			return BundleManifest{}, stacktrace.NewError("application is currently read-only")
+		}
+		if bundle.manifest.RuntimeVersion < application.RuntimeVersion {
+			return BundleManifest{}, stacktrace.Propagate(ErrBundleRuntimeDowngrade, "")
+		}
+	} else {
+		application = &types.Application{
+			ID:               applicationID,
+			AllowLaunching:   false,
+			AllowFileEditing: true,
+			Autorun:          false,
+		}
+	}
+	application.UpdatedAt = types.ApplicationVersion(now)
+	application.UpdatedBy = importedBy.Address()
+	application.EditMessage = editMessage
+	application.RuntimeVersion = bundle.manifest.RuntimeVersion
+
+	currentFiles := map[string]*types.ApplicationFile{}
+	if exists {
+		files, _, err := types.GetApplicationFilesForApplication[*types.ApplicationFile](ctx, application.ID, "", nil)
+		if err != nil {
+			return BundleManifest{}, stacktrace.Propagate(err, "")
+		}
+		for _, file := range files {
+			currentFiles[file.Name] = file
+		}
+	}
+
+	manifestFiles := make(map[string]BundleManifestFile)
+	for _, manifestFile := range bundle.manifest.Files {
+		manifestFiles[manifestFile.Name] = manifestFile
+	}
+
+	for _, change := range computeBundleFileChanges(bundle, currentFiles, deleteFilesNotInBundle) {
+		var file *types.ApplicationFile
+		switch change.Change {
+		case BundleFileUnchanged:
+			continue
+		case BundleFileDeleted:
+			file = currentFiles[change.Name]
+			file.Deleted = true
+		default:
+			manifestFile := manifestFiles[change.Name]
+			file = &types.ApplicationFile{
+				ApplicationID: applicationID,
+				Name:          manifestFile.Name,
+				Deleted:       false,
+				Public:        manifestFile.Public,
+				Type:          manifestFile.Type,
+				Content:       bundle.files[manifestFile.Name],
+			}
+		}
+		file.UpdatedAt = now
+		file.UpdatedBy = importedBy.Address()
+		file.EditMessage = editMessage
+
+		err = file.Update(ctx)
+		if err != nil {
+			return BundleManifest{}, stacktrace.Propagate(err, "")
+		}
+	}
+
+	err = application.Update(ctx)
+	if err != nil {
+		return BundleManifest{}, stacktrace.Propagate(err, "")
+	}
+
+	return bundle.manifest, stacktrace.Propagate(ctx.Commit(), "")
+}
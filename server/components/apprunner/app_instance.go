@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 	"runtime/debug"
 	"strings"
@@ -36,6 +37,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/process"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/queue"
 	"github.com/tnyim/jungletv/server/components/apprunner/modules/rpc"
+	"github.com/tnyim/jungletv/server/components/apprunner/modules/settings"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils"
@@ -63,6 +65,7 @@ type appInstance struct {
 	startedOrStoppedAt time.Time
 	onPaused           event.NoArgEvent
 	onTerminated       event.NoArgEvent
+	settingsChanged    event.Event[[]string]
 	runner             *AppRunner
 	loop               *eventloop.EventLoop
 	appLogger          *appLogger
@@ -72,6 +75,16 @@ type appInstance struct {
 	transpiledFiles    map[transpiledFilesMapKey][]byte
 	transpiledFilesMu  sync.Mutex
 
+	// maps the names under which files were loaded by the runtime to the names of the respective application files
+	fileNames   map[string]string
+	fileNamesMu sync.Mutex
+	// source of the main file as executed
+	mainSource string
+
+	// debugger is nil when the instance was not launched with debugging enabled
+	debugger          *appDebugger
+	watchdogSuspended atomic.Bool
+
 	// promisesWithoutRejectionHandler are rejected promises with no handler,
 	// if there is something in this map at an end of an event loop then it will exit with an error.
 	// It's similar to what Deno and Node do.
@@ -104,18 +117,24 @@ var ErrApplicationFileTypeMismatch = errors.New("unexpected type for application
 // ErrApplicationInstanceNotRunning is returned when the specified application is not running
 var ErrApplicationInstanceNotRunning = errors.New("application instance not running")
 
-func newAppInstance(r *AppRunner, applicationID string, applicationVersion types.ApplicationVersion, applicationWallet *wallet.Wallet, d modules.Dependencies) (*appInstance, error) {
+func newAppInstance(r *AppRunner, applicationID string, applicationVersion types.ApplicationVersion, applicationWallet *wallet.Wallet, debuggable bool, d modules.Dependencies) (*appInstance, error) {
 	instance := &appInstance{
 		applicationID:                   applicationID,
 		applicationVersion:              applicationVersion,
 		applicationWallet:               applicationWallet,
 		onPaused:                        event.NewNoArg(),
 		onTerminated:                    event.NewNoArg(),
+		settingsChanged:                 event.New[[]string](),
 		runner:                          r,
 		modules:                         &modules.Collection{},
 		appLogger:                       NewAppLogger(d.ModLogWebhook, applicationID),
 		promisesWithoutRejectionHandler: make(map[*goja.Promise]struct{}),
 		transpiledFiles:                 make(map[transpiledFilesMapKey][]byte),
+		fileNames:                       make(map[string]string),
+	}
+
+	if debuggable {
+		instance.debugger = newAppDebugger(instance.watchdogSuspended.Store)
 	}
 
 	accountIndex := uint32(0)
@@ -163,6 +182,7 @@ func newAppInstance(r *AppRunner, applicationID string, applicationVersion types
 	instance.rpcModule = rpc.New()
 	instance.modules.RegisterNativeModule(instance.rpcModule)
 	instance.modules.RegisterNativeModule(configuration.New(instance, r.configManager, instance.pagesModule))
+	instance.modules.RegisterNativeModule(settings.New(instance, instance.settingsChanged, instance.runOnLoopLogError))
 
 	registry := instance.modules.BuildRegistry(instance.sourceLoader)
 	registry.RegisterNativeModule(console.ModuleName, console.RequireWithPrinter(instance.appLogger))
@@ -190,21 +210,22 @@ func (a *appInstance) getMainFile() (*types.ApplicationFile, bool, error) {
 	}
 	defer ctx.Commit() // read-only tx
 
-	files, err := types.GetApplicationFilesWithNamesForApplicationAtVersion(ctx, a.applicationID, a.applicationVersion, []string{MainFileName, MainFileNameTypeScript})
+	files, err := types.GetApplicationFilesWithNamesForApplicationAtVersion(ctx, a.applicationID, a.applicationVersion, []string{MainFileName, MainFileNameModule, MainFileNameTypeScript})
 	if err != nil {
 		return nil, false, stacktrace.Propagate(err, "")
 	}
-	file, ok := files[MainFileName]
+	for _, name := range []string{MainFileName, MainFileNameModule} {
+		if file, ok := files[name]; ok {
+			if !slices.Contains(validServerScriptMIMETypes, file.Type) {
+				return nil, false, stacktrace.Propagate(ErrApplicationFileTypeMismatch, "main application file has wrong type")
+			}
+			return file, isESModule(file.Name, file.Content), nil
+		}
+	}
 	tsFile, tsok := files[MainFileNameTypeScript]
-	if !ok && !tsok {
+	if !tsok {
 		return nil, false, stacktrace.Propagate(ErrApplicationFileNotFound, "main application file not found")
 	}
-	if ok {
-		if !slices.Contains(validServerScriptMIMETypes, file.Type) {
-			return nil, false, stacktrace.Propagate(ErrApplicationFileTypeMismatch, "main application file has wrong type")
-		}
-		return file, false, nil
-	}
 	if !slices.Contains(validServerTypeScriptMIMETypes, tsFile.Type) {
 		return nil, false, stacktrace.Propagate(ErrApplicationFileTypeMismatch, "main application file has wrong type")
 	}
@@ -232,7 +253,7 @@ func (a *appInstance) StartOrResume(ctx context.Context) error {
 	a.modules.ExecutionResumed(a.ctx)
 
 	if !a.startedOnce {
-		mainFile, isTypeScript, err := a.getMainFile()
+		mainFile, needsTranspilation, err := a.getMainFile()
 		if err != nil {
 			return stacktrace.Propagate(err, "")
 		}
@@ -247,14 +268,23 @@ func (a *appInstance) StartOrResume(ctx context.Context) error {
 			a.vmInterrupt = r.Interrupt
 			a.vmClearInterrupt = r.ClearInterrupt
 
-			_, err = r.RunScript("", runtimeBaseCode)
+			if a.debugger != nil {
+				err = r.Set(debuggerHookFunctionName, a.debugger.hook)
+			}
+
+			if err == nil {
+				_, err = r.RunScript("", runtimeBaseCode)
+			}
+			if err == nil {
+				_, err = r.RunScript("", runtimeModuleCode)
+			}
 
 			a.modules.EnableModules(r)
 			a.appLogger.RuntimeLog("application instance started")
 		})
 
 		mainSource := string(mainFile.Content)
-		if isTypeScript {
+		if needsTranspilation {
 			a.runOnLoopLogError(func(vm *goja.Runtime) error {
 				mainSourceBytes, err := a.transpileTS(mainFile.Name, mainFile.Content, false)
 				if err != nil {
@@ -270,7 +300,10 @@ func (a *appInstance) StartOrResume(ctx context.Context) error {
 		}
 
 		a.runOnLoopLogError(func(vm *goja.Runtime) error {
-			_, err = vm.RunScript(MainFileName, mainSource)
+			mainSource = a.instrumentForDebugging(mainFile.Name, mainSource, false)
+			a.mainSource = mainSource
+			a.recordLoadedFile(mainFile.Name, mainFile.Name)
+			_, err = vm.RunScript(mainFile.Name, mainSource)
 			return err // do not propagate, user code, there's no need to make the stack trace more confusing
 		})
 		a.startedOnce = true
@@ -316,6 +349,11 @@ func (a *appInstance) startWatchdog(tolerateEventLoopStuckFor time.Duration) (fu
 				}
 				timer.Reset(tolerateEventLoopStuckFor)
 			case <-timer.C:
+				if a.watchdogSuspended.Load() {
+					// execution is paused by the debugger
+					timer.Reset(tolerateEventLoopStuckFor)
+					continue
+				}
 				a.appLogger.RuntimeError(fmt.Sprintf("application event loop stuck for at least %v, terminating", tolerateEventLoopStuckFor))
 				a.Terminate(true, 0*time.Second, false)
 				return
@@ -439,6 +477,11 @@ func (a *appInstance) pause(force bool, after time.Duration, toTerminate bool) e
 		return stacktrace.Propagate(ErrApplicationInstanceAlreadyPaused, "")
 	}
 
+	if a.debugger != nil {
+		// the debugger may be holding the event loop, release it so the loop can stop
+		a.debugger.detach()
+	}
+
 	a.stopWatchdog()
 	a.stopWatchdog = nil
 
@@ -498,6 +541,7 @@ func (a *appInstance) Running() (bool, types.ApplicationVersion, time.Time) {
 
 func (a *appInstance) sourceLoader(vm *goja.Runtime, filename string) ([]byte, error) {
 	if filename == "node_modules/tslib" {
+		a.recordLoadedFile(filename, filename)
 		return tslibCode, nil
 	}
 	ctx, err := transaction.Begin(a.ctx)
@@ -508,7 +552,7 @@ func (a *appInstance) sourceLoader(vm *goja.Runtime, filename string) ([]byte, e
 
 	filenames := []string{filename}
 	if !strings.HasSuffix(filename, ".js") {
-		filenames = append(filenames, filename+".ts")
+		filenames = append(filenames, filename+".ts", filename+".mjs")
 	}
 
 	files, err := types.GetApplicationFilesWithNamesForApplicationAtVersion(ctx, a.applicationID, a.applicationVersion, filenames)
@@ -521,22 +565,55 @@ func (a *appInstance) sourceLoader(vm *goja.Runtime, filename string) ([]byte, e
 		if !isJSON && !isTypeScript && !slices.Contains(validServerScriptMIMETypes, file.Type) {
 			return nil, stacktrace.Propagate(ErrApplicationFileTypeMismatch, "source file has wrong type")
 		}
+		a.recordLoadedFile(filename, f)
 
-		if isTypeScript {
+		if isTypeScript || (!isJSON && isESModule(f, file.Content)) {
 			transpiled, err := a.transpileTS(f, file.Content, false)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "")
 			}
-			return []byte(transpiled), nil
+			return []byte(a.instrumentForDebugging(f, string(transpiled), true)), nil
 		}
 
+		if !isJSON {
+			return []byte(a.instrumentForDebugging(f, string(file.Content), true)), nil
+		}
 		return file.Content, nil
 	}
 	return nil, errors.Join(require.ModuleFileDoesNotExistError, stacktrace.Propagate(ErrApplicationFileNotFound, "required file not found"))
 }
 
+func (a *appInstance) recordLoadedFile(loadedAs, fileName string) {
+	a.fileNamesMu.Lock()
+	defer a.fileNamesMu.Unlock()
+	a.fileNames[loadedAs] = fileName
+}
+
+// loadedFileNames returns a map from the names under which files were loaded by the runtime, to the names of the
+// respective application files
+func (a *appInstance) loadedFileNames() map[string]string {
+	a.fileNamesMu.Lock()
+	defer a.fileNamesMu.Unlock()
+	return maps.Clone(a.fileNames)
+}
+
 var sourceMappingRegex = regexp.MustCompile(`//# sourceMappingURL=data:application/json;base64,(.*)`)
 
+// instrumentForDebugging instruments the given source for debugging, if the instance was launched with debugging
+// enabled. Must run within the event loop
+func (a *appInstance) instrumentForDebugging(filename, source string, isModule bool) string {
+	if a.debugger == nil {
+		return source
+	}
+	instrumented, err := a.debugger.instrument(filename, source, isModule)
+	if err != nil {
+		// most likely a syntax error, which will surface when the file is executed
+		a.appLogger.RuntimeLog("could not instrument file " + filename + " for debugging")
+		return source
+	}
+	return instrumented
+}
+
 func (a *appInstance) transpileTS(filename string, source []byte, forBrowser bool) ([]byte, error) {
 	a.transpiledFilesMu.Lock()
 	defer a.transpiledFilesMu.Unlock()
@@ -550,14 +627,20 @@ func (a *appInstance) transpileTS(filename string, source []byte, forBrowser boo
 		return js, nil
 	}
 
-	moduleName := strings.TrimSuffix(filename, ".ts")
+	moduleName := strings.TrimSuffix(filename, path.Ext(filename))
+
+	fileKind := "TypeScript file"
+	if !slices.Contains([]string{".ts", ".mts", ".cts"}, path.Ext(filename)) {
+		fileKind = "ES module"
+	}
 
 	compilerOptions := typeScriptCompilerOptions
 	if forBrowser {
 		compilerOptions = typeScriptCompilerOptionsForBrowser
-		a.appLogger.RuntimeLog("transpiling TypeScript file " + filename + " for browser context")
+		a.appLogger.RuntimeLog("transpiling " + fileKind + " " + filename + " for browser context")
 	} else {
-		a.appLogger.RuntimeLog("transpiling TypeScript file " + filename)
+		a.appLogger.RuntimeLog("transpiling " + fileKind + " " + filename)
+		source = []byte(rewriteDynamicImports(string(source)))
 	}
 
 	transpiled, err := typescript.TranspileCtx(
@@ -593,9 +676,10 @@ func (a *appInstance) transpileTS(filename string, source []byte, forBrowser boo
 		// if and only if the original code did not intend to be a module (namely, if it only contains `import type` but no `import` and no `export`).
 		transpiled = strings.Replace(transpiled, `Object.defineProperty(exports, "__esModule", { value: true });`, "", 1)
 
-		a.appLogger.RuntimeLog("transpiled TypeScript file " + filename + " for browser context")
+		a.appLogger.RuntimeLog("transpiled " + fileKind + " " + filename + " for browser context")
 	} else {
-		a.appLogger.RuntimeLog("transpiled TypeScript file " + filename)
+		transpiled = finishServerModule(filename, transpiled)
+		a.appLogger.RuntimeLog("transpiled " + fileKind + " " + filename)
 	}
 	a.transpiledFiles[mapKey] = []byte(transpiled)
 	return []byte(transpiled), nil
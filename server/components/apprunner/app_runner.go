@@ -112,6 +112,8 @@ type AppRunner struct {
 	onApplicationStopped           event.Event[RunningApplication]
 	moduleDependencies             modules.Dependencies
 	incomingClientEventRateLimiter limiter.Store
+	profile                        *activeProfile
+	profileMu                      sync.Mutex
 }
 
 // WalletBuilder builds wallets for an application
@@ -168,13 +170,20 @@ func (r *AppRunner) ApplicationStopped() event.Event[RunningApplication] {
 
 // LaunchApplication launches the most recent version of the specified application
 func (r *AppRunner) LaunchApplicationAtVersion(applicationID string, applicationVersion types.ApplicationVersion) error {
-	err := r.launchApplication(r.workerContext, applicationID, applicationVersion)
+	err := r.launchApplication(r.workerContext, applicationID, applicationVersion, false)
 	return stacktrace.Propagate(err, "")
 }
 
 // LaunchApplication launches the most recent version of the specified application
 func (r *AppRunner) LaunchApplication(applicationID string) error {
-	err := r.launchApplication(r.workerContext, applicationID, types.ApplicationVersion{})
+	err := r.launchApplication(r.workerContext, applicationID, types.ApplicationVersion{}, false)
+	return stacktrace.Propagate(err, "")
+}
+
+// LaunchApplicationWithDebugging launches the most recent version of the specified application with debugging enabled.
+// Applications launched this way run slower, as their code is instrumented to allow for a debugger to be attached
+func (r *AppRunner) LaunchApplicationWithDebugging(applicationID string) error {
+	err := r.launchApplication(r.workerContext, applicationID, types.ApplicationVersion{}, true)
 	return stacktrace.Propagate(err, "")
 }
 
@@ -193,7 +202,7 @@ func (r *AppRunner) LaunchAutorunApplications() error {
 
 	for _, application := range applications {
 		if application.AllowLaunching && application.Autorun {
-			err := r.launchApplication(ctx, application.ID, application.UpdatedAt)
+			err := r.launchApplication(ctx, application.ID, application.UpdatedAt, false)
 			if err != nil {
 				return stacktrace.Propagate(err, "")
 			}
@@ -203,7 +212,7 @@ func (r *AppRunner) LaunchAutorunApplications() error {
 	return nil
 }
 
-func (r *AppRunner) launchApplication(ctxCtx context.Context, applicationID string, specificVersion types.ApplicationVersion) error {
+func (r *AppRunner) launchApplication(ctxCtx context.Context, applicationID string, specificVersion types.ApplicationVersion, debuggable bool) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
@@ -244,7 +253,7 @@ func (r *AppRunner) launchApplication(ctxCtx context.Context, applicationID stri
 		return stacktrace.Propagate(err, "")
 	}
 
-	instance, err := newAppInstance(r, application.ID, specificVersion, wallet, r.moduleDependencies)
+	instance, err := newAppInstance(r, application.ID, specificVersion, wallet, debuggable, r.moduleDependencies)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
@@ -330,6 +339,38 @@ func (r *AppRunner) StopApplication(applicationID string) error {
 	}
 }
 
+// AttachDebugger attaches a debugger to the specified application, which must have been launched with debugging enabled
+func (r *AppRunner) AttachDebugger(applicationID string) (*DebuggerSession, error) {
+	r.instancesLock.RLock()
+	defer r.instancesLock.RUnlock()
+
+	instance, ok := r.instances[applicationID]
+	if !ok {
+		return nil, stacktrace.Propagate(ErrApplicationNotInstantiated, "")
+	}
+	if instance.debugger == nil {
+		return nil, stacktrace.Propagate(ErrApplicationNotDebuggable, "")
+	}
+	session, err := instance.debugger.attach()
+	return session, stacktrace.Propagate(err, "")
+}
+
+// DebuggerSession returns the debugger session with the given ID, attached to the specified application
+func (r *AppRunner) DebuggerSession(applicationID, sessionID string) (*DebuggerSession, error) {
+	r.instancesLock.RLock()
+	defer r.instancesLock.RUnlock()
+
+	instance, ok := r.instances[applicationID]
+	if !ok || instance.debugger == nil {
+		return nil, stacktrace.Propagate(ErrDebuggerSessionNotFound, "")
+	}
+	session := instance.debugger.currentSession()
+	if session == nil || session.ID() != sessionID {
+		return nil, stacktrace.Propagate(ErrDebuggerSessionNotFound, "")
+	}
+	return session, nil
+}
+
 // RunningApplication contains information about a running application
 type RunningApplication struct {
 	ApplicationID      string
@@ -0,0 +1,527 @@
+package apprunner
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dop251/goja"
+	"github.com/oklog/ulid/v2"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/event"
+	"golang.org/x/exp/slices"
+)
+
+// ErrApplicationNotDebuggable is returned when attempting to debug an application that was not launched with debugging enabled
+var ErrApplicationNotDebuggable = errors.New("application not launched with debugging enabled")
+
+// ErrDebuggerAlreadyAttached is returned when attempting to debug an application that already has a debugger attached
+var ErrDebuggerAlreadyAttached = errors.New("a debugger is already attached to this application")
+
+// ErrDebuggerSessionNotFound is returned when the specified debugger session does not exist
+var ErrDebuggerSessionNotFound = errors.New("debugger session not found")
+
+// ErrDebuggerSessionDetached is returned when attempting to use a debugger session that is no longer attached
+var ErrDebuggerSessionDetached = errors.New("debugger session detached")
+
+// ErrApplicationNotPaused is returned when attempting an operation that requires the application execution to be paused by the debugger
+var ErrApplicationNotPaused = errors.New("application execution not paused by the debugger")
+
+// ErrInvalidStackFrame is returned when the specified stack frame does not exist or its scope is not available
+var ErrInvalidStackFrame = errors.New("invalid stack frame")
+
+// DebuggerLocation is a location in the original source of an application file
+type DebuggerLocation struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// DebuggerPauseReason is the reason why the execution of an application was paused by the debugger
+type DebuggerPauseReason int
+
+const (
+	DebuggerPauseReasonBreakpoint DebuggerPauseReason = iota
+	DebuggerPauseReasonStep
+	DebuggerPauseReasonPauseRequested
+)
+
+// DebuggerStackFrame represents a frame in the call stack of a paused application
+type DebuggerStackFrame struct {
+	FunctionName   string
+	Location       DebuggerLocation
+	ScopeAvailable bool
+}
+
+// DebuggerPausedState contains information about the state of an application paused by the debugger
+type DebuggerPausedState struct {
+	Reason    DebuggerPauseReason
+	Location  DebuggerLocation
+	CallStack []DebuggerStackFrame
+}
+
+// DebuggerVariable is a variable in the scope of a stack frame
+type DebuggerVariable struct {
+	Name  string
+	Value string
+}
+
+type debuggerStepMode int
+
+const (
+	debuggerStepModeNone debuggerStepMode = iota
+	debuggerStepModeInto
+	debuggerStepModeOver
+	debuggerStepModeOut
+)
+
+type debuggerShadowFrame struct {
+	locationID   int
+	functionName string
+	evaluator    goja.Callable
+}
+
+type debuggerPausedFrame struct {
+	debuggerShadowFrame
+	valid bool
+}
+
+type debuggerCommand struct {
+	f    func(vm *goja.Runtime) (resume bool)
+	done chan struct{}
+}
+
+// appDebugger implements debugging for a single application instance that was launched with debugging enabled
+type appDebugger struct {
+	// accessed only from within the event loop
+	locations    []debuggerLocationInfo
+	shadowFrames []debuggerShadowFrame
+
+	mu             sync.Mutex
+	session        *DebuggerSession
+	breakpoints    map[string]map[int]struct{}
+	stepMode       debuggerStepMode
+	stepOrigin     debuggerShadowFrame
+	stepDepth      int
+	pauseRequested bool
+	pausedFrames   []debuggerPausedFrame
+
+	suspendWatchdog func(bool)
+}
+
+// DebuggerSession is a debugger attached to an application instance
+type DebuggerSession struct {
+	id         string
+	debugger   *appDebugger
+	commands   chan debuggerCommand
+	detachedCh chan struct{}
+	detachOnce sync.Once
+	onPaused   event.Event[DebuggerPausedState]
+	onResumed  event.NoArgEvent
+	onDetached event.NoArgEvent
+}
+
+func newAppDebugger(suspendWatchdog func(bool)) *appDebugger {
+	return &appDebugger{
+		breakpoints:     make(map[string]map[int]struct{}),
+		suspendWatchdog: suspendWatchdog,
+	}
+}
+
+// instrument must run within the event loop
+func (d *appDebugger) instrument(fileName, source string, isModule bool) (string, error) {
+	instrumented, locations, err := instrumentForDebugging(fileName, source, isModule, len(d.locations))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "")
+	}
+	d.locations = append(d.locations, locations...)
+	return instrumented, nil
+}
+
+func (d *appDebugger) attach() (*DebuggerSession, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.session != nil {
+		return nil, stacktrace.Propagate(ErrDebuggerAlreadyAttached, "")
+	}
+	d.session = &DebuggerSession{
+		id:         ulid.Make().String(),
+		debugger:   d,
+		commands:   make(chan debuggerCommand),
+		detachedCh: make(chan struct{}),
+		onPaused:   event.New[DebuggerPausedState](),
+		onResumed:  event.NewNoArg(),
+		onDetached: event.NewNoArg(),
+	}
+	return d.session, nil
+}
+
+func (d *appDebugger) currentSession() *DebuggerSession {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.session
+}
+
+// detach detaches the current debugger session, if any, resuming the execution if it is paused
+func (d *appDebugger) detach() {
+	if s := d.currentSession(); s != nil {
+		s.Detach()
+	}
+}
+
+func (d *appDebugger) hook(call goja.FunctionCall, vm *goja.Runtime) goja.Value {
+	locationID := int(call.Argument(0).ToInteger())
+	if locationID < 0 || locationID >= len(d.locations) {
+		return goja.Undefined()
+	}
+	session := d.currentSession()
+	if session == nil {
+		return goja.Undefined()
+	}
+	evaluator, _ := goja.AssertFunction(call.Argument(1))
+
+	stack := vm.CaptureCallStack(0, nil)
+	depth := len(stack)
+	current := debuggerShadowFrame{
+		locationID: locationID,
+		evaluator:  evaluator,
+	}
+	if len(stack) > 1 {
+		current.functionName = stack[1].FuncName()
+	}
+
+	var previous debuggerShadowFrame
+	hadPrevious := false
+	if depth < len(d.shadowFrames) {
+		previous, hadPrevious = d.shadowFrames[depth], true
+		d.shadowFrames = d.shadowFrames[:depth+1]
+	} else {
+		for len(d.shadowFrames) <= depth {
+			d.shadowFrames = append(d.shadowFrames, debuggerShadowFrame{locationID: -1})
+		}
+	}
+	d.shadowFrames[depth] = current
+
+	location := d.locations[locationID].location
+	sameLine := func(other debuggerShadowFrame) bool {
+		if other.locationID < 0 || other.functionName != current.functionName {
+			return false
+		}
+		otherLocation := d.locations[other.locationID].location
+		return otherLocation.File == location.File && otherLocation.Line == location.Line
+	}
+
+	reason, pause := func() (DebuggerPauseReason, bool) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if d.pauseRequested {
+			return DebuggerPauseReasonPauseRequested, true
+		}
+		switch d.stepMode {
+		case debuggerStepModeInto:
+			if depth != d.stepDepth || !sameLine(d.stepOrigin) {
+				return DebuggerPauseReasonStep, true
+			}
+		case debuggerStepModeOver:
+			if depth < d.stepDepth || (depth == d.stepDepth && !sameLine(d.stepOrigin)) {
+				return DebuggerPauseReasonStep, true
+			}
+		case debuggerStepModeOut:
+			if depth < d.stepDepth {
+				return DebuggerPauseReasonStep, true
+			}
+		}
+		if lines, ok := d.breakpoints[location.File]; ok {
+			if _, ok := lines[location.Line]; ok && !(hadPrevious && sameLine(previous)) {
+				return DebuggerPauseReasonBreakpoint, true
+			}
+		}
+		return 0, false
+	}()
+	if pause {
+		d.pause(vm, session, reason, stack, depth)
+	}
+	return goja.Undefined()
+}
+
+// pause blocks the event loop until the debugger session resumes the execution or is detached
+func (d *appDebugger) pause(vm *goja.Runtime, session *DebuggerSession, reason DebuggerPauseReason, stack []goja.StackFrame, depth int) {
+	state := DebuggerPausedState{
+		Reason:   reason,
+		Location: d.locations[d.shadowFrames[depth].locationID].location,
+	}
+	pausedFrames := []debuggerPausedFrame{}
+	for j := 1; j < len(stack); j++ {
+		frame := DebuggerStackFrame{
+			FunctionName: stack[j].FuncName(),
+		}
+		pausedFrame := debuggerPausedFrame{}
+		shadowDepth := depth - j + 1
+		if shadowDepth < len(d.shadowFrames) {
+			shadow := d.shadowFrames[shadowDepth]
+			if shadow.locationID >= 0 && shadow.functionName == frame.FunctionName {
+				pausedFrame = debuggerPausedFrame{
+					debuggerShadowFrame: shadow,
+					valid:               true,
+				}
+			}
+		}
+		if pausedFrame.valid {
+			frame.Location = d.locations[pausedFrame.locationID].location
+			frame.ScopeAvailable = true
+		} else {
+			position := stack[j].Position()
+			frame.Location = DebuggerLocation{
+				File:   position.Filename,
+				Line:   position.Line,
+				Column: position.Column,
+			}
+		}
+		state.CallStack = append(state.CallStack, frame)
+		pausedFrames = append(pausedFrames, pausedFrame)
+	}
+
+	d.mu.Lock()
+	d.pauseRequested = false
+	d.stepMode = debuggerStepModeNone
+	d.pausedFrames = pausedFrames
+	d.mu.Unlock()
+
+	d.suspendWatchdog(true)
+	defer d.suspendWatchdog(false)
+
+	session.onPaused.Notify(state, true)
+	defer session.onResumed.Notify(true)
+
+	defer func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.pausedFrames = nil
+	}()
+
+	for {
+		select {
+		case command := <-session.commands:
+			resume := command.f(vm)
+			close(command.done)
+			if resume {
+				return
+			}
+		case <-session.detachedCh:
+			return
+		}
+	}
+}
+
+// ID returns the ID of the debugger session
+func (s *DebuggerSession) ID() string {
+	return s.id
+}
+
+// Paused returns the event that is fired when the application execution is paused by the debugger
+func (s *DebuggerSession) Paused() event.Event[DebuggerPausedState] {
+	return s.onPaused
+}
+
+// Resumed returns the event that is fired when the application execution is resumed after being paused by the debugger
+func (s *DebuggerSession) Resumed() event.NoArgEvent {
+	return s.onResumed
+}
+
+// Detached returns the event that is fired when the debugger session is detached
+func (s *DebuggerSession) Detached() event.NoArgEvent {
+	return s.onDetached
+}
+
+// Detach detaches the debugger session from the application, clearing all breakpoints and resuming the execution
+func (s *DebuggerSession) Detach() {
+	s.detachOnce.Do(func() {
+		d := s.debugger
+		d.mu.Lock()
+		if d.session == s {
+			d.session = nil
+			d.breakpoints = make(map[string]map[int]struct{})
+			d.stepMode = debuggerStepModeNone
+			d.pauseRequested = false
+		}
+		d.mu.Unlock()
+		close(s.detachedCh)
+		s.onDetached.Notify(true)
+	})
+}
+
+// SetBreakpoints replaces the breakpoints for the given file.
+// Returns the lines, among those requested, that contain statements where the execution can be paused
+func (s *DebuggerSession) SetBreakpoints(file string, lines []int) ([]int, error) {
+	d := s.debugger
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.session != s {
+		return nil, stacktrace.Propagate(ErrDebuggerSessionDetached, "")
+	}
+
+	lineSet := make(map[int]struct{})
+	for _, line := range lines {
+		lineSet[line] = struct{}{}
+	}
+	d.breakpoints[file] = lineSet
+	if len(lines) == 0 {
+		delete(d.breakpoints, file)
+	}
+
+	// d.locations is only appended to, and the breakpoints for files which have not been loaded yet are kept anyway,
+	// so the following is merely informative
+	valid := []int{}
+	for _, location := range d.locations {
+		if location.location.File != file {
+			continue
+		}
+		if _, ok := lineSet[location.location.Line]; ok && !slices.Contains(valid, location.location.Line) {
+			valid = append(valid, location.location.Line)
+		}
+	}
+	slices.Sort(valid)
+	return valid, nil
+}
+
+// RequestPause requests for the application execution to be paused at the next statement
+func (s *DebuggerSession) RequestPause() error {
+	d := s.debugger
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.session != s {
+		return stacktrace.Propagate(ErrDebuggerSessionDetached, "")
+	}
+	d.pauseRequested = true
+	return nil
+}
+
+// Resume resumes the application execution
+func (s *DebuggerSession) Resume(ctx context.Context) error {
+	return stacktrace.Propagate(s.resumeWithStepMode(ctx, debuggerStepModeNone), "")
+}
+
+// StepInto resumes the application execution, pausing at the next statement, including statements in called functions
+func (s *DebuggerSession) StepInto(ctx context.Context) error {
+	return stacktrace.Propagate(s.resumeWithStepMode(ctx, debuggerStepModeInto), "")
+}
+
+// StepOver resumes the application execution, pausing at the next statement in the current function or in its callers
+func (s *DebuggerSession) StepOver(ctx context.Context) error {
+	return stacktrace.Propagate(s.resumeWithStepMode(ctx, debuggerStepModeOver), "")
+}
+
+// StepOut resumes the application execution, pausing once the current function returns
+func (s *DebuggerSession) StepOut(ctx context.Context) error {
+	return stacktrace.Propagate(s.resumeWithStepMode(ctx, debuggerStepModeOut), "")
+}
+
+func (s *DebuggerSession) resumeWithStepMode(ctx context.Context, mode debuggerStepMode) error {
+	return stacktrace.Propagate(s.runWhilePaused(ctx, func(vm *goja.Runtime) bool {
+		d := s.debugger
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.stepMode = mode
+		stack := vm.CaptureCallStack(0, nil)
+		d.stepDepth = len(stack)
+		if d.stepDepth < len(d.shadowFrames) {
+			d.stepOrigin = d.shadowFrames[d.stepDepth]
+		}
+		return true
+	}), "")
+}
+
+// Evaluate evaluates an expression in the scope of the specified stack frame of the paused application
+func (s *DebuggerSession) Evaluate(ctx context.Context, frameIndex int, expression string) (bool, string, error) {
+	successful := false
+	result := ""
+	err := s.runWhilePaused(ctx, func(vm *goja.Runtime) bool {
+		frame, ok := s.pausedFrame(frameIndex)
+		if !ok {
+			result = ErrInvalidStackFrame.Error()
+			return false
+		}
+		value, err := frame.evaluator(goja.Undefined(), vm.ToValue(expression))
+		if err != nil {
+			result = err.Error()
+			return false
+		}
+		successful = true
+		result = resultString(vm, value, 0)
+		return false
+	})
+	return successful, result, stacktrace.Propagate(err, "")
+}
+
+// Scope returns the variables in the scope of the specified stack frame of the paused application
+func (s *DebuggerSession) Scope(ctx context.Context, frameIndex int) ([]DebuggerVariable, error) {
+	var variables []DebuggerVariable
+	frameFound := false
+	err := s.runWhilePaused(ctx, func(vm *goja.Runtime) bool {
+		frame, ok := s.pausedFrame(frameIndex)
+		if !ok {
+			return false
+		}
+		frameFound = true
+		variables = []DebuggerVariable{}
+		for _, name := range s.debugger.locations[frame.locationID].scopeNames {
+			value, err := frame.evaluator(goja.Undefined(), vm.ToValue(name))
+			if err != nil {
+				// most likely the variable is in the temporal dead zone or in a block scope that does not include this statement
+				continue
+			}
+			variables = append(variables, DebuggerVariable{
+				Name:  name,
+				Value: resultString(vm, value, 0),
+			})
+		}
+		return false
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !frameFound {
+		return nil, stacktrace.Propagate(ErrInvalidStackFrame, "")
+	}
+	return variables, nil
+}
+
+func (s *DebuggerSession) pausedFrame(frameIndex int) (debuggerPausedFrame, bool) {
+	d := s.debugger
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if frameIndex < 0 || frameIndex >= len(d.pausedFrames) || !d.pausedFrames[frameIndex].valid {
+		return debuggerPausedFrame{}, false
+	}
+	return d.pausedFrames[frameIndex], true
+}
+
+// runWhilePaused runs a function within the event loop while it is paused by the debugger
+func (s *DebuggerSession) runWhilePaused(ctx context.Context, f func(vm *goja.Runtime) (resume bool)) error {
+	d := s.debugger
+	d.mu.Lock()
+	attached := d.session == s
+	paused := d.pausedFrames != nil
+	d.mu.Unlock()
+	if !attached {
+		return stacktrace.Propagate(ErrDebuggerSessionDetached, "")
+	}
+	if !paused {
+		return stacktrace.Propagate(ErrApplicationNotPaused, "")
+	}
+
+	command := debuggerCommand{
+		f:    f,
+		done: make(chan struct{}),
+	}
+	select {
+	case s.commands <- command:
+	case <-s.detachedCh:
+		return stacktrace.Propagate(ErrDebuggerSessionDetached, "")
+	case <-ctx.Done():
+		return stacktrace.Propagate(ctx.Err(), "")
+	}
+	// once the command has been picked up, it must be waited for, as it may be accessing variables owned by the caller
+	<-command.done
+	return nil
+}
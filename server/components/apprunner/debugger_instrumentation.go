@@ -0,0 +1,376 @@
+package apprunner
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/file"
+	"github.com/dop251/goja/parser"
+	"github.com/go-sourcemap/sourcemap"
+	"github.com/palantir/stacktrace"
+	"golang.org/x/exp/slices"
+)
+
+// goja has no debugging API, so debugging is implemented through source instrumentation: when an application is
+// launched with debugging enabled, a call to the debugger hook is inserted before every statement of the code that
+// gets executed. The hook receives the ID of the location of the statement, and an arrow function containing a direct
+// eval, which allows for evaluating expressions within the scope of the statement when the execution is paused.
+// The instrumentation is inserted without adding new lines, so that line numbers (and the source maps) remain valid.
+
+const debuggerHookFunctionName = "__jungletvDebugHook"
+const debuggerEvaluatorParameterName = "$__jungletvExpression"
+
+// debuggerLocationInfo contains information about an instrumented statement
+type debuggerLocationInfo struct {
+	location DebuggerLocation
+	// names of the variables declared in the scopes enclosing the statement, deduplicated
+	scopeNames []string
+}
+
+type debuggerInstrumentationInsertion struct {
+	offset     int
+	locationID int
+}
+
+type debuggerInstrumenter struct {
+	fileName   string
+	source     string
+	prefixLen  int
+	lineStarts []int
+	sourceMap  *sourcemap.Consumer
+	scopes     [][]string
+	insertions []debuggerInstrumentationInsertion
+	locations  []debuggerLocationInfo
+}
+
+// instrumentForDebugging returns the instrumented version of the given source, and the information about the
+// locations of the instrumented statements. The IDs of the locations are sequential, starting at firstLocationID
+func instrumentForDebugging(fileName, source string, isModule bool, firstLocationID int) (string, []debuggerLocationInfo, error) {
+	prefix := ""
+	suffix := ""
+	var implicitNames []string
+	if isModule {
+		// mimic the wrapping done by goja_nodejs' require
+		prefix = "(function(exports, require, module) {"
+		suffix = "\n})"
+		implicitNames = []string{"exports", "require", "module"}
+	}
+	program, err := goja.Parse(fileName, prefix+source+suffix, parser.WithDisableSourceMaps)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "")
+	}
+
+	inst := &debuggerInstrumenter{
+		fileName:  fileName,
+		source:    source,
+		prefixLen: len(prefix),
+		scopes:    [][]string{implicitNames},
+	}
+	inst.lineStarts = append(inst.lineStarts, 0)
+	for i, c := range source {
+		if c == '\n' {
+			inst.lineStarts = append(inst.lineStarts, i+1)
+		}
+	}
+	if loc := sourceMappingRegex.FindStringSubmatch(source); loc != nil {
+		if sm, err := parseInlineSourceMap(fileName, loc[1]); err == nil {
+			inst.sourceMap = sm
+		}
+	}
+
+	if isModule {
+		// the actual program is the function literal introduced by the wrapping
+		if len(program.Body) != 1 {
+			return "", nil, stacktrace.NewError("unexpected module structure")
+		}
+		inst.walk(reflect.ValueOf(program.Body[0]))
+	} else {
+		inst.scopes[0] = append(inst.scopes[0], collectDeclaredNames(program.Body)...)
+		inst.instrumentStatementList(program.Body, true)
+	}
+
+	sort.SliceStable(inst.insertions, func(i, j int) bool {
+		return inst.insertions[i].offset < inst.insertions[j].offset
+	})
+
+	var b strings.Builder
+	b.Grow(len(source) + len(inst.insertions)*64)
+	lastOffset := 0
+	for _, insertion := range inst.insertions {
+		b.WriteString(source[lastOffset:insertion.offset])
+		b.WriteString(fmt.Sprintf("%s(%d,%s=>eval(%s));",
+			debuggerHookFunctionName, firstLocationID+insertion.locationID, debuggerEvaluatorParameterName, debuggerEvaluatorParameterName))
+		lastOffset = insertion.offset
+	}
+	b.WriteString(source[lastOffset:])
+
+	return b.String(), inst.locations, nil
+}
+
+func parseInlineSourceMap(fileName, b64 string) (*sourcemap.Consumer, error) {
+	decoded, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	sm, err := sourcemap.Parse(fileName, decoded)
+	return sm, stacktrace.Propagate(err, "")
+}
+
+// statementOffset returns the offset, within the unwrapped source, of the first character of the statement,
+// taking into account the parentheses which are not represented in the AST
+func (inst *debuggerInstrumenter) statementOffset(statement ast.Statement) int {
+	offset := int(statement.Idx0()) - 1 - inst.prefixLen
+	for i := offset - 1; i >= 0; i-- {
+		c := inst.source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		case c == '(':
+			offset = i
+		case strings.HasSuffix(inst.source[:i+1], "async") &&
+			(i < len("async") || !isJSIdentifierChar(inst.source[i-len("async")])):
+			// the Idx0 of async function declarations points to the function keyword
+			if _, ok := statement.(*ast.FunctionDeclaration); ok {
+				return i + 1 - len("async")
+			}
+			return offset
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+func isJSIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (inst *debuggerInstrumenter) location(offset int) DebuggerLocation {
+	line := sort.Search(len(inst.lineStarts), func(i int) bool { return inst.lineStarts[i] > offset })
+	column := offset - inst.lineStarts[line-1] + 1
+	loc := DebuggerLocation{
+		File:   inst.fileName,
+		Line:   line,
+		Column: column,
+	}
+	if inst.sourceMap != nil {
+		if _, _, origLine, origColumn, ok := inst.sourceMap.Source(line, column); ok {
+			loc.Line = origLine
+			loc.Column = origColumn
+		}
+	}
+	return loc
+}
+
+func (inst *debuggerInstrumenter) instrumentStatementList(list []ast.Statement, canHaveDirectives bool) {
+	inDirectivePrologue := canHaveDirectives
+	for _, statement := range list {
+		if inDirectivePrologue {
+			if es, ok := statement.(*ast.ExpressionStatement); ok {
+				if _, ok := es.Expression.(*ast.StringLiteral); ok {
+					continue
+				}
+			}
+			inDirectivePrologue = false
+		}
+		if _, ok := statement.(*ast.EmptyStatement); !ok {
+			offset := inst.statementOffset(statement)
+			if offset >= 0 {
+				inst.insertions = append(inst.insertions, debuggerInstrumentationInsertion{
+					offset:     offset,
+					locationID: len(inst.locations),
+				})
+				inst.locations = append(inst.locations, debuggerLocationInfo{
+					location:   inst.location(offset),
+					scopeNames: inst.currentScopeNames(),
+				})
+			}
+		}
+		inst.walk(reflect.ValueOf(statement))
+	}
+}
+
+func (inst *debuggerInstrumenter) currentScopeNames() []string {
+	names := []string{}
+	for i := len(inst.scopes) - 1; i >= 0; i-- {
+		for _, name := range inst.scopes[i] {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+var fileType = reflect.TypeOf(&file.File{})
+
+func (inst *debuggerInstrumenter) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			inst.walk(v.Elem())
+		}
+		return
+	case reflect.Pointer:
+		if v.IsNil() || v.Type() == fileType {
+			return
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			inst.walk(v.Index(i))
+		}
+		return
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				inst.walk(v.Field(i))
+			}
+		}
+		return
+	default:
+		return
+	}
+
+	switch node := v.Interface().(type) {
+	case *ast.FunctionLiteral:
+		names := collectParameterNames(node.ParameterList)
+		if node.Name != nil {
+			names = append(names, string(node.Name.Name))
+		}
+		names = append(names, collectDeclaredNames(node.Body.List)...)
+		inst.scopes = append(inst.scopes, names)
+		inst.walk(reflect.ValueOf(node.ParameterList))
+		inst.instrumentStatementList(node.Body.List, true)
+		inst.scopes = inst.scopes[:len(inst.scopes)-1]
+	case *ast.ArrowFunctionLiteral:
+		names := collectParameterNames(node.ParameterList)
+		inst.walk(reflect.ValueOf(node.ParameterList))
+		if body, ok := node.Body.(*ast.BlockStatement); ok {
+			names = append(names, collectDeclaredNames(body.List)...)
+			inst.scopes = append(inst.scopes, names)
+			inst.instrumentStatementList(body.List, true)
+		} else {
+			inst.scopes = append(inst.scopes, names)
+			inst.walk(reflect.ValueOf(node.Body))
+		}
+		inst.scopes = inst.scopes[:len(inst.scopes)-1]
+	case *ast.ClassStaticBlock:
+		inst.scopes = append(inst.scopes, collectDeclaredNames(node.Block.List))
+		inst.instrumentStatementList(node.Block.List, false)
+		inst.scopes = inst.scopes[:len(inst.scopes)-1]
+	case *ast.BlockStatement:
+		inst.instrumentStatementList(node.List, false)
+	case *ast.CaseStatement:
+		inst.walk(reflect.ValueOf(node.Test))
+		inst.instrumentStatementList(node.Consequent, false)
+	default:
+		inst.walk(v.Elem())
+	}
+}
+
+func collectParameterNames(list *ast.ParameterList) []string {
+	if list == nil {
+		return nil
+	}
+	names := []string{}
+	for _, binding := range list.List {
+		names = append(names, collectBindingNames(binding.Target)...)
+	}
+	if list.Rest != nil {
+		names = append(names, collectBindingNames(list.Rest)...)
+	}
+	return names
+}
+
+func collectBindingNames(target ast.Expression) []string {
+	names := []string{}
+	var collect func(v reflect.Value)
+	collect = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Interface, reflect.Pointer:
+			if v.IsNil() || v.Type() == fileType {
+				return
+			}
+			if id, ok := v.Interface().(*ast.Identifier); ok {
+				names = append(names, string(id.Name))
+				return
+			}
+			collect(v.Elem())
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				collect(v.Index(i))
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					collect(v.Field(i))
+				}
+			}
+		}
+	}
+	collect(reflect.ValueOf(target))
+	return names
+}
+
+// collectDeclaredNames returns the names declared within a list of statements, without descending into nested
+// functions and classes
+func collectDeclaredNames(list []ast.Statement) []string {
+	names := []string{}
+	var collect func(v reflect.Value)
+	collect = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Interface:
+			if !v.IsNil() {
+				collect(v.Elem())
+			}
+			return
+		case reflect.Pointer:
+			if v.IsNil() || v.Type() == fileType {
+				return
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				collect(v.Index(i))
+			}
+			return
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					collect(v.Field(i))
+				}
+			}
+			return
+		default:
+			return
+		}
+
+		switch node := v.Interface().(type) {
+		case *ast.FunctionLiteral, *ast.ArrowFunctionLiteral, *ast.ClassLiteral:
+			return
+		case *ast.FunctionDeclaration:
+			if node.Function.Name != nil {
+				names = append(names, string(node.Function.Name.Name))
+			}
+		case *ast.ClassDeclaration:
+			if node.Class.Name != nil {
+				names = append(names, string(node.Class.Name.Name))
+			}
+		case *ast.Binding:
+			names = append(names, collectBindingNames(node.Target)...)
+			collect(reflect.ValueOf(node.Initializer))
+		case *ast.ForDeclaration:
+			names = append(names, collectBindingNames(node.Target)...)
+		case *ast.CatchStatement:
+			names = append(names, collectBindingNames(node.Parameter)...)
+			collect(reflect.ValueOf(node.Body))
+		default:
+			collect(v.Elem())
+		}
+	}
+	collect(reflect.ValueOf(list))
+	return names
+}
@@ -0,0 +1,244 @@
+package apprunner
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dop251/goja"
+)
+
+// MainFileNameModule is the name of the application ES module file containing the application entry point
+const MainFileNameModule = "main.mjs"
+
+// ES modules are not natively supported by goja, so application ES modules are transpiled to CommonJS using the
+// TypeScript compiler, just like TypeScript files. The TypeScript compiler takes care of static imports and exports,
+// but the following features need special handling:
+//  - dynamic import() calls are rewritten into calls to a module-local function before transpilation, so that the
+//    returned promise only resolves once the imported module (including any top-level await it contains) finishes
+//    evaluating;
+//  - modules containing top-level await are wrapped in an async function after transpilation. The promise for
+//    the module's evaluation is registered so that dynamic imports of that module can wait for it.
+// When a module containing top-level await is statically imported, the importer receives the module's exports
+// object right away, and it is filled in as the module's evaluation progresses.
+
+const dynamicImportFunctionName = "__jungletvImport"
+
+// esModuleSyntaxRegex matches import and export declarations, which can only appear in ES modules.
+// import() calls and import.meta are not matched
+var esModuleSyntaxRegex = regexp.MustCompile("(?m)^\\s*(import(\\s*[*{\"'`]|\\s+[\\w$])|export\\s)")
+
+// runtimeModuleCode is executed on every application instance before the main file and contains the runtime support
+// for ES modules
+const runtimeModuleCode = `const __jungletvModuleEvaluations = new WeakMap();
+function __jungletvDynamicImport(load) {
+    return Promise.resolve().then(load).then((m) =>
+        Promise.resolve(__jungletvModuleEvaluations.get(m)).then(() =>
+            m && m.__esModule ? m : Object.assign({ default: m }, m)));
+};`
+
+// the require function must be called from within a function defined in the importing module's source file,
+// otherwise relative specifiers will be resolved against the wrong path
+const dynamicImportFunctionCode = "\nfunction " + dynamicImportFunctionName +
+	"(s) { return __jungletvDynamicImport(() => require(String(s))); }\n"
+
+// isESModule returns whether the given JavaScript source file should be loaded as an ES module
+func isESModule(filename string, source []byte) bool {
+	switch path.Ext(filename) {
+	case ".mjs":
+		return true
+	case ".cjs":
+		return false
+	}
+	return esModuleSyntaxRegex.Match(source)
+}
+
+// rewriteDynamicImports replaces dynamic import() calls with calls to the module-local dynamic import function.
+// String literals, template literal text, regular expression literals and comments are left untouched
+func rewriteDynamicImports(source string) string {
+	var b strings.Builder
+	b.Grow(len(source))
+
+	// substitutionBraceDepths has an entry for each template literal substitution (${...}) being scanned, holding the
+	// number of braces opened within the substitution that are yet to be closed
+	var substitutionBraceDepths []int
+	// whether a slash at this point starts a regular expression literal, as opposed to being a division operator
+	regexAllowed := true
+	// the last character of the last token scanned, used to skip property accesses like obj.import()
+	var prevChar byte
+
+	writeTemplateText := func(i int) int {
+		j, inSubstitution := scanTemplateText(source, i)
+		b.WriteString(source[i:j])
+		if inSubstitution {
+			substitutionBraceDepths = append(substitutionBraceDepths, 0)
+			regexAllowed, prevChar = true, '{'
+		} else {
+			regexAllowed, prevChar = false, '`'
+		}
+		return j
+	}
+
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(source) && source[j] != c && source[j] != '\n' {
+				if source[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			j = min(j+1, len(source))
+			b.WriteString(source[i:j])
+			i = j
+			regexAllowed, prevChar = false, c
+		case c == '`':
+			b.WriteByte(c)
+			i = writeTemplateText(i + 1)
+		case c == '}' && len(substitutionBraceDepths) > 0 && substitutionBraceDepths[len(substitutionBraceDepths)-1] == 0:
+			// end of a template literal substitution, the template literal text continues
+			substitutionBraceDepths = substitutionBraceDepths[:len(substitutionBraceDepths)-1]
+			b.WriteByte(c)
+			i = writeTemplateText(i + 1)
+		case strings.HasPrefix(source[i:], "//"):
+			j := strings.IndexByte(source[i:], '\n')
+			if j < 0 {
+				j = len(source) - i
+			}
+			b.WriteString(source[i : i+j])
+			i += j
+		case strings.HasPrefix(source[i:], "/*"):
+			j := strings.Index(source[i+2:], "*/")
+			if j < 0 {
+				j = len(source) - i
+			} else {
+				j += 4
+			}
+			b.WriteString(source[i : i+j])
+			i += j
+		case c == '/' && regexAllowed:
+			j := scanRegexLiteral(source, i)
+			b.WriteString(source[i:j])
+			i = j
+			regexAllowed, prevChar = false, '/'
+		case isIdentifierChar(c):
+			j := i
+			for j < len(source) && isIdentifierChar(source[j]) {
+				j++
+			}
+			word := source[i:j]
+			isDynamicImport := false
+			if word == "import" && prevChar != '.' {
+				k := j
+				for k < len(source) && (source[k] == ' ' || source[k] == '\t' || source[k] == '\n' || source[k] == '\r') {
+					k++
+				}
+				isDynamicImport = k < len(source) && source[k] == '('
+			}
+			if isDynamicImport {
+				b.WriteString(dynamicImportFunctionName)
+			} else {
+				b.WriteString(word)
+			}
+			i = j
+			regexAllowed, prevChar = slices.Contains(keywordsPrecedingExpressions, word), word[len(word)-1]
+		default:
+			if len(substitutionBraceDepths) > 0 {
+				if c == '{' {
+					substitutionBraceDepths[len(substitutionBraceDepths)-1]++
+				} else if c == '}' {
+					substitutionBraceDepths[len(substitutionBraceDepths)-1]--
+				}
+			}
+			b.WriteByte(c)
+			i++
+			if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+				regexAllowed, prevChar = c != ')' && c != ']', c
+			}
+		}
+	}
+	return b.String()
+}
+
+// keywordsPrecedingExpressions are the keywords after which a slash starts a regular expression literal
+var keywordsPrecedingExpressions = []string{"return", "typeof", "instanceof", "in", "of", "new", "delete", "void",
+	"throw", "case", "do", "else", "yield", "await"}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// scanTemplateText returns the index right after the template literal text starting at i, which ends either with the
+// closing backtick or with the start of a substitution, in which case inSubstitution is true
+func scanTemplateText(source string, i int) (end int, inSubstitution bool) {
+	for i < len(source) {
+		switch {
+		case source[i] == '\\':
+			i += 2
+		case source[i] == '`':
+			return i + 1, false
+		case strings.HasPrefix(source[i:], "${"):
+			return i + 2, true
+		default:
+			i++
+		}
+	}
+	return len(source), false
+}
+
+// scanRegexLiteral returns the index right after the regular expression literal (including its flags) whose opening
+// slash is at i
+func scanRegexLiteral(source string, i int) int {
+	inClass := false
+	for i++; i < len(source); i++ {
+		switch source[i] {
+		case '\\':
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '\n':
+			// unterminated, let it surface as a syntax error when the module is transpiled
+			return i
+		case '/':
+			if !inClass {
+				i++
+				for i < len(source) && isIdentifierChar(source[i]) {
+					i++
+				}
+				return i
+			}
+		}
+	}
+	return len(source)
+}
+
+// finishServerModule adds the runtime support for dynamic imports to transpiled module code and, if the code contains
+// top-level await, wraps it in an async function whose evaluation promise is registered for the module
+func finishServerModule(filename, transpiled string) string {
+	sourceMapComment := ""
+	if loc := sourceMappingRegex.FindStringIndex(transpiled); loc != nil {
+		sourceMapComment = transpiled[loc[0]:loc[1]]
+		transpiled = transpiled[:loc[0]] + transpiled[loc[1]:]
+	}
+
+	// the wrapping is kept on the first line of the code, so that line numbers (and the source map) remain correct
+	if !parsesAsCommonJSModule(filename, transpiled) {
+		wrapped := "__jungletvModuleEvaluations.set(exports, (async () => {" + transpiled + "\n})());"
+		if parsesAsCommonJSModule(filename, wrapped) {
+			transpiled = wrapped
+		}
+		// otherwise, the syntax error is unrelated to top-level await, let it surface when the module is executed
+	}
+
+	return transpiled + dynamicImportFunctionCode + sourceMapComment
+}
+
+func parsesAsCommonJSModule(filename, source string) bool {
+	_, err := goja.Parse(filename, "(function(exports, require, module) {"+source+"\n})")
+	return err == nil
+}
@@ -0,0 +1,62 @@
+package apprunner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsESModule(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]bool{
+		`import { a } from "./a";`:        true,
+		`import * as a from "./a";`:       true,
+		`import a from "./a";`:            true,
+		`import "./a";`:                   true,
+		`import{a}from"./a";`:             true,
+		"  export const a = 1;":           true,
+		"export default 1;":               true,
+		`const a = require("./a");`:       false,
+		`import("./a").then(() => {});`:   false,
+		"const url = import.meta.url;":    false,
+		"importance = 1;":                 false,
+		"importFoo();":                    false,
+		"exports.a = 1;":                  false,
+		"// import { a } from \"./a\";":   false,
+		"const a = 1;\nimport b from 'b'": true,
+	}
+	for input, expected := range testCases {
+		require.Equal(t, expected, isESModule("main.js", []byte(input)), input)
+	}
+
+	require.True(t, isESModule("main.mjs", []byte("const a = 1;")))
+	require.False(t, isESModule("main.cjs", []byte(`import a from "./a";`)))
+}
+
+func TestRewriteDynamicImports(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]string{
+		`import("./a.mjs")`:                                  `__jungletvImport("./a.mjs")`,
+		`await import ("./a.mjs")`:                           `await __jungletvImport ("./a.mjs")`,
+		`import { a } from "./a.mjs"`:                        `import { a } from "./a.mjs"`,
+		"const u = import.meta.url":                          "const u = import.meta.url",
+		`obj.import("./a.mjs")`:                              `obj.import("./a.mjs")`,
+		`reimport("./a.mjs")`:                                `reimport("./a.mjs")`,
+		`const s = "import('./a.mjs')"`:                      `const s = "import('./a.mjs')"`,
+		`const s = 'import("./a.mjs")'`:                      `const s = 'import("./a.mjs")'`,
+		"// import('./a.mjs')\nimport('./b.mjs')":            "// import('./a.mjs')\n__jungletvImport('./b.mjs')",
+		"/* import('./a.mjs') */ import('./b.mjs')":          "/* import('./a.mjs') */ __jungletvImport('./b.mjs')",
+		"const s = `import('./a.mjs')`":                      "const s = `import('./a.mjs')`",
+		"const s = `${await import('./a.mjs')}`":             "const s = `${await __jungletvImport('./a.mjs')}`",
+		"`a ${ {b: 1}.b } import('x') ${import('./a.mjs')}`": "`a ${ {b: 1}.b } import('x') ${__jungletvImport('./a.mjs')}`",
+		"`${`${import('./a.mjs')}`}`":                        "`${`${__jungletvImport('./a.mjs')}`}`",
+		"const r = /`/; import('./a.mjs')":                   "const r = /`/; __jungletvImport('./a.mjs')",
+		"const r = /[/\"]/g; import('./a.mjs')":              "const r = /[/\"]/g; __jungletvImport('./a.mjs')",
+		"if (x) return /'/.test(s); import('./a.mjs')":       "if (x) return /'/.test(s); __jungletvImport('./a.mjs')",
+		"const d = a / b / c; import('./a.mjs')":             "const d = a / b / c; __jungletvImport('./a.mjs')",
+		"const d = (a) / 2; import('./a.mjs')":               "const d = (a) / 2; __jungletvImport('./a.mjs')",
+	}
+	for input, expected := range testCases {
+		require.Equal(t, expected, rewriteDynamicImports(input), input)
+	}
+}
@@ -0,0 +1,236 @@
+package apprunner
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja/parser"
+	"github.com/palantir/stacktrace"
+)
+
+// goja does not expose its heap, so heap snapshots are approximated by traversing the object graphs reachable from
+// the global scope of the application (properties of the global object, and top-level declarations of the main
+// file). Variables captured by closures and the internal state of native objects can't be reached.
+// Sizes are estimates, meant for comparing object graphs against each other and across snapshots.
+
+// maxHeapSnapshotObjects is the maximum number of objects visited when taking a heap snapshot
+const maxHeapSnapshotObjects = 1000000
+
+const heapSnapshotObjectSize = 64
+const heapSnapshotPropertySize = 16
+const heapSnapshotPrimitiveSize = 8
+const heapSnapshotStringSize = 16
+
+// heapSnapshotChildrenCode evaluates to a function that returns the values referenced by an object, without
+// invoking getters
+const heapSnapshotChildrenCode = `(function(o) {
+    const children = [];
+    for (const k of Reflect.ownKeys(o)) {
+        const d = Reflect.getOwnPropertyDescriptor(o, k);
+        if (d && "value" in d) children.push(d.value);
+    }
+    if (o instanceof Map) for (const e of Map.prototype.entries.call(o)) children.push(e[0], e[1]);
+    else if (o instanceof Set) for (const v of Set.prototype.values.call(o)) children.push(v);
+    return children;
+})`
+
+// HeapSnapshotEntry describes the object graph reachable from a root in the global scope of an application
+type HeapSnapshotEntry struct {
+	RootName string
+	// estimated size of the objects that are reachable from this root but not from any other root
+	RetainedSize int64
+	// estimated size of all the objects reachable from this root
+	ReachableSize int64
+	ObjectCount   int
+}
+
+// HeapSnapshot contains a summary of the object graphs held by an application
+type HeapSnapshot struct {
+	Entries            []HeapSnapshotEntry
+	TotalSize          int64
+	TotalObjectCount   int
+	Truncated          bool
+	ExecutionTime      time.Duration
+	ApplicationVersion time.Time
+}
+
+func isRuntimeGlobalName(name string) bool {
+	return strings.HasPrefix(name, "__jungletv")
+}
+
+// TakeHeapSnapshot returns a summary of the largest object graphs held by the specified application
+func (r *AppRunner) TakeHeapSnapshot(ctx context.Context, applicationID string, maxEntries int) (HeapSnapshot, error) {
+	var instance *appInstance
+	var ok bool
+	func() {
+		r.instancesLock.RLock()
+		defer r.instancesLock.RUnlock()
+		instance, ok = r.instances[applicationID]
+	}()
+	if !ok {
+		return HeapSnapshot{}, stacktrace.Propagate(ErrApplicationNotInstantiated, "")
+	}
+	snapshot, err := instance.TakeHeapSnapshot(ctx, maxEntries)
+	return snapshot, stacktrace.Propagate(err, "")
+}
+
+func (a *appInstance) TakeHeapSnapshot(ctx context.Context, maxEntries int) (HeapSnapshot, error) {
+	snapshot, executionTime, err := runOnLoopSynchronouslyAndGetResult(ctx, a, func(vm *goja.Runtime) (HeapSnapshot, error) {
+		return a.takeHeapSnapshot(vm, maxEntries)
+	})
+	snapshot.ExecutionTime = executionTime
+	snapshot.ApplicationVersion = time.Time(a.applicationVersion)
+	return snapshot, stacktrace.Propagate(err, "")
+}
+
+type heapSnapshotRoot struct {
+	name  string
+	value goja.Value
+}
+
+type heapSnapshotObjectInfo struct {
+	size int64
+	// index of the first root through which the object was reached, or -1 if reached through multiple roots
+	root int
+}
+
+func (a *appInstance) heapSnapshotRoots(vm *goja.Runtime) []heapSnapshotRoot {
+	roots := []heapSnapshotRoot{}
+	seen := make(map[string]struct{})
+	global := vm.GlobalObject()
+	// properties of the global object defined by the ECMAScript standard are not enumerable
+	for _, name := range global.Keys() {
+		if isRuntimeGlobalName(name) {
+			continue
+		}
+		seen[name] = struct{}{}
+		roots = append(roots, heapSnapshotRoot{name, global.Get(name)})
+	}
+
+	// top-level lexical declarations are not properties of the global object
+	program, err := goja.Parse("", a.mainSource, parser.WithDisableSourceMaps)
+	if err != nil {
+		return roots
+	}
+	for _, name := range collectDeclaredNames(program.Body) {
+		if _, ok := seen[name]; ok || isRuntimeGlobalName(name) {
+			continue
+		}
+		value, err := vm.RunString(name)
+		if err != nil {
+			// most likely in the temporal dead zone
+			continue
+		}
+		roots = append(roots, heapSnapshotRoot{name, value})
+	}
+	return roots
+}
+
+func (a *appInstance) takeHeapSnapshot(vm *goja.Runtime, maxEntries int) (HeapSnapshot, error) {
+	childrenValue, err := vm.RunString(heapSnapshotChildrenCode)
+	if err != nil {
+		return HeapSnapshot{}, stacktrace.Propagate(err, "")
+	}
+	childrenOf, ok := goja.AssertFunction(childrenValue)
+	if !ok {
+		return HeapSnapshot{}, stacktrace.NewError("heap snapshot helper is not a function")
+	}
+
+	children := func(obj *goja.Object) []goja.Value {
+		if obj.ExportType() == reflect.TypeOf(goja.Proxy{}) {
+			// proxy traps would run application code
+			return nil
+		}
+		result, err := childrenOf(goja.Undefined(), obj)
+		if err != nil {
+			return nil
+		}
+		var children []goja.Value
+		if err := vm.ExportTo(result, &children); err != nil {
+			return nil
+		}
+		return children
+	}
+
+	roots := a.heapSnapshotRoots(vm)
+	entries := make([]HeapSnapshotEntry, len(roots))
+	objects := make(map[*goja.Object]*heapSnapshotObjectInfo)
+	snapshot := HeapSnapshot{}
+
+	for i, root := range roots {
+		entries[i].RootName = root.name
+		rootObject, isObject := root.value.(*goja.Object)
+		if !isObject {
+			entries[i].RetainedSize = primitiveSize(root.value)
+			entries[i].ReachableSize = entries[i].RetainedSize
+			continue
+		}
+		visited := map[*goja.Object]struct{}{rootObject: {}}
+		queue := []*goja.Object{rootObject}
+		for len(queue) > 0 {
+			obj := queue[0]
+			queue = queue[1:]
+
+			info, ok := objects[obj]
+			if !ok && len(objects) >= maxHeapSnapshotObjects {
+				snapshot.Truncated = true
+				continue
+			}
+			objectChildren := children(obj)
+			if !ok {
+				info = &heapSnapshotObjectInfo{
+					size: heapSnapshotObjectSize + int64(len(objectChildren))*heapSnapshotPropertySize,
+					root: i,
+				}
+				for _, child := range objectChildren {
+					info.size += primitiveSize(child)
+				}
+				objects[obj] = info
+			} else if info.root != i {
+				info.root = -1
+			}
+			entries[i].ReachableSize += info.size
+			entries[i].ObjectCount++
+
+			for _, child := range objectChildren {
+				if childObject, isObject := child.(*goja.Object); isObject {
+					if _, ok := visited[childObject]; !ok {
+						visited[childObject] = struct{}{}
+						queue = append(queue, childObject)
+					}
+				}
+			}
+		}
+	}
+
+	for _, info := range objects {
+		snapshot.TotalSize += info.size
+		if info.root >= 0 {
+			entries[info.root].RetainedSize += info.size
+		}
+	}
+	snapshot.TotalObjectCount = len(objects)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].RetainedSize > entries[j].RetainedSize
+	})
+	if maxEntries > 0 && len(entries) > maxEntries {
+		entries = entries[:maxEntries]
+	}
+	snapshot.Entries = entries
+	return snapshot, nil
+}
+
+func primitiveSize(v goja.Value) int64 {
+	if v == nil || goja.IsUndefined(v) || goja.IsNull(v) {
+		return 0
+	}
+	if t := v.ExportType(); t != nil && t.Kind() == reflect.String {
+		return heapSnapshotStringSize + int64(len(v.String()))
+	}
+	return heapSnapshotPrimitiveSize
+}
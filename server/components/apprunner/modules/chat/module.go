@@ -2,6 +2,8 @@ package chat
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -75,7 +77,10 @@ func (m *chatModule) ModuleLoader() require.ModuleLoader {
 		m.exports.Set("createSystemMessage", m.createSystemMessage)
 		m.exports.Set("createMessage", m.createMessage)
 		m.exports.Set("createMessageWithPageAttachment", m.createMessageWithPageAttachment)
+		m.exports.Set("createPoll", m.createPoll)
 		m.exports.Set("getMessages", m.getMessages)
+		m.exports.Set("muteUser", m.muteUser)
+		m.exports.Set("unmuteUser", m.unmuteUser)
 
 		m.exports.DefineAccessorProperty("nickname", m.runtime.ToValue(func(call goja.FunctionCall) goja.Value {
 			return m.runtime.ToValue(m.chatManager.GetNickname(m.executionContext, m.appUser))
@@ -106,6 +111,20 @@ func (m *chatModule) ModuleLoader() require.ModuleLoader {
 				"messageID": arg.String(),
 			}
 		})
+		gojautil.AdaptEvent(m.eventAdapter, m.chatManager.OnMessageEdited(), "messageedited", func(vm *goja.Runtime, arg *chat.Message) map[string]interface{} {
+			return map[string]interface{}{
+				"message": m.serializeMessage(m.executionContext, arg),
+			}
+		})
+		gojautil.AdaptEvent(m.eventAdapter, m.chatManager.OnMessageReactionUpdated(), "reactionupdated", func(vm *goja.Runtime, arg chatmanager.MessageReactionUpdatedEventArgs) map[string]interface{} {
+			return map[string]interface{}{
+				"messageID": arg.MessageID.String(),
+				"reaction":  arg.Reaction,
+				"added":     arg.Added,
+				"count":     arg.Count,
+				"user":      gojautil.SerializeUser(vm, arg.Reactor),
+			}
+		})
 		m.eventAdapter.StartOrResume()
 	}
 }
@@ -137,6 +156,11 @@ func (m *chatModule) serializeMessage(ctx context.Context, message *chat.Message
 		"content":      message.Content,
 		"shadowbanned": message.Shadowbanned,
 		"author":       gojautil.SerializeUser(m.runtime, message.Author),
+		"channel":      message.Channel,
+	}
+
+	if message.EditedAt != nil {
+		result["editedAt"] = gojautil.SerializeTime(m.runtime, *message.EditedAt)
 	}
 
 	if message.Reference != nil {
@@ -149,6 +173,15 @@ func (m *chatModule) serializeMessage(ctx context.Context, message *chat.Message
 	}
 	result["attachments"] = attachments
 
+	reactions := []map[string]interface{}{}
+	for _, r := range message.Reactions {
+		reactions = append(reactions, map[string]interface{}{
+			"reaction": r.Reaction,
+			"count":    r.Count,
+		})
+	}
+	result["reactions"] = reactions
+
 	return m.runtime.ToValue(result)
 }
 
@@ -157,8 +190,9 @@ func (m *chatModule) createSystemMessage(call goja.FunctionCall) goja.Value {
 		panic(m.runtime.NewTypeError("Missing argument"))
 	}
 	contentValue := call.Argument(0)
+	channelID := m.channelIDFromArgument(call.Argument(1), chat.MainChannelID)
 
-	message, err := m.chatManager.CreateSystemMessage(m.executionContext, contentValue.String())
+	message, err := m.chatManager.CreateSystemMessageInChannel(m.executionContext, channelID, contentValue.String())
 	if err != nil {
 		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
 	}
@@ -193,7 +227,9 @@ func (m *chatModule) createMessage(call goja.FunctionCall) goja.Value {
 		panic(m.runtime.NewTypeError("Message content is empty"))
 	}
 
-	message, err := m.chatManager.CreateMessage(m.executionContext, m.appUser, content, reference, []chat.MessageAttachmentStorage{})
+	channelID := m.channelIDFromArgument(call.Argument(2), channelOfReference(reference))
+
+	message, err := m.chatManager.CreateMessage(m.executionContext, m.appUser, channelID, content, reference, []chat.MessageAttachmentStorage{})
 	if err != nil {
 		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
 	}
@@ -251,10 +287,58 @@ func (m *chatModule) createMessageWithPageAttachment(call goja.FunctionCall) goj
 		content = strings.TrimSpace(contentValue.String())
 	}
 
-	message, err := m.chatManager.CreateMessage(m.executionContext, m.appUser, content, reference, []chat.MessageAttachmentStorage{attachment})
+	channelID := m.channelIDFromArgument(call.Argument(4), channelOfReference(reference))
+
+	message, err := m.chatManager.CreateMessage(m.executionContext, m.appUser, channelID, content, reference, []chat.MessageAttachmentStorage{attachment})
+	if err != nil {
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+
+	return m.serializeMessage(m.executionContext, message)
+}
+
+func (m *chatModule) createPoll(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 3 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	question := call.Argument(0).String()
+
+	var options []string
+	err := m.runtime.ExportTo(call.Argument(1), &options)
+	if err != nil {
+		panic(m.runtime.NewTypeError("Second argument to createPoll must be an array of strings"))
+	}
+
+	var durationMs int64
+	err = m.runtime.ExportTo(call.Argument(2), &durationMs)
+	if err != nil {
+		panic(m.runtime.NewTypeError("Third argument to createPoll must be a number of milliseconds"))
+	}
+
+	votingMode := types.ChatPollVotingModeSingle
+	pointsPerVote := 0
+	pointsPerVoteValue := call.Argument(3)
+	if !goja.IsUndefined(pointsPerVoteValue) && !goja.IsNull(pointsPerVoteValue) {
+		err = m.runtime.ExportTo(pointsPerVoteValue, &pointsPerVote)
+		if err != nil || pointsPerVote < 0 {
+			panic(m.runtime.NewTypeError("Fourth argument to createPoll must be a non-negative integer"))
+		}
+		if pointsPerVote > 0 {
+			votingMode = types.ChatPollVotingModePoints
+		}
+	}
+
+	channelID := m.channelIDFromArgument(call.Argument(4), chat.MainChannelID)
+
+	message, poll, err := m.chatManager.CreatePoll(m.executionContext, m.appUser, channelID, question, options,
+		time.Duration(durationMs)*time.Millisecond, votingMode, pointsPerVote)
 	if err != nil {
+		if errors.Is(err, chatmanager.ErrInvalidPoll) {
+			panic(m.runtime.NewTypeError(stacktrace.RootCause(err).Error()))
+		}
 		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
 	}
+	m.logger.RuntimeAuditLog(fmt.Sprintf("created chat poll %s in channel %s with question %s", poll.ID, poll.Channel, poll.Question))
 
 	return m.serializeMessage(m.executionContext, message)
 }
@@ -273,9 +357,10 @@ func (m *chatModule) getMessages(call goja.FunctionCall) goja.Value {
 	if err != nil {
 		panic(m.runtime.NewTypeError("Second argument to getMessages must be a Date"))
 	}
+	channelID := m.channelIDFromArgument(call.Argument(2), chat.MainChannelID)
 
 	return gojautil.DoAsyncWithTransformer(m.runtime, m.runOnLoop, func(actx gojautil.AsyncContext) ([]*chat.Message, gojautil.PromiseResultTransformer[[]*chat.Message]) {
-		messages, err := m.chatManager.LoadMessagesBetween(m.executionContext, nil, since, until)
+		messages, err := m.chatManager.LoadMessagesBetween(m.executionContext, channelID, nil, since, until)
 		if err != nil {
 			panic(actx.NewGoError(stacktrace.Propagate(err, "")))
 		}
@@ -290,6 +375,72 @@ func (m *chatModule) getMessages(call goja.FunctionCall) goja.Value {
 	})
 }
 
+// channelIDFromArgument returns the chat channel ID passed in an optional argument, or defaultChannelID if the
+// argument was not specified
+func (m *chatModule) channelIDFromArgument(value goja.Value, defaultChannelID string) string {
+	if goja.IsUndefined(value) || goja.IsNull(value) || value.String() == "" {
+		return defaultChannelID
+	}
+	return value.String()
+}
+
+// channelOfReference returns the channel in which replies to the given message should be created by default
+func channelOfReference(reference *chat.Message) string {
+	if reference == nil {
+		return chat.MainChannelID
+	}
+	return reference.Channel
+}
+
+func (m *chatModule) muteUser(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 2 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	address := call.Argument(0).String()
+	reason := call.Argument(1).String()
+
+	var duration time.Duration
+	durationValue := call.Argument(2)
+	if !goja.IsUndefined(durationValue) && !goja.IsNull(durationValue) {
+		var durationMs int64
+		err := m.runtime.ExportTo(durationValue, &durationMs)
+		if err != nil {
+			panic(m.runtime.NewTypeError("Third argument to muteUser must be a number of milliseconds"))
+		}
+		duration = time.Duration(durationMs) * time.Millisecond
+	}
+
+	mute, err := m.chatManager.MuteUser(m.executionContext, address, duration, types.ChatMuteSourceApplication, reason, m.appUser, m.infoProvider.ApplicationID())
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrInvalidMuteDuration) {
+			panic(m.runtime.NewTypeError("Invalid mute duration"))
+		}
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+	m.logger.RuntimeAuditLog(fmt.Sprintf("muted %s from chat until %s with reason %s", address, mute.MutedUntil.Format(time.RFC3339), reason))
+
+	return gojautil.SerializeTime(m.runtime, mute.MutedUntil)
+}
+
+func (m *chatModule) unmuteUser(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 2 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	address := call.Argument(0).String()
+	reason := call.Argument(1).String()
+
+	_, err := m.chatManager.UnmuteUser(m.executionContext, address, reason, m.appUser)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrUserNotMuted) {
+			return m.runtime.ToValue(false)
+		}
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+	m.logger.RuntimeAuditLog(fmt.Sprintf("lifted chat mute of %s with reason %s", address, reason))
+
+	return m.runtime.ToValue(true)
+}
+
 func (m *chatModule) setApplicationNickname(call goja.FunctionCall) goja.Value {
 	if len(call.Arguments) < 1 {
 		panic(m.runtime.NewTypeError("Missing argument"))
@@ -0,0 +1,129 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/require"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
+	"github.com/tnyim/jungletv/server/components/apprunner/modules"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ModuleName is the name by which this module can be require()d in a script
+const ModuleName = "jungletv:settings"
+
+type settingsModule struct {
+	runtime         *goja.Runtime
+	exports         *goja.Object
+	infoProvider    ProcessInformationProvider
+	schedule        gojautil.ScheduleFunction
+	eventAdapter    *gojautil.EventAdapter
+	settingsChanged event.Event[[]string]
+	schema          *Schema
+
+	executionContext context.Context
+}
+
+// ProcessInformationProvider can get information about the process
+type ProcessInformationProvider interface {
+	ApplicationID() string
+	ApplicationVersion() types.ApplicationVersion
+}
+
+// New returns a new settings module.
+// settingsChanged should be notified with the keys of the settings whose values were changed by administrators
+func New(infoProvider ProcessInformationProvider, settingsChanged event.Event[[]string], schedule gojautil.ScheduleFunction) modules.NativeModule {
+	return &settingsModule{
+		infoProvider:    infoProvider,
+		settingsChanged: settingsChanged,
+		schedule:        schedule,
+	}
+}
+
+func (m *settingsModule) IsNodeBuiltin() bool {
+	return false
+}
+
+func (m *settingsModule) ModuleLoader() require.ModuleLoader {
+	return func(runtime *goja.Runtime, module *goja.Object) {
+		m.runtime = runtime
+		m.eventAdapter = gojautil.NewEventAdapter(runtime, m.schedule)
+		m.exports = module.Get("exports").(*goja.Object)
+		m.exports.Set("addEventListener", m.eventAdapter.AddEventListener)
+		m.exports.Set("removeEventListener", m.eventAdapter.RemoveEventListener)
+		m.exports.Set("get", m.get)
+		m.exports.Set("getAll", m.getAll)
+
+		gojautil.AdaptEvent(m.eventAdapter, m.settingsChanged, "settingschanged", func(vm *goja.Runtime, keys []string) map[string]interface{} {
+			return map[string]interface{}{
+				"keys": keys,
+			}
+		})
+		m.eventAdapter.StartOrResume()
+	}
+}
+func (m *settingsModule) ModuleName() string {
+	return ModuleName
+}
+func (m *settingsModule) AutoRequire() (bool, string) {
+	return false, ""
+}
+
+func (m *settingsModule) ExecutionResumed(ctx context.Context) {
+	m.executionContext = ctx
+	if m.eventAdapter != nil {
+		m.eventAdapter.StartOrResume()
+	}
+}
+
+func (m *settingsModule) ExecutionPaused() {
+	if m.eventAdapter != nil {
+		m.eventAdapter.Pause()
+	}
+	m.executionContext = nil
+}
+
+func (m *settingsModule) resolveValues() map[string]interface{} {
+	ctx, err := transaction.Begin(m.executionContext)
+	if err != nil {
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+	defer ctx.Commit() // read-only tx
+
+	if m.schema == nil {
+		// the schema can't change during the lifetime of the instance, as it is bound to the application version
+		version := m.infoProvider.ApplicationVersion()
+		m.schema, err = LoadSchema(ctx, m.infoProvider.ApplicationID(), &version)
+		if err != nil {
+			panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+		}
+	}
+
+	setValues, err := LoadSetValues(ctx, m.infoProvider.ApplicationID())
+	if err != nil {
+		panic(m.runtime.NewGoError(stacktrace.Propagate(err, "")))
+	}
+	return m.schema.ResolveValues(setValues)
+}
+
+func (m *settingsModule) get(call goja.FunctionCall) goja.Value {
+	if len(call.Arguments) < 1 {
+		panic(m.runtime.NewTypeError("Missing argument"))
+	}
+	key := call.Argument(0).String()
+
+	values := m.resolveValues()
+	value, ok := values[key]
+	if !ok {
+		panic(m.runtime.NewTypeError("Setting '%s' is not declared in %s", key, SchemaFileName))
+	}
+	return m.runtime.ToValue(value)
+}
+
+func (m *settingsModule) getAll(call goja.FunctionCall) goja.Value {
+	return m.runtime.ToValue(m.resolveValues())
+}
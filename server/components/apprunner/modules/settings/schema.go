@@ -0,0 +1,234 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+)
+
+// SchemaFileName is the name of the application file containing the declaration of the application settings
+const SchemaFileName = "settings.json"
+
+// MaxSettingsPerApplication is the maximum number of settings an application can declare
+const MaxSettingsPerApplication = 100
+
+// MaxSettingValueLength is the maximum length of the JSON representation of a setting value
+const MaxSettingValueLength = 4096
+
+// SettingType is the type of the value of a setting
+type SettingType string
+
+// SettingTypeString is the type of settings whose values are strings
+const SettingTypeString SettingType = "string"
+
+// SettingTypeNumber is the type of settings whose values are numbers
+const SettingTypeNumber SettingType = "number"
+
+// SettingTypeInteger is the type of settings whose values are integer numbers
+const SettingTypeInteger SettingType = "integer"
+
+// SettingTypeBoolean is the type of settings whose values are booleans
+const SettingTypeBoolean SettingType = "boolean"
+
+// ErrSettingNotFound is returned when the specified setting is not declared by the application
+var ErrSettingNotFound = errors.New("setting not found")
+
+// ErrInvalidSchema is returned when the settings schema of an application is malformed
+var ErrInvalidSchema = errors.New("invalid settings schema")
+
+// ValidationError is returned when a value does not satisfy the constraints of a setting
+type ValidationError struct {
+	Key    string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid value for setting %s: %s", e.Key, e.Reason)
+}
+
+// Definition is the declaration of an application setting
+type Definition struct {
+	Key         string        `json:"key"`
+	Type        SettingType   `json:"type"`
+	Label       string        `json:"label,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Default     interface{}   `json:"default"`
+	Minimum     *float64      `json:"minimum,omitempty"`
+	Maximum     *float64      `json:"maximum,omitempty"`
+	MinLength   *int          `json:"minLength,omitempty"`
+	MaxLength   *int          `json:"maxLength,omitempty"`
+	Pattern     string        `json:"pattern,omitempty"`
+	Options     []interface{} `json:"options,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Schema is the set of settings declared by an application, in declaration order
+type Schema struct {
+	Definitions []*Definition
+	byKey       map[string]*Definition
+}
+
+var settingKeyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,127}$`)
+
+// EmptySchema returns the schema of an application that declares no settings
+func EmptySchema() *Schema {
+	return &Schema{
+		byKey: make(map[string]*Definition),
+	}
+}
+
+// ParseSchema parses and validates the contents of a settings schema file, which must contain a JSON array of
+// setting definitions
+func ParseSchema(content []byte) (*Schema, error) {
+	var definitions []*Definition
+	err := sonic.Unmarshal(content, &definitions)
+	if err != nil {
+		return nil, stacktrace.Propagate(ErrInvalidSchema, "malformed JSON: %v", err)
+	}
+	if len(definitions) > MaxSettingsPerApplication {
+		return nil, stacktrace.Propagate(ErrInvalidSchema, "too many settings (maximum is %d)", MaxSettingsPerApplication)
+	}
+
+	schema := EmptySchema()
+	for i, d := range definitions {
+		if d == nil {
+			return nil, stacktrace.Propagate(ErrInvalidSchema, "setting at index %d is null", i)
+		}
+		if !settingKeyRegexp.MatchString(d.Key) {
+			return nil, stacktrace.Propagate(ErrInvalidSchema, "setting at index %d has an invalid key", i)
+		}
+		if _, present := schema.byKey[d.Key]; present {
+			return nil, stacktrace.Propagate(ErrInvalidSchema, "setting %s is declared more than once", d.Key)
+		}
+		switch d.Type {
+		case SettingTypeString, SettingTypeNumber, SettingTypeInteger, SettingTypeBoolean:
+		default:
+			return nil, stacktrace.Propagate(ErrInvalidSchema, "setting %s has an invalid type", d.Key)
+		}
+		if d.Pattern != "" {
+			d.pattern, err = regexp.Compile(d.Pattern)
+			if err != nil {
+				return nil, stacktrace.Propagate(ErrInvalidSchema, "setting %s has an invalid pattern: %v", d.Key, err)
+			}
+		}
+		for j, option := range d.Options {
+			d.Options[j], err = d.validateType(option)
+			if err != nil {
+				return nil, stacktrace.Propagate(ErrInvalidSchema, "setting %s has an invalid option: %v", d.Key, err)
+			}
+		}
+		d.Default, err = d.Validate(d.Default)
+		if err != nil {
+			return nil, stacktrace.Propagate(ErrInvalidSchema, "setting %s has an invalid default value: %v", d.Key, err)
+		}
+		schema.Definitions = append(schema.Definitions, d)
+		schema.byKey[d.Key] = d
+	}
+	return schema, nil
+}
+
+// Definition returns the definition of the setting with the given key
+func (s *Schema) Definition(key string) (*Definition, bool) {
+	d, ok := s.byKey[key]
+	return d, ok
+}
+
+// ResolveValues returns the value of each setting in the schema, given the JSON-encoded values set by administrators.
+// Settings without a set value, or whose set value no longer satisfies the schema, take their default value
+func (s *Schema) ResolveValues(setValues map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(s.Definitions))
+	for _, d := range s.Definitions {
+		result[d.Key] = d.Default
+		if encoded, ok := setValues[d.Key]; ok {
+			if value, err := d.ParseAndValidate(encoded); err == nil {
+				result[d.Key] = value
+			}
+		}
+	}
+	return result
+}
+
+// ParseAndValidate decodes a JSON-encoded value and checks it against the constraints of the setting, returning the
+// normalized value
+func (d *Definition) ParseAndValidate(encoded string) (interface{}, error) {
+	if len(encoded) > MaxSettingValueLength {
+		return nil, &ValidationError{Key: d.Key, Reason: "value too long"}
+	}
+	var value interface{}
+	err := sonic.UnmarshalString(encoded, &value)
+	if err != nil {
+		return nil, &ValidationError{Key: d.Key, Reason: "malformed JSON"}
+	}
+	return d.Validate(value)
+}
+
+// Validate checks a decoded JSON value against the constraints of the setting, returning the normalized value
+func (d *Definition) Validate(value interface{}) (interface{}, error) {
+	value, err := d.validateType(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(d.Options) > 0 {
+		found := false
+		for _, option := range d.Options {
+			if option == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &ValidationError{Key: d.Key, Reason: "value is not one of the allowed options"}
+		}
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if d.Minimum != nil && v < *d.Minimum {
+			return nil, &ValidationError{Key: d.Key, Reason: fmt.Sprintf("value must be at least %v", *d.Minimum)}
+		}
+		if d.Maximum != nil && v > *d.Maximum {
+			return nil, &ValidationError{Key: d.Key, Reason: fmt.Sprintf("value must be at most %v", *d.Maximum)}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if d.MinLength != nil && length < *d.MinLength {
+			return nil, &ValidationError{Key: d.Key, Reason: fmt.Sprintf("value must have at least %d characters", *d.MinLength)}
+		}
+		if d.MaxLength != nil && length > *d.MaxLength {
+			return nil, &ValidationError{Key: d.Key, Reason: fmt.Sprintf("value must have at most %d characters", *d.MaxLength)}
+		}
+		if d.pattern != nil && !d.pattern.MatchString(v) {
+			return nil, &ValidationError{Key: d.Key, Reason: "value does not match the required pattern"}
+		}
+	}
+	return value, nil
+}
+
+func (d *Definition) validateType(value interface{}) (interface{}, error) {
+	switch d.Type {
+	case SettingTypeString:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case SettingTypeNumber:
+		if v, ok := value.(float64); ok && !math.IsInf(v, 0) && !math.IsNaN(v) {
+			return v, nil
+		}
+	case SettingTypeInteger:
+		if v, ok := value.(float64); ok && v == math.Trunc(v) && math.Abs(v) <= 1<<53 {
+			return v, nil
+		}
+	case SettingTypeBoolean:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	}
+	return nil, &ValidationError{Key: d.Key, Reason: fmt.Sprintf("value must be of type %s", d.Type)}
+}
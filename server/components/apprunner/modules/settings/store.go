@@ -0,0 +1,42 @@
+package settings
+
+import (
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+)
+
+// LoadSchema loads the settings schema of an application at the specified version, or at its latest version if
+// version is nil. Applications without a settings schema file have an empty schema
+func LoadSchema(node sqalx.Node, applicationID string, version *types.ApplicationVersion) (*Schema, error) {
+	var files map[string]*types.ApplicationFile
+	var err error
+	if version != nil {
+		files, err = types.GetApplicationFilesWithNamesForApplicationAtVersion(node, applicationID, *version, []string{SchemaFileName})
+	} else {
+		files, err = types.GetApplicationFilesWithNamesForApplication(node, applicationID, []string{SchemaFileName})
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	file, ok := files[SchemaFileName]
+	if !ok {
+		return EmptySchema(), nil
+	}
+	schema, err := ParseSchema(file.Content)
+	return schema, stacktrace.Propagate(err, "")
+}
+
+// LoadSetValues returns the JSON-encoded values set by administrators for the settings of an application, indexed by
+// key
+func LoadSetValues(node sqalx.Node, applicationID string) (map[string]string, error) {
+	settings, err := types.GetApplicationSettingsForApplication(node, applicationID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	result := make(map[string]string, len(settings))
+	for key, setting := range settings {
+		result[key] = setting.Value
+	}
+	return result, nil
+}
@@ -0,0 +1,155 @@
+package apprunner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/google/pprof/profile"
+	"github.com/palantir/stacktrace"
+	"golang.org/x/exp/slices"
+)
+
+// MaxProfileDuration is the maximum duration of an application execution profile.
+// Profiles are automatically stopped after this duration, but their result remains available until collected
+const MaxProfileDuration = 5 * time.Minute
+
+// ErrProfilerAlreadyActive is returned when attempting to start profiling an application while a profile is already
+// being recorded
+var ErrProfilerAlreadyActive = errors.New("profiler already active")
+
+// ErrProfilerNotActive is returned when attempting to stop a profile that was not started
+var ErrProfilerNotActive = errors.New("profiler not active for this application")
+
+// activeProfile is an application execution profile being recorded or awaiting collection.
+// goja's profiler samples every runtime within the process, so only one profile may be recorded at a time.
+// Samples are attributed to the profiled application by the names of the files it loaded, which means that other
+// applications running concurrently and executing files with the same names may contribute to the profile
+type activeProfile struct {
+	applicationID string
+	instance      *appInstance
+	buf           bytes.Buffer
+	startedAt     time.Time
+	stoppedAt     time.Time
+	timer         *time.Timer
+}
+
+// StartProfilingApplication starts recording an execution profile of the specified application.
+// The profile must be collected using StopProfilingApplication
+func (r *AppRunner) StartProfilingApplication(applicationID string) error {
+	r.instancesLock.RLock()
+	instance, ok := r.instances[applicationID]
+	r.instancesLock.RUnlock()
+	if !ok {
+		return stacktrace.Propagate(ErrApplicationNotInstantiated, "")
+	}
+	if running, _, _ := instance.Running(); !running {
+		return stacktrace.Propagate(ErrApplicationInstanceNotRunning, "")
+	}
+
+	r.profileMu.Lock()
+	defer r.profileMu.Unlock()
+
+	if r.profile != nil && r.profile.stoppedAt.IsZero() {
+		return stacktrace.Propagate(ErrProfilerAlreadyActive, "application %s is being profiled", r.profile.applicationID)
+	}
+
+	p := &activeProfile{
+		applicationID: applicationID,
+		instance:      instance,
+		startedAt:     time.Now(),
+	}
+	err := goja.StartProfile(&p.buf)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	p.timer = time.AfterFunc(MaxProfileDuration, func() {
+		r.profileMu.Lock()
+		defer r.profileMu.Unlock()
+		if r.profile == p && p.stoppedAt.IsZero() {
+			goja.StopProfile()
+			p.stoppedAt = time.Now()
+			instance.appLogger.RuntimeLog(fmt.Sprintf("execution profiler stopped after reaching the maximum duration of %v", MaxProfileDuration))
+		}
+	})
+	// discards any previous profile that was never collected
+	r.profile = p
+
+	instance.appLogger.RuntimeLog("execution profiler started")
+	return nil
+}
+
+// StopProfilingApplication stops recording the execution profile of the specified application and returns it,
+// gzip-compressed in the pprof format, along with the duration of the profile
+func (r *AppRunner) StopProfilingApplication(applicationID string) ([]byte, time.Duration, error) {
+	p := func() *activeProfile {
+		r.profileMu.Lock()
+		defer r.profileMu.Unlock()
+
+		p := r.profile
+		if p == nil || p.applicationID != applicationID {
+			return nil
+		}
+		if p.stoppedAt.IsZero() {
+			p.timer.Stop()
+			goja.StopProfile()
+			p.stoppedAt = time.Now()
+			p.instance.appLogger.RuntimeLog("execution profiler stopped")
+		}
+		r.profile = nil
+		return p
+	}()
+	if p == nil {
+		return nil, 0, stacktrace.Propagate(ErrProfilerNotActive, "")
+	}
+
+	prof, err := profile.Parse(&p.buf)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+
+	filterProfileForInstance(prof, p.instance)
+
+	out := bytes.Buffer{}
+	err = prof.Write(&out)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	return out.Bytes(), p.stoppedAt.Sub(p.startedAt), nil
+}
+
+// filterProfileForInstance removes the samples that do not involve files loaded by the instance and replaces the
+// file names in the profile with the names of the application files.
+// Line numbers in the profile already refer to the original source files, as goja applies source maps when
+// resolving positions
+func filterProfileForInstance(prof *profile.Profile, instance *appInstance) {
+	fileNames := instance.loadedFileNames()
+
+	samples := prof.Sample[:0]
+	for _, sample := range prof.Sample {
+		if slices.ContainsFunc(sample.Location, func(location *profile.Location) bool {
+			return slices.ContainsFunc(location.Line, func(line profile.Line) bool {
+				_, ok := fileNames[line.Function.Filename]
+				return ok
+			})
+		}) {
+			samples = append(samples, sample)
+		}
+	}
+	prof.Sample = samples
+
+	for _, f := range prof.Function {
+		if name, ok := fileNames[f.Filename]; ok {
+			f.Filename = name
+		}
+	}
+
+	for _, mapping := range prof.Mapping {
+		mapping.File = "[application " + instance.applicationID + "]"
+	}
+	prof.Comments = append(prof.Comments, fmt.Sprintf("application %s version %s",
+		instance.applicationID, time.Time(instance.applicationVersion).Format(time.RFC3339Nano)))
+	*prof = *prof.Compact()
+}
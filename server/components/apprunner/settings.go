@@ -0,0 +1,176 @@
+package apprunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/apprunner/modules/settings"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ApplicationSettingValue is the current value of an application setting
+type ApplicationSettingValue struct {
+	Definition *settings.Definition
+	Value      interface{}
+	// IsDefault is true when no valid value was set by an administrator and the setting takes its default value
+	IsDefault bool
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// applicationSettingsSchema returns the settings schema in effect for the application: the schema of the running
+// version, if the application is running, or the schema of the latest version otherwise
+func (r *AppRunner) applicationSettingsSchema(node sqalx.Node, applicationID string) (*settings.Schema, error) {
+	applications, err := types.GetApplicationsWithIDs(node, []string{applicationID})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if _, ok := applications[applicationID]; !ok {
+		return nil, stacktrace.Propagate(ErrApplicationNotFound, "")
+	}
+
+	var version *types.ApplicationVersion
+	if running, runningVersion, _ := r.IsRunning(applicationID); running {
+		version = &runningVersion
+	}
+	schema, err := settings.LoadSchema(node, applicationID, version)
+	return schema, stacktrace.Propagate(err, "")
+}
+
+// ApplicationSettings returns the settings declared by the application, along with their current values
+func (r *AppRunner) ApplicationSettings(ctxCtx context.Context, applicationID string) ([]ApplicationSettingValue, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	schema, err := r.applicationSettingsSchema(ctx, applicationID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	setSettings, err := types.GetApplicationSettingsForApplication(ctx, applicationID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	result := make([]ApplicationSettingValue, len(schema.Definitions))
+	for i, d := range schema.Definitions {
+		result[i] = ApplicationSettingValue{
+			Definition: d,
+			Value:      d.Default,
+			IsDefault:  true,
+		}
+		if setting, ok := setSettings[d.Key]; ok {
+			if value, err := d.ParseAndValidate(setting.Value); err == nil {
+				result[i].Value = value
+				result[i].IsDefault = false
+				result[i].UpdatedAt = setting.UpdatedAt
+				result[i].UpdatedBy = setting.UpdatedBy
+			}
+		}
+	}
+	return result, nil
+}
+
+// SetApplicationSetting sets the value of an application setting, given its JSON representation, and notifies the
+// running instance of the application, if any
+func (r *AppRunner) SetApplicationSetting(ctxCtx context.Context, applicationID, key, encodedValue string, updatedBy auth.User) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	schema, err := r.applicationSettingsSchema(ctx, applicationID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	definition, ok := schema.Definition(key)
+	if !ok {
+		return stacktrace.Propagate(settings.ErrSettingNotFound, "")
+	}
+
+	value, err := definition.ParseAndValidate(encodedValue)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	normalized, err := sonic.MarshalString(value)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	setting := &types.ApplicationSetting{
+		ApplicationID: applicationID,
+		Key:           key,
+		Value:         normalized,
+		UpdatedAt:     time.Now(),
+		UpdatedBy:     updatedBy.Address(),
+	}
+	err = setting.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	r.notifySettingsChanged(applicationID, []string{key})
+	return nil
+}
+
+// ResetApplicationSetting discards the value set for an application setting, so that it takes its default value, and
+// notifies the running instance of the application, if any
+func (r *AppRunner) ResetApplicationSetting(ctxCtx context.Context, applicationID, key string) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	schema, err := r.applicationSettingsSchema(ctx, applicationID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	if _, ok := schema.Definition(key); !ok {
+		return stacktrace.Propagate(settings.ErrSettingNotFound, "")
+	}
+
+	setting := &types.ApplicationSetting{
+		ApplicationID: applicationID,
+		Key:           key,
+	}
+	err = setting.Delete(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	r.notifySettingsChanged(applicationID, []string{key})
+	return nil
+}
+
+func (r *AppRunner) notifySettingsChanged(applicationID string, keys []string) {
+	r.instancesLock.RLock()
+	defer r.instancesLock.RUnlock()
+
+	instance, ok := r.instances[applicationID]
+	if !ok {
+		return
+	}
+	instance.settingsChanged.Notify(keys, false)
+}
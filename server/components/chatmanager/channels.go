@@ -0,0 +1,261 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+// ErrChatChannelNotFound is returned when the specified chat channel does not exist
+var ErrChatChannelNotFound = errors.New("chat channel not found")
+
+// ErrChatChannelAccessDenied is returned when an user attempts to use a chat channel they are not allowed to access
+var ErrChatChannelAccessDenied = errors.New("chat channel access denied")
+
+// ErrChatChannelDisabled is returned when attempting to send a message to a disabled chat channel
+var ErrChatChannelDisabled = errors.New("chat channel disabled")
+
+// ErrInvalidChatChannel is returned when a chat channel has an invalid ID, title or permission level
+var ErrInvalidChatChannel = errors.New("invalid chat channel")
+
+// ErrCannotRemoveMainChannel is returned when attempting to remove the main chat channel
+var ErrCannotRemoveMainChannel = errors.New("the main chat channel can't be removed")
+
+// ErrChatChannelHasMessages is returned when attempting to remove a chat channel that contains messages.
+// Such channels can only be disabled, so that their messages remain available for moderation
+var ErrChatChannelHasMessages = errors.New("chat channel has messages")
+
+var chatChannelIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,35}$`)
+
+type chatChannels struct {
+	mu       sync.RWMutex
+	loaded   bool
+	channels map[string]*types.ChatChannel
+}
+
+// ReloadChannels reloads the chat channels from the database
+func (c *Manager) ReloadChannels(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	channels, err := types.GetChatChannels(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	m := make(map[string]*types.ChatChannel, len(channels))
+	for _, channel := range channels {
+		m[channel.ID] = channel
+	}
+
+	c.channels.mu.Lock()
+	defer c.channels.mu.Unlock()
+	c.channels.channels = m
+	c.channels.loaded = true
+	return nil
+}
+
+func (c *Manager) ensureChannelsLoaded(ctx context.Context) error {
+	c.channels.mu.RLock()
+	loaded := c.channels.loaded
+	c.channels.mu.RUnlock()
+	if !loaded {
+		return stacktrace.Propagate(c.ReloadChannels(ctx), "")
+	}
+	return nil
+}
+
+// Channels returns all chat channels, including those the given user can't access
+func (c *Manager) Channels(ctx context.Context) ([]*types.ChatChannel, error) {
+	err := c.ensureChannelsLoaded(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.channels.mu.RLock()
+	defer c.channels.mu.RUnlock()
+	channels := make([]*types.ChatChannel, 0, len(c.channels.channels))
+	for _, channel := range c.channels.channels {
+		channels = append(channels, channel)
+	}
+	slices.SortFunc(channels, func(a, b *types.ChatChannel) int {
+		// the main channel always comes first
+		if a.ID == chat.MainChannelID {
+			return -1
+		} else if b.ID == chat.MainChannelID {
+			return 1
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return channels, nil
+}
+
+// ChannelsAccessibleBy returns the chat channels the given user can access. user may be nil
+func (c *Manager) ChannelsAccessibleBy(ctx context.Context, user auth.User) ([]*types.ChatChannel, error) {
+	channels, err := c.Channels(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	result := []*types.ChatChannel{}
+	for _, channel := range channels {
+		if canAccessChannel(user, channel) {
+			result = append(result, channel)
+		}
+	}
+	return result, nil
+}
+
+// Channel returns the chat channel with the given ID, if the given user can access it.
+// The empty string identifies the main channel. user may be nil
+func (c *Manager) Channel(ctx context.Context, user auth.User, id string) (*types.ChatChannel, error) {
+	if id == "" {
+		id = chat.MainChannelID
+	}
+	err := c.ensureChannelsLoaded(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	c.channels.mu.RLock()
+	channel, ok := c.channels.channels[id]
+	c.channels.mu.RUnlock()
+	if !ok {
+		return nil, stacktrace.Propagate(ErrChatChannelNotFound, "")
+	}
+	if !canAccessChannel(user, channel) {
+		return nil, stacktrace.Propagate(ErrChatChannelAccessDenied, "")
+	}
+	return channel, nil
+}
+
+// ChannelEnabled returns whether messages can currently be sent in the given channel, taking into account whether
+// chat as a whole is enabled
+func (c *Manager) ChannelEnabled(channel *types.ChatChannel) bool {
+	return c.enabled && channel.Enabled
+}
+
+// canAccessChannel returns whether the given user can read and write in the given channel.
+// The application that owns a channel can always access it
+func canAccessChannel(user auth.User, channel *types.ChatChannel) bool {
+	if channel.ApplicationID != nil && user != nil && user != auth.User(nil) && user.ApplicationID() == *channel.ApplicationID {
+		return true
+	}
+	return auth.UserPermissionLevelIsAtLeast(user, auth.PermissionLevel(channel.RequiredPermissionLevel))
+}
+
+// canViewMessagesInChannel returns whether the given user can see the messages in the channel with the given ID.
+// Messages in channels that no longer exist are not visible
+func (c *Manager) canViewMessagesInChannel(ctx context.Context, user auth.User, channelID string) (bool, error) {
+	_, err := c.Channel(ctx, user, channelID)
+	if err != nil {
+		if errors.Is(err, ErrChatChannelNotFound) || errors.Is(err, ErrChatChannelAccessDenied) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "")
+	}
+	return true, nil
+}
+
+// SaveChannel validates and creates or updates a chat channel
+func (c *Manager) SaveChannel(ctxCtx context.Context, channel *types.ChatChannel, updatedBy auth.User) error {
+	if !chatChannelIDRegexp.MatchString(channel.ID) {
+		return stacktrace.Propagate(fmt.Errorf("%w: the ID must contain only lowercase letters, digits and dashes", ErrInvalidChatChannel), "")
+	}
+	channel.Title = strings.TrimSpace(channel.Title)
+	if channel.Title == "" || len(channel.Title) > 64 {
+		return stacktrace.Propagate(fmt.Errorf("%w: the title must be between 1 and 64 characters long", ErrInvalidChatChannel), "")
+	}
+	if _, ok := auth.PermissionLevelOrder[auth.PermissionLevel(channel.RequiredPermissionLevel)]; !ok {
+		return stacktrace.Propagate(fmt.Errorf("%w: unknown permission level", ErrInvalidChatChannel), "")
+	}
+	if channel.ApplicationID != nil && *channel.ApplicationID == "" {
+		channel.ApplicationID = nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	channel.UpdatedAt = time.Now()
+	channel.UpdatedBy = updatedBy.Address()
+
+	err = channel.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = c.ReloadChannels(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	c.channelUpdated.Notify(channel.ID, channel, false)
+	return nil
+}
+
+// RemoveChannel deletes a chat channel, along with all of its messages, returning the deleted channel
+func (c *Manager) RemoveChannel(ctxCtx context.Context, id string) (*types.ChatChannel, error) {
+	if id == chat.MainChannelID {
+		return nil, stacktrace.Propagate(ErrCannotRemoveMainChannel, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	channels, err := types.GetChatChannelsWithIDs(ctx, []string{id})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	channel, ok := channels[id]
+	if !ok {
+		return nil, stacktrace.Propagate(ErrChatChannelNotFound, "")
+	}
+
+	hasMessages, err := types.ChatChannelHasMessages(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if hasMessages {
+		return nil, stacktrace.Propagate(ErrChatChannelHasMessages, "")
+	}
+
+	err = channel.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.ReloadChannels(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.channelUpdated.Notify(channel.ID, nil, false)
+	return channel, nil
+}
@@ -0,0 +1,495 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ErrMessageRejectedByContentFilter is returned when a message is rejected by the chat content filter
+var ErrMessageRejectedByContentFilter = errors.New("message rejected by content filter")
+
+// ErrInvalidContentFilterRule is returned when a chat filter rule has an invalid type, action or parameter
+var ErrInvalidContentFilterRule = errors.New("invalid content filter rule")
+
+// ErrContentFilterRuleNotFound is returned when the specified chat filter rule does not exist
+var ErrContentFilterRuleNotFound = errors.New("content filter rule not found")
+
+// ContentFilterMatcher checks chat message contents against a single filter rule
+type ContentFilterMatcher interface {
+	// Match returns whether the content matches the rule and, if so, the part of the content that matched it
+	Match(content string) (bool, string)
+}
+
+// ContentFilterMatcherBuilder builds a ContentFilterMatcher from the parameter of a chat filter rule.
+// It should return an error if the parameter is invalid for the rule type
+type ContentFilterMatcherBuilder func(parameter string) (ContentFilterMatcher, error)
+
+var contentFilterMatcherBuilders = map[types.ChatFilterRuleType]ContentFilterMatcherBuilder{
+	types.ChatFilterRuleTypeRegex:          newRegexMatcher,
+	types.ChatFilterRuleTypeWordList:       newWordListMatcher,
+	types.ChatFilterRuleTypeLinkDomain:     newLinkDomainMatcher,
+	types.ChatFilterRuleTypeMentionSpam:    newMentionSpamMatcher,
+	types.ChatFilterRuleTypeCharacterFlood: newCharacterFloodMatcher,
+	types.ChatFilterRuleTypeScamAddress:    newScamAddressMatcher,
+}
+
+// RegisterContentFilterRuleType makes a new rule type available to the chat content filter.
+// It must be called before the chat manager is used
+func RegisterContentFilterRuleType(ruleType types.ChatFilterRuleType, builder ContentFilterMatcherBuilder) {
+	contentFilterMatcherBuilders[ruleType] = builder
+}
+
+// contentFilterActionSeverity orders the filter actions, so that the most severe action among the matched rules is
+// the one applied to a message
+var contentFilterActionSeverity = map[types.ChatFilterAction]int{
+	types.ChatFilterActionFlag:   1,
+	types.ChatFilterActionHide:   2,
+	types.ChatFilterActionReject: 3,
+	types.ChatFilterActionMute:   4,
+}
+
+type compiledContentFilterRule struct {
+	rule    *types.ChatFilterRule
+	matcher ContentFilterMatcher
+}
+
+// ContentFilterHit describes a chat filter rule matched by a message
+type ContentFilterHit struct {
+	Rule    *types.ChatFilterRule
+	Excerpt string
+}
+
+type contentFilter struct {
+	mu     sync.RWMutex
+	loaded bool
+	rules  []compiledContentFilterRule
+}
+
+func compileContentFilterRule(rule *types.ChatFilterRule) (compiledContentFilterRule, error) {
+	if _, ok := contentFilterActionSeverity[rule.Action]; !ok {
+		return compiledContentFilterRule{}, fmt.Errorf("%w: unknown action", ErrInvalidContentFilterRule)
+	}
+	builder, ok := contentFilterMatcherBuilders[rule.RuleType]
+	if !ok {
+		return compiledContentFilterRule{}, fmt.Errorf("%w: unknown rule type", ErrInvalidContentFilterRule)
+	}
+	matcher, err := builder(rule.Parameter)
+	if err != nil {
+		return compiledContentFilterRule{}, fmt.Errorf("%w: %s", ErrInvalidContentFilterRule, stacktrace.RootCause(err).Error())
+	}
+	return compiledContentFilterRule{rule, matcher}, nil
+}
+
+// ReloadContentFilterRules reloads the enabled chat filter rules from the database
+func (c *Manager) ReloadContentFilterRules(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rules, err := types.GetEnabledChatFilterRules(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	compiled := make([]compiledContentFilterRule, 0, len(rules))
+	for _, rule := range rules {
+		r, err := compileContentFilterRule(rule)
+		if err != nil {
+			// rules are validated before being saved, but the set of available rule types may have changed since
+			c.log.Printf("skipping chat filter rule %s: %v", rule.ID, err)
+			continue
+		}
+		compiled = append(compiled, r)
+	}
+
+	c.contentFilter.mu.Lock()
+	defer c.contentFilter.mu.Unlock()
+	c.contentFilter.rules = compiled
+	c.contentFilter.loaded = true
+	return nil
+}
+
+// SaveContentFilterRule validates and creates or updates a chat filter rule.
+// If the rule has an empty ID, a new rule is created
+func (c *Manager) SaveContentFilterRule(ctxCtx context.Context, rule *types.ChatFilterRule, updatedBy auth.User) error {
+	_, err := compileContentFilterRule(rule)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	if rule.ID == "" {
+		rule.ID = uuid.NewV4().String()
+	} else {
+		existing, err := types.GetChatFilterRulesWithIDs(ctx, []string{rule.ID})
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if _, ok := existing[rule.ID]; !ok {
+			return stacktrace.Propagate(ErrContentFilterRuleNotFound, "")
+		}
+	}
+	rule.UpdatedAt = time.Now()
+	rule.UpdatedBy = updatedBy.Address()
+
+	err = rule.Update(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(c.ReloadContentFilterRules(ctxCtx), "")
+}
+
+// RemoveContentFilterRule deletes a chat filter rule, returning the deleted rule
+func (c *Manager) RemoveContentFilterRule(ctxCtx context.Context, id string) (*types.ChatFilterRule, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	rules, err := types.GetChatFilterRulesWithIDs(ctx, []string{id})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	rule, ok := rules[id]
+	if !ok {
+		return nil, stacktrace.Propagate(ErrContentFilterRuleNotFound, "")
+	}
+
+	err = rule.Delete(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return rule, stacktrace.Propagate(c.ReloadContentFilterRules(ctxCtx), "")
+}
+
+// filterContent runs the content of a message through the enabled chat filter rules, returning the rules it matched
+// and the action to apply to the message (the empty string if no rules matched)
+func (c *Manager) filterContent(ctx context.Context, content string) ([]ContentFilterHit, types.ChatFilterAction, error) {
+	c.contentFilter.mu.RLock()
+	loaded := c.contentFilter.loaded
+	c.contentFilter.mu.RUnlock()
+	if !loaded {
+		err := c.ReloadContentFilterRules(ctx)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "")
+		}
+	}
+
+	c.contentFilter.mu.RLock()
+	defer c.contentFilter.mu.RUnlock()
+
+	hits := []ContentFilterHit{}
+	var action types.ChatFilterAction
+	for _, r := range c.contentFilter.rules {
+		matched, excerpt := r.matcher.Match(content)
+		if !matched {
+			continue
+		}
+		hits = append(hits, ContentFilterHit{
+			Rule:    r.rule,
+			Excerpt: excerpt,
+		})
+		if contentFilterActionSeverity[r.rule.Action] > contentFilterActionSeverity[action] {
+			action = r.rule.Action
+		}
+	}
+	return hits, action, nil
+}
+
+func (c *Manager) reportContentFilterHits(author auth.User, message *chat.Message, hits []ContentFilterHit, action types.ChatFilterAction) {
+	go c.statsClient.Count("chat_content_filter_hit", len(hits))
+
+	messageDescription, webhookMessageDescription := "Rejected chat message", "Rejected chat message"
+	if message != nil {
+		messageDescription = fmt.Sprintf("Chat message %d", message.ID.Int64())
+		webhookMessageDescription = fmt.Sprintf("Chat message `%d`", message.ID.Int64())
+	}
+
+	descriptions := make([]string, len(hits))
+	excerpts := make([]string, len(hits))
+	for i, hit := range hits {
+		excerpt := []rune(hit.Excerpt)
+		if len(excerpt) > 100 {
+			excerpt = append(excerpt[:100], '…')
+		}
+		excerpts[i] = string(excerpt)
+		descriptions[i] = hit.Rule.Description
+		if descriptions[i] == "" {
+			descriptions[i] = string(hit.Rule.RuleType)
+		}
+
+		c.log.Printf("%s by %s matched content filter rule %s (%s, action: %s, applied action: %s). Matched content: %q",
+			messageDescription, author.Address(), hit.Rule.ID, descriptions[i], hit.Rule.Action, action, excerpts[i])
+	}
+
+	if c.modLogWebhook == nil {
+		return
+	}
+
+	go func() {
+		for i, hit := range hits {
+			_, err := c.modLogWebhook.SendContent(
+				fmt.Sprintf("%s by %s matched content filter rule `%s` (%s, action: %s, applied action: %s). Matched content: `%s`",
+					webhookMessageDescription,
+					author.Address()[:14],
+					hit.Rule.ID,
+					descriptions[i],
+					hit.Rule.Action,
+					action,
+					strings.ReplaceAll(excerpts[i], "`", "'")))
+			if err != nil {
+				c.log.Println("Failed to send mod log webhook:", err)
+			}
+		}
+	}()
+}
+
+type regexMatcher struct {
+	re *regexp.Regexp
+}
+
+func newRegexMatcher(parameter string) (ContentFilterMatcher, error) {
+	re, err := regexp.Compile(parameter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &regexMatcher{re}, nil
+}
+
+func (m *regexMatcher) Match(content string) (bool, string) {
+	loc := m.re.FindStringIndex(content)
+	if loc == nil {
+		return false, ""
+	}
+	return true, content[loc[0]:loc[1]]
+}
+
+// splitRuleParameterList splits a rule parameter containing one entry per line, ignoring empty lines
+func splitRuleParameterList(parameter string) []string {
+	entries := []string{}
+	for _, line := range strings.Split(parameter, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			entries = append(entries, line)
+		}
+	}
+	return entries
+}
+
+// newWordListMatcher matches whole words, case-insensitively. The parameter contains one word or phrase per line
+func newWordListMatcher(parameter string) (ContentFilterMatcher, error) {
+	words := splitRuleParameterList(parameter)
+	if len(words) == 0 {
+		return nil, stacktrace.NewError("word list is empty")
+	}
+	for i := range words {
+		words[i] = regexp.QuoteMeta(words[i])
+	}
+	re, err := regexp.Compile(`(?i)(?:^|[^\pL\pN])(` + strings.Join(words, "|") + `)(?:$|[^\pL\pN])`)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &wordListMatcher{re}, nil
+}
+
+type wordListMatcher struct {
+	re *regexp.Regexp
+}
+
+func (m *wordListMatcher) Match(content string) (bool, string) {
+	match := m.re.FindStringSubmatch(content)
+	if match == nil {
+		return false, ""
+	}
+	return true, match[1]
+}
+
+var linkDomainRegexp = regexp.MustCompile(`(?i)([a-z][a-z0-9+.-]*://)?((?:[\pL\pN](?:[\pL\pN-]*[\pL\pN])?\.)+(\pL{2,}))`)
+
+// linkDomainCommonTLDs are the top-level domains for which hostnames are considered to be links even when not
+// preceded by a URL scheme, to avoid treating sentences with a missing space after a full stop as links
+var linkDomainCommonTLDs = map[string]struct{}{
+	"app": {}, "click": {}, "co": {}, "com": {}, "dev": {}, "gg": {}, "info": {}, "io": {}, "link": {}, "live": {},
+	"ly": {}, "me": {}, "net": {}, "online": {}, "org": {}, "ru": {}, "shop": {}, "site": {}, "top": {}, "tv": {},
+	"xyz": {},
+}
+
+// newLinkDomainMatcher matches links to the listed domains and their subdomains.
+// The parameter contains one domain per line. The entry `*` matches all domains, and entries prefixed with `!` are
+// exceptions, so that e.g. `*` followed by `!example.com` matches links to any domain other than example.com
+func newLinkDomainMatcher(parameter string) (ContentFilterMatcher, error) {
+	m := &linkDomainMatcher{}
+	for _, entry := range splitRuleParameterList(parameter) {
+		entry = strings.ToLower(entry)
+		if entry == "*" {
+			m.matchAll = true
+		} else if strings.HasPrefix(entry, "!") {
+			m.exceptions = append(m.exceptions, strings.TrimPrefix(entry, "!"))
+		} else {
+			m.domains = append(m.domains, entry)
+		}
+	}
+	if !m.matchAll && len(m.domains) == 0 {
+		return nil, stacktrace.NewError("domain list is empty")
+	}
+	return m, nil
+}
+
+type linkDomainMatcher struct {
+	matchAll   bool
+	domains    []string
+	exceptions []string
+}
+
+func domainMatchesList(domain string, list []string) bool {
+	for _, d := range list {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *linkDomainMatcher) Match(content string) (bool, string) {
+	for _, match := range linkDomainRegexp.FindAllStringSubmatch(content, -1) {
+		domain := strings.ToLower(match[2])
+		if _, commonTLD := linkDomainCommonTLDs[strings.ToLower(match[3])]; match[1] == "" && !commonTLD && !strings.HasPrefix(domain, "www.") {
+			continue
+		}
+		if domainMatchesList(domain, m.exceptions) {
+			continue
+		}
+		if m.matchAll || domainMatchesList(domain, m.domains) {
+			return true, match[0]
+		}
+	}
+	return false, ""
+}
+
+var mentionRegexp = regexp.MustCompile(`@[\pL\pN_.-]+`)
+
+// newMentionSpamMatcher matches messages that mention more distinct users than the maximum in the parameter
+func newMentionSpamMatcher(parameter string) (ContentFilterMatcher, error) {
+	max, err := strconv.Atoi(strings.TrimSpace(parameter))
+	if err != nil || max < 0 {
+		return nil, stacktrace.NewError("parameter must be a non-negative integer")
+	}
+	return &mentionSpamMatcher{max}, nil
+}
+
+type mentionSpamMatcher struct {
+	maxMentions int
+}
+
+func (m *mentionSpamMatcher) Match(content string) (bool, string) {
+	mentions := make(map[string]struct{})
+	for _, mention := range mentionRegexp.FindAllString(content, -1) {
+		mentions[strings.ToLower(mention)] = struct{}{}
+	}
+	if len(mentions) <= m.maxMentions {
+		return false, ""
+	}
+	return true, fmt.Sprintf("%d mentions", len(mentions))
+}
+
+// newCharacterFloodMatcher matches messages where a character that is not whitespace repeats consecutively more
+// times than the maximum in the parameter
+func newCharacterFloodMatcher(parameter string) (ContentFilterMatcher, error) {
+	max, err := strconv.Atoi(strings.TrimSpace(parameter))
+	if err != nil || max < 1 {
+		return nil, stacktrace.NewError("parameter must be a positive integer")
+	}
+	return &characterFloodMatcher{max}, nil
+}
+
+type characterFloodMatcher struct {
+	maxRepetitions int
+}
+
+func (m *characterFloodMatcher) Match(content string) (bool, string) {
+	var previous rune
+	count := 0
+	for _, r := range content {
+		if r == previous && !unicode.IsSpace(r) {
+			count++
+		} else {
+			previous = r
+			count = 1
+		}
+		if count > m.maxRepetitions {
+			return true, strings.Repeat(string(r), count)
+		}
+	}
+	return false, ""
+}
+
+var cryptocurrencyAddressRegexp = regexp.MustCompile(`(?i)\b(?:ban|nano|xrb)_[13][13456789abcdefghijkmnopqrstuwxyz]{59}\b`)
+
+// newScamAddressMatcher matches messages containing Banano or Nano addresses that match any of the patterns in the
+// parameter, one per line. In patterns, `*` matches any sequence of characters, so that e.g. `ban_1abc*xyz` matches
+// vanity addresses crafted to resemble a legitimate address
+func newScamAddressMatcher(parameter string) (ContentFilterMatcher, error) {
+	patterns := splitRuleParameterList(parameter)
+	if len(patterns) == 0 {
+		return nil, stacktrace.NewError("address pattern list is empty")
+	}
+	for i := range patterns {
+		parts := strings.Split(strings.ToLower(patterns[i]), "*")
+		for j := range parts {
+			parts[j] = regexp.QuoteMeta(parts[j])
+		}
+		patterns[i] = strings.Join(parts, ".*")
+	}
+	re, err := regexp.Compile(`^(?:` + strings.Join(patterns, "|") + `)$`)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &scamAddressMatcher{re}, nil
+}
+
+type scamAddressMatcher struct {
+	re *regexp.Regexp
+}
+
+func (m *scamAddressMatcher) Match(content string) (bool, string) {
+	for _, address := range cryptocurrencyAddressRegexp.FindAllString(content, -1) {
+		if m.re.MatchString(strings.ToLower(address)) {
+			return true, address
+		}
+	}
+	return false, ""
+}
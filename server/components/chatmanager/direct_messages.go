@@ -0,0 +1,307 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+// ErrDirectMessagesNotAllowed is returned when a direct message can't be sent because one of the participants has not
+// enabled direct messages, or because one of them has blocked the other
+var ErrDirectMessagesNotAllowed = errors.New("direct messages not allowed between these users")
+
+// ErrDirectMessageReportNotFound is returned when the specified direct message report does not exist
+var ErrDirectMessageReportNotFound = errors.New("direct message report not found")
+
+// MaxDirectMessageConversations is the maximum number of conversations included in a direct message inbox
+const MaxDirectMessageConversations = 100
+
+// maxReportedDirectMessages is the number of messages, preceding a report, that moderators can review
+const maxReportedDirectMessages = 200
+
+// DirectMessageEventArgs contains the values for the DirectMessage event
+type DirectMessageEventArgs struct {
+	Message *chat.Message
+	// OtherParty is the participant of the conversation other than the user the event is keyed by
+	OtherParty             auth.User
+	ProtobufRepresentation *proto.ChatMessage
+}
+
+// DirectMessagesEnabled returns whether the user accepts direct messages
+func (c *Manager) DirectMessagesEnabled(ctx context.Context, user auth.User) (bool, error) {
+	enabled, err := c.store.DirectMessagesEnabled(ctx, user.Address())
+	return enabled, stacktrace.Propagate(err, "")
+}
+
+// SetDirectMessagesEnabled sets whether the user accepts direct messages
+func (c *Manager) SetDirectMessagesEnabled(ctx context.Context, user auth.User, enabled bool) error {
+	err := c.store.SetDirectMessagesEnabled(ctx, user, enabled)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	c.directMessagesEnabledChanged.Notify(user.Address(), enabled, false)
+	return nil
+}
+
+func (c *Manager) directMessagesAllowed(ctx context.Context, a, b auth.User) (bool, error) {
+	if a.Address() == b.Address() {
+		return false, nil
+	}
+	for _, pair := range [][2]auth.User{{a, b}, {b, a}} {
+		enabled, err := c.store.DirectMessagesEnabled(ctx, pair[0].Address())
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if !enabled {
+			return false, nil
+		}
+		blocked, err := c.blockedUserStore.LoadUsersBlockedBy(ctx, pair[0])
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+		if slices.Contains(blocked, pair[1].Address()) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// SendDirectMessage sends a direct message from author to recipient. Both must have enabled direct messages and
+// neither can have blocked the other
+func (c *Manager) SendDirectMessage(ctxCtx context.Context, author, recipient auth.User, content string) (*chat.Message, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	allowed, err := c.directMessagesAllowed(ctx, author, recipient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !allowed {
+		return nil, stacktrace.Propagate(ErrDirectMessagesNotAllowed, "")
+	}
+
+	banned, err := c.moderationStore.LoadUserBannedFromChat(ctx, author.Address(), authinterceptor.RemoteAddressFromContext(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.checkNotMuted(ctx, author)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if author.ApplicationID() == "" {
+		var ok bool
+		if banned && !auth.UserPermissionLevelIsAtLeast(author, auth.AdminPermissionLevel) {
+			_, _, _, ok, err = c.slowmodeRateLimiter.Take(ctx, author.Address())
+		} else {
+			_, _, _, ok, err = c.rateLimiter.Take(ctx, author.Address())
+		}
+
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if !ok {
+			return nil, stacktrace.NewError("rate limit reached")
+		}
+	}
+
+	content = strings.TrimSpace(content)
+	// replace groups of more than 2 newlines with 2 newlines
+	content = newlineReducingRegexp.ReplaceAllString(content, "\n\n")
+	if content == "" {
+		return nil, stacktrace.NewError("message empty")
+	}
+
+	var filterAction types.ChatFilterAction
+	if author.ApplicationID() == "" && !auth.UserPermissionLevelIsAtLeast(author, auth.AdminPermissionLevel) {
+		// hits are deliberately not reported to moderators, as direct messages are private unless reported.
+		// For the same reason, rules with the mute action only reject direct messages
+		_, filterAction, err = c.filterContent(ctx, content)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if filterAction == types.ChatFilterActionReject || filterAction == types.ChatFilterActionMute {
+			return nil, stacktrace.Propagate(ErrMessageRejectedByContentFilter, "")
+		}
+	}
+
+	content, err = c.processEmotesForStorage(ctx, author, content)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	m := &chat.Message{
+		ID:           c.idNode.Generate(),
+		CreatedAt:    time.Now(),
+		Author:       author,
+		Content:      content,
+		Shadowbanned: banned || filterAction == types.ChatFilterActionHide,
+	}
+	nickname, err := c.store.StoreDirectMessage(ctx, m, recipient.Address())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to store direct message")
+	}
+	m.Author.SetNickname(nickname)
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.processEmotesForLoadingMessage(ctxCtx, m)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoMessage := m.SerializeForAPI(ctxCtx, c.userSerializer)
+	// let the author's other sessions know about the message, too
+	c.directMessage.Notify(author.Address(), DirectMessageEventArgs{m, recipient, protoMessage}, false)
+	if !m.Shadowbanned {
+		c.directMessage.Notify(recipient.Address(), DirectMessageEventArgs{m, author, protoMessage}, false)
+	}
+	go c.statsClient.Count("direct_message_created", 1)
+	return m, nil
+}
+
+// LoadDirectMessages loads up to num messages of the conversation between user and otherParty, sent before the
+// specified instant
+func (c *Manager) LoadDirectMessages(ctx context.Context, user, otherParty auth.User, before time.Time, num int) ([]*chat.Message, error) {
+	messages, err := c.store.LoadDirectMessages(ctx, user.Address(), otherParty.Address(), user, before, num)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	err = c.processEmotesForLoadingMessages(ctx, messages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return messages, nil
+}
+
+// LoadDirectMessageConversations loads the direct message conversations of the user, most recently active first
+func (c *Manager) LoadDirectMessageConversations(ctx context.Context, user auth.User) ([]chat.DirectMessageConversation, error) {
+	conversations, err := c.store.LoadDirectMessageConversations(ctx, user, MaxDirectMessageConversations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for _, conversation := range conversations {
+		err = c.processEmotesForLoadingMessage(ctx, conversation.LatestMessage)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+	return conversations, nil
+}
+
+// ReportDirectMessageConversation lets a participant of a direct message conversation report it, making it reviewable
+// by moderators
+func (c *Manager) ReportDirectMessageConversation(ctxCtx context.Context, reporter, reported auth.User, reason string) (*types.DirectMessageReport, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	// only participants of existing conversations can report them
+	messages, err := c.store.LoadDirectMessages(ctx, reporter.Address(), reported.Address(), reporter, time.Now(), 1)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(messages) == 0 {
+		return nil, stacktrace.Propagate(chat.ErrChatMessageNotFound, "")
+	}
+
+	report := &types.DirectMessageReport{
+		ID:         uuid.NewV4().String(),
+		ReportedAt: time.Now(),
+		Reporter:   reporter.Address(),
+		Reported:   reported.Address(),
+		Reason:     reason,
+	}
+	err = report.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if c.modLogWebhook != nil {
+		go func() {
+			_, err := c.modLogWebhook.SendContent(
+				fmt.Sprintf("Direct message conversation with %s reported by %s (report `%s`). Reason: %s",
+					reported.Address()[:14],
+					reporter.Address()[:14],
+					report.ID,
+					reason))
+			if err != nil {
+				c.log.Println("Failed to send mod log webhook:", err)
+			}
+		}()
+	}
+	return report, nil
+}
+
+// LoadReportedDirectMessageConversation loads the report with the given ID along with the messages of the reported
+// conversation that were sent up to the moment of the report, including shadowbanned ones.
+// The report is marked as reviewed by the moderator
+func (c *Manager) LoadReportedDirectMessageConversation(ctxCtx context.Context, reportID string, moderator auth.User) (*types.DirectMessageReport, []*chat.Message, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	reports, err := types.GetDirectMessageReportsWithIDs(ctx, []string{reportID})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	report, ok := reports[reportID]
+	if !ok {
+		return nil, nil, stacktrace.Propagate(ErrDirectMessageReportNotFound, "")
+	}
+
+	messages, err := c.store.LoadDirectMessages(ctx, report.Reporter, report.Reported, nil, report.ReportedAt, maxReportedDirectMessages)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	if !report.ReviewedAt.Valid {
+		report.ReviewedAt.Time = time.Now()
+		report.ReviewedAt.Valid = true
+		moderatorAddress := moderator.Address()
+		report.ReviewedBy = &moderatorAddress
+		err = report.Update(ctx)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.processEmotesForLoadingMessages(ctxCtx, messages)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	return report, messages, nil
+}
@@ -93,7 +93,18 @@ func (c *Manager) processEmotesForLoadingMessage(ctx context.Context, message *c
 	// this lets the clients show the correct alt/title text for them
 	// we don't store the shortcodes along with the message to save on DB space and to ensure
 	// the shortcodes are always up-to-date when rendering
-	message.Content = utils.ReplaceAllStringSubmatchFuncExcludingInside(emoteRegexp, codeTagRegexp, message.Content, func(s []string) string {
+	message.Content = c.processEmotesForLoadingContent(ctx, message.Content)
+	for i := range message.Revisions {
+		message.Revisions[i].Content = c.processEmotesForLoadingContent(ctx, message.Revisions[i].Content)
+	}
+	if message.Reference != nil {
+		c.processEmotesForLoadingMessage(ctx, message.Reference)
+	}
+	return nil
+}
+
+func (c *Manager) processEmotesForLoadingContent(ctx context.Context, content string) string {
+	return utils.ReplaceAllStringSubmatchFuncExcludingInside(emoteRegexp, codeTagRegexp, content, func(s []string) string {
 		if s[1] == `\` {
 			// escaped emote, do not do anything, return the original as-is
 			return s[0]
@@ -113,8 +124,4 @@ func (c *Manager) processEmotesForLoadingMessage(ctx context.Context, message *c
 		return fmt.Sprintf("<%s:%s:%d>", s[2], emote.Shortcode, emote.ID)
 
 	})
-	if message.Reference != nil {
-		c.processEmotesForLoadingMessage(ctx, message.Reference)
-	}
-	return nil
 }
@@ -3,7 +3,9 @@ package chatmanager
 import (
 	"github.com/bwmarrin/snowflake"
 	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 )
 
@@ -33,6 +35,40 @@ func (c *Manager) OnMessageDeleted() event.Event[snowflake.ID] {
 	return c.messageDeleted
 }
 
+// OnMessageEdited returns an event that is fired when the content of a chat message is edited
+func (c *Manager) OnMessageEdited() event.Event[*chat.Message] {
+	return c.messageEdited
+}
+
+// OnPollUpdated returns an event that is fired when votes are cast in a chat poll, or when a poll closes
+func (c *Manager) OnPollUpdated() event.Event[PollUpdatedEventArgs] {
+	return c.pollUpdated
+}
+
+// OnMessageHidden returns an event that is fired when an existing chat message becomes visible only to its author
+func (c *Manager) OnMessageHidden() event.Event[*chat.Message] {
+	return c.messageHidden
+}
+
+// MessageReactionUpdatedEventArgs contains the values for the MessageReactionUpdated event
+type MessageReactionUpdatedEventArgs struct {
+	MessageID snowflake.ID
+	// Channel is the ID of the channel the message belongs to
+	Channel string
+	// Shadowbanned is true when the message is only visible to its author
+	Shadowbanned bool
+	Reactor      auth.User
+	Reaction     string
+	Added        bool
+	// Count is the number of users who reacted with Reaction after the update
+	Count int
+}
+
+// OnMessageReactionUpdated returns an event that is fired when a reaction is added to or removed from a chat message
+func (c *Manager) OnMessageReactionUpdated() event.Event[MessageReactionUpdatedEventArgs] {
+	return c.messageReactionUpdated
+}
+
 // OnUserBlockedBy returns a keyed event whose key is the reward address of an user and is fired when that user blocks another user
 // The blocked user will be sent as the event argument
 func (c *Manager) OnUserBlockedBy() event.Keyed[string, string] {
@@ -50,3 +86,38 @@ func (c *Manager) OnUserUnblockedBy() event.Keyed[string, string] {
 func (c *Manager) OnUserChangedNickname() event.Keyed[string, string] {
 	return c.userChangedNickname
 }
+
+// OnUnreadRepliesUpdated returns a keyed event whose key is the reward address of an user and is fired when someone
+// replies to that user, or when the user marks their replies as read
+func (c *Manager) OnUnreadRepliesUpdated() event.Keyed[string, UnreadRepliesUpdatedEventArgs] {
+	return c.unreadRepliesUpdated
+}
+
+// OnDirectMessage returns a keyed event whose key is the reward address of an user and is fired when a direct message
+// is sent by or to that user
+func (c *Manager) OnDirectMessage() event.Keyed[string, DirectMessageEventArgs] {
+	return c.directMessage
+}
+
+// OnDirectMessagesEnabledChanged returns a keyed event whose key is the reward address of an user and is fired when
+// that user enables or disables direct messages
+func (c *Manager) OnDirectMessagesEnabledChanged() event.Keyed[string, bool] {
+	return c.directMessagesEnabledChanged
+}
+
+// OnChannelUpdated returns a keyed event whose key is the ID of a chat channel and is fired when the settings of that
+// channel are changed. The updated channel will be sent as the event argument, or nil if the channel was removed
+func (c *Manager) OnChannelUpdated() event.Keyed[string, *types.ChatChannel] {
+	return c.channelUpdated
+}
+
+// OnUserMuteUpdated returns a keyed event whose key is the reward address of an user and is fired when that user is
+// muted or unmuted. The mute in effect will be sent as the event argument, or nil if the user is no longer muted
+func (c *Manager) OnUserMuteUpdated() event.Keyed[string, *types.ChatMute] {
+	return c.userMuteUpdated
+}
+
+// OnReportQueueUpdated returns an event that is fired when chat messages are reported or when reports are resolved
+func (c *Manager) OnReportQueueUpdated() event.NoArgEvent {
+	return c.reportQueueUpdated
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/DisgoOrg/disgohook/api"
 	"github.com/bwmarrin/snowflake"
 	"github.com/palantir/stacktrace"
 	"github.com/patrickmn/go-cache"
@@ -17,6 +18,7 @@ import (
 	"github.com/tnyim/jungletv/server/stores/blockeduser"
 	"github.com/tnyim/jungletv/server/stores/chat"
 	"github.com/tnyim/jungletv/server/stores/moderation"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 	"golang.org/x/sync/singleflight"
 	"gopkg.in/alexcesaro/statsd.v2"
@@ -32,11 +34,18 @@ type Manager struct {
 	slowmodeRateLimiter   limiter.Store
 	nickChangeRateLimiter limiter.Store
 	gifSearchRateLimiter  limiter.Store
+	reactionRateLimiter   limiter.Store
+	reportRateLimiter     limiter.Store
 	moderationStore       moderation.Store
 	blockedUserStore      blockeduser.Store
 	emoteCache            *chat.EmoteCache
 	userSerializer        auth.APIUserSerializer
 	pointsManager         *pointsmanager.Manager
+	modLogWebhook         api.WebhookClient
+	contentFilter         contentFilter
+	channels              chatChannels
+	mutes                 chatMutes
+	reports               reportQueue
 
 	tenorClient                      tenorclient.ClientWithResponsesInterface
 	tenorAPIkey                      string
@@ -50,17 +59,34 @@ type Manager struct {
 	chatDisabled   event.Event[DisabledReason]
 	messageCreated event.Event[MessageCreatedEventArgs]
 	messageDeleted event.Event[snowflake.ID]
+	messageEdited  event.Event[*chat.Message]
+	messageHidden  event.Event[*chat.Message]
+
+	messageReactionUpdated event.Event[MessageReactionUpdatedEventArgs]
+
+	pollUpdated event.Event[PollUpdatedEventArgs]
 
 	userBlockedBy       event.Keyed[string, string]
 	userUnblockedBy     event.Keyed[string, string]
 	userChangedNickname event.Keyed[string, string]
+
+	unreadRepliesUpdated event.Keyed[string, UnreadRepliesUpdatedEventArgs]
+
+	directMessage                event.Keyed[string, DirectMessageEventArgs]
+	directMessagesEnabledChanged event.Keyed[string, bool]
+
+	channelUpdated event.Keyed[string, *types.ChatChannel]
+
+	userMuteUpdated event.Keyed[string, *types.ChatMute]
+
+	reportQueueUpdated event.NoArgEvent
 }
 
 // New returns an initialized chat Manager
 func New(log *log.Logger, statsClient *statsd.Client,
 	store chat.Store, moderationStore moderation.Store, blockStore blockeduser.Store,
 	userSerializer auth.APIUserSerializer, pointsManager *pointsmanager.Manager, snowflakeNode *snowflake.Node,
-	tenorAPIkey string) (*Manager, error) {
+	tenorAPIkey string, modLogWebhook api.WebhookClient) (*Manager, error) {
 	rateLimiter, err := memorystore.New(&memorystore.Config{
 		Tokens:   15,
 		Interval: 30 * time.Second,
@@ -93,6 +119,22 @@ func New(log *log.Logger, statsClient *statsd.Client,
 		return nil, stacktrace.Propagate(err, "failed to create GIF search rate limiter")
 	}
 
+	reactionRateLimiter, err := memorystore.New(&memorystore.Config{
+		Tokens:   15,
+		Interval: 30 * time.Second,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to create reaction rate limiter")
+	}
+
+	reportRateLimiter, err := memorystore.New(&memorystore.Config{
+		Tokens:   5,
+		Interval: 5 * time.Minute,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to create report rate limiter")
+	}
+
 	tenorClient, err := tenorclient.NewClientWithResponses("https://g.tenor.com/", tenorclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		query := req.URL.Query()
 		query.Del("key")
@@ -112,12 +154,15 @@ func New(log *log.Logger, statsClient *statsd.Client,
 		slowmodeRateLimiter:   slowmodeRateLimiter,
 		nickChangeRateLimiter: nickChangeRateLimiter,
 		gifSearchRateLimiter:  gifSearchRateLimiter,
+		reactionRateLimiter:   reactionRateLimiter,
+		reportRateLimiter:     reportRateLimiter,
 		enabled:               true,
 		moderationStore:       moderationStore,
 		blockedUserStore:      blockStore,
 		emoteCache:            &chat.EmoteCache{},
 		userSerializer:        userSerializer,
 		pointsManager:         pointsManager,
+		modLogWebhook:         modLogWebhook,
 
 		tenorClient:   tenorClient,
 		tenorAPIkey:   tenorAPIkey,
@@ -128,13 +173,31 @@ func New(log *log.Logger, statsClient *statsd.Client,
 
 		userChangedNickname: event.NewKeyed[string, string](),
 
+		unreadRepliesUpdated: event.NewKeyed[string, UnreadRepliesUpdatedEventArgs](),
+
+		directMessage:                event.NewKeyed[string, DirectMessageEventArgs](),
+		directMessagesEnabledChanged: event.NewKeyed[string, bool](),
+
+		channelUpdated: event.NewKeyed[string, *types.ChatChannel](),
+
+		userMuteUpdated: event.NewKeyed[string, *types.ChatMute](),
+
+		reportQueueUpdated: event.NewNoArg(),
+
 		chatEnabled:    event.NewNoArg(),
 		chatDisabled:   event.New[DisabledReason](),
 		messageCreated: event.New[MessageCreatedEventArgs](),
 		messageDeleted: event.New[snowflake.ID](),
+		messageEdited:  event.New[*chat.Message](),
+		messageHidden:  event.New[*chat.Message](),
+
+		messageReactionUpdated: event.New[MessageReactionUpdatedEventArgs](),
+
+		pollUpdated: event.New[PollUpdatedEventArgs](),
 	}
 
 	m.store.SetAttachmentLoaderForType(MessageAttachmentTypeTenorGif, m.getTenorGifInfo)
+	m.store.SetAttachmentLoaderForType(MessageAttachmentTypePoll, m.getPollAttachment)
 
 	return m, nil
 }
@@ -10,23 +10,42 @@ import (
 	"github.com/tnyim/jungletv/server/auth"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
 )
 
 var newlineReducingRegexp = regexp.MustCompile("\n\n\n+")
 
-func (c *Manager) CreateMessage(ctx context.Context, author auth.User, content string, reference *chat.Message, attachments []chat.MessageAttachmentStorage) (*chat.Message, error) {
+// CreateMessage creates a message by the given author in the chat channel with the given ID.
+// The empty string identifies the main channel
+func (c *Manager) CreateMessage(ctx context.Context, author auth.User, channelID string, content string, reference *chat.Message, attachments []chat.MessageAttachmentStorage) (*chat.Message, error) {
 	if !c.enabled {
 		return nil, stacktrace.NewError("chat currently disabled")
 	}
 
+	channel, err := c.Channel(ctx, author, channelID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !channel.Enabled {
+		return nil, stacktrace.Propagate(ErrChatChannelDisabled, "")
+	}
+	if reference != nil && reference.Channel != channel.ID {
+		return nil, stacktrace.NewError("referenced message belongs to a different channel")
+	}
+
 	banned, err := c.moderationStore.LoadUserBannedFromChat(ctx, author.Address(), authinterceptor.RemoteAddressFromContext(ctx))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
+	err = c.checkNotMuted(ctx, author)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
 	if author.ApplicationID() == "" {
 		var ok bool
-		if (c.slowmode || banned) && !auth.UserPermissionLevelIsAtLeast(author, auth.AdminPermissionLevel) {
+		if (c.slowmode || channel.Slowmode || banned) && !auth.UserPermissionLevelIsAtLeast(author, auth.AdminPermissionLevel) {
 			_, _, _, ok, err = c.slowmodeRateLimiter.Take(ctx, author.Address())
 		} else {
 			_, _, _, ok, err = c.rateLimiter.Take(ctx, author.Address())
@@ -44,6 +63,22 @@ func (c *Manager) CreateMessage(ctx context.Context, author auth.User, content s
 	// replace groups of more than 2 newlines with 2 newlines
 	content = newlineReducingRegexp.ReplaceAllString(content, "\n\n")
 
+	var filterHits []ContentFilterHit
+	var filterAction types.ChatFilterAction
+	if author.ApplicationID() == "" && !auth.UserPermissionLevelIsAtLeast(author, auth.AdminPermissionLevel) {
+		filterHits, filterAction, err = c.filterContent(ctx, content)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if filterAction == types.ChatFilterActionReject || filterAction == types.ChatFilterActionMute {
+			c.reportContentFilterHits(author, nil, filterHits, filterAction)
+			if filterAction == types.ChatFilterActionMute {
+				c.muteForContentFilterHits(ctx, author, filterHits)
+			}
+			return nil, stacktrace.Propagate(ErrMessageRejectedByContentFilter, "")
+		}
+	}
+
 	content, err = c.processEmotesForStorage(ctx, author, content)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -60,7 +95,8 @@ func (c *Manager) CreateMessage(ctx context.Context, author auth.User, content s
 		Author:       author,
 		Content:      content,
 		Reference:    reference,
-		Shadowbanned: banned,
+		Channel:      channel.ID,
+		Shadowbanned: banned || filterAction == types.ChatFilterActionHide,
 		Attachments:  attachments,
 	}
 	nickname, err := c.store.StoreMessage(ctx, m)
@@ -70,6 +106,9 @@ func (c *Manager) CreateMessage(ctx context.Context, author auth.User, content s
 	if m.Author != nil {
 		m.Author.SetNickname(nickname)
 	}
+	if len(filterHits) > 0 {
+		c.reportContentFilterHits(author, m, filterHits, filterAction)
+	}
 
 	err = c.processEmotesForLoadingMessage(ctx, m)
 	if err != nil {
@@ -85,13 +124,35 @@ func (c *Manager) CreateMessage(ctx context.Context, author auth.User, content s
 		}
 	}
 
-	c.messageCreated.Notify(MessageCreatedEventArgs{m, m.SerializeForAPI(ctx, c.userSerializer)}, false)
+	protoMessage := m.SerializeForAPI(ctx, c.userSerializer)
+	c.messageCreated.Notify(MessageCreatedEventArgs{m, protoMessage}, false)
+	err = c.notifyReplyReceived(ctx, m, protoMessage)
+	if err != nil {
+		c.log.Println(stacktrace.Propagate(err, "failed to notify reply"))
+	}
 	go c.statsClient.Count("chat_message_created", 1)
 	return m, nil
 }
 
+// CreateSystemMessage creates a system message in the main chat channel
 func (c *Manager) CreateSystemMessage(ctx context.Context, content string) (*chat.Message, error) {
-	var err error
+	m, err := c.CreateSystemMessageInChannel(ctx, chat.MainChannelID, content)
+	return m, stacktrace.Propagate(err, "")
+}
+
+// CreateSystemMessageInChannel creates a system message in the chat channel with the given ID
+func (c *Manager) CreateSystemMessageInChannel(ctx context.Context, channelID string, content string) (*chat.Message, error) {
+	err := c.ensureChannelsLoaded(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.channels.mu.RLock()
+	_, ok := c.channels.channels[channelID]
+	c.channels.mu.RUnlock()
+	if !ok {
+		return nil, stacktrace.Propagate(ErrChatChannelNotFound, "")
+	}
+
 	content, err = c.processEmotesForStorage(ctx, nil, content)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -101,6 +162,7 @@ func (c *Manager) CreateSystemMessage(ctx context.Context, content string) (*cha
 		ID:        c.idNode.Generate(),
 		CreatedAt: time.Now(),
 		Content:   content,
+		Channel:   channelID,
 	}
 	_, err = c.store.StoreMessage(ctx, m)
 	if err != nil {
@@ -0,0 +1,129 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+)
+
+// MessageEditWindow is the amount of time after the creation of a message during which its author can edit it
+const MessageEditWindow = 15 * time.Minute
+
+// ErrMessageNotEditable is returned when the user attempts to edit a message that is not theirs, or a message that is
+// too old to be edited
+var ErrMessageNotEditable = errors.New("message can't be edited")
+
+// EditMessage replaces the content of a message sent by the given user.
+// Edited content goes through the same processing as the content of new messages
+func (c *Manager) EditMessage(ctx context.Context, user auth.User, id snowflake.ID, content string) (*chat.Message, error) {
+	if !c.enabled {
+		return nil, stacktrace.NewError("chat currently disabled")
+	}
+
+	message, err := c.store.LoadMessage(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if message.Author == nil || message.Author.Address() != user.Address() {
+		return nil, stacktrace.Propagate(ErrMessageNotEditable, "")
+	}
+	if time.Since(message.CreatedAt) > MessageEditWindow {
+		return nil, stacktrace.Propagate(ErrMessageNotEditable, "")
+	}
+
+	channel, err := c.Channel(ctx, user, message.Channel)
+	if err != nil {
+		if errors.Is(err, ErrChatChannelNotFound) || errors.Is(err, ErrChatChannelAccessDenied) {
+			return nil, stacktrace.Propagate(ErrMessageNotEditable, "")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !channel.Enabled {
+		return nil, stacktrace.Propagate(ErrChatChannelDisabled, "")
+	}
+
+	banned, err := c.moderationStore.LoadUserBannedFromChat(ctx, user.Address(), authinterceptor.RemoteAddressFromContext(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.checkNotMuted(ctx, user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if user.ApplicationID() == "" {
+		var ok bool
+		if (c.slowmode || channel.Slowmode || banned) && !auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel) {
+			_, _, _, ok, err = c.slowmodeRateLimiter.Take(ctx, user.Address())
+		} else {
+			_, _, _, ok, err = c.rateLimiter.Take(ctx, user.Address())
+		}
+
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if !ok {
+			return nil, stacktrace.NewError("rate limit reached")
+		}
+	}
+
+	content = strings.TrimSpace(content)
+	// replace groups of more than 2 newlines with 2 newlines
+	content = newlineReducingRegexp.ReplaceAllString(content, "\n\n")
+	if content == "" && len(message.AttachmentsView) == 0 {
+		return nil, stacktrace.NewError("message empty")
+	}
+
+	var filterHits []ContentFilterHit
+	var filterAction types.ChatFilterAction
+	if user.ApplicationID() == "" && !auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel) {
+		filterHits, filterAction, err = c.filterContent(ctx, content)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if filterAction == types.ChatFilterActionReject || filterAction == types.ChatFilterActionMute {
+			c.reportContentFilterHits(user, nil, filterHits, filterAction)
+			if filterAction == types.ChatFilterActionMute {
+				c.muteForContentFilterHits(ctx, user, filterHits)
+			}
+			return nil, stacktrace.Propagate(ErrMessageRejectedByContentFilter, "")
+		}
+	}
+
+	content, err = c.processEmotesForStorage(ctx, user, content)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if content == message.Content {
+		// nothing to do
+		message, err = c.LoadMessage(ctx, id)
+		return message, stacktrace.Propagate(err, "")
+	}
+
+	err = c.store.EditMessage(ctx, id, content, banned || filterAction == types.ChatFilterActionHide)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "failed to edit chat message")
+	}
+
+	message, err = c.LoadMessage(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(filterHits) > 0 {
+		c.reportContentFilterHits(user, message, filterHits, filterAction)
+	}
+
+	c.messageEdited.Notify(message, false)
+	go c.statsClient.Count("chat_message_edited", 1)
+	return message, nil
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
 )
 
-func (c *Manager) LoadMessagesBetween(ctx context.Context, includeShadowbanned auth.User, since, until time.Time) ([]*chat.Message, error) {
-	messages, err := c.store.LoadMessagesBetween(ctx, includeShadowbanned, since, until)
+func (c *Manager) LoadMessagesBetween(ctx context.Context, channel string, includeShadowbanned auth.User, since, until time.Time) ([]*chat.Message, error) {
+	messages, err := c.store.LoadMessagesBetween(ctx, channel, includeShadowbanned, since, until)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "could not load chat messages")
 	}
@@ -20,14 +21,22 @@ func (c *Manager) LoadMessagesBetween(ctx context.Context, includeShadowbanned a
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
+	err = c.loadReactionsForMessages(ctx, includeShadowbanned, messages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 	return messages, nil
 }
 
-func (c *Manager) LoadNumLatestMessages(ctx context.Context, includeShadowbanned auth.User, num int) ([]*chat.Message, []*proto.ChatMessage, error) {
-	messages, err := c.store.LoadNumLatestMessages(ctx, includeShadowbanned, num)
+func (c *Manager) LoadNumLatestMessages(ctx context.Context, channel string, includeShadowbanned auth.User, num int) ([]*chat.Message, []*proto.ChatMessage, error) {
+	messages, err := c.store.LoadNumLatestMessages(ctx, channel, includeShadowbanned, num)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "could not load chat messages")
 	}
+	err = c.loadReactionsForMessages(ctx, includeShadowbanned, messages)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
 	protoMessages := make([]*proto.ChatMessage, len(messages))
 	for i := range messages {
 		err = c.processEmotesForLoadingMessage(ctx, messages[i])
@@ -45,6 +54,22 @@ func (c *Manager) LoadNumLatestMessagesFromUser(ctx context.Context, user auth.U
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "could not load chat messages")
 	}
+	err = c.loadReactionsForMessages(ctx, nil, messages)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	// this method is used for moderation purposes, so include the edit history
+	ids := make([]snowflake.ID, len(messages))
+	for i := range messages {
+		ids[i] = messages[i].ID
+	}
+	revisions, err := c.store.LoadMessageRevisions(ctx, ids)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	for _, message := range messages {
+		message.Revisions = revisions[message.ID]
+	}
 	protoMessages := make([]*proto.ChatMessage, len(messages))
 	for i := range messages {
 		err = c.processEmotesForLoadingMessage(ctx, messages[i])
@@ -66,5 +91,33 @@ func (c *Manager) LoadMessage(ctx context.Context, id snowflake.ID) (*chat.Messa
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
+	err = c.loadReactionsForMessages(ctx, nil, []*chat.Message{message})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
 	return message, nil
 }
+
+// SearchMessages performs a full-text search over chat messages, for moderation purposes
+func (c *Manager) SearchMessages(ctx context.Context, params chat.SearchParams, pagParams *types.PaginationParams) ([]*chat.Message, uint64, error) {
+	messages, total, err := c.store.SearchMessages(ctx, params, pagParams)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "could not search chat messages")
+	}
+	err = c.processEmotesForLoadingMessages(ctx, messages)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	return messages, total, nil
+}
+
+// LoadMessageContext loads the messages sent within the specified duration before and after the message with the
+// given ID in the same channel, including that message and shadowbanned messages by the same author
+func (c *Manager) LoadMessageContext(ctx context.Context, id snowflake.ID, window time.Duration) ([]*chat.Message, error) {
+	message, err := c.store.LoadMessage(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	messages, err := c.LoadMessagesBetween(ctx, message.Channel, message.Author, message.CreatedAt.Add(-window), message.CreatedAt.Add(window))
+	return messages, stacktrace.Propagate(err, "")
+}
@@ -0,0 +1,378 @@
+package chatmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// ReportAutoHideThreshold is the number of distinct trusted users that must report a message for it to be hidden
+// automatically, pending moderator review
+const ReportAutoHideThreshold = 3
+
+// ErrMessageNotReportable is returned when an user attempts to report a message that is their own, a system message
+// or a message they can't see
+var ErrMessageNotReportable = errors.New("message can't be reported")
+
+// ErrMessageAlreadyReported is returned when an user attempts to report the same message more than once
+var ErrMessageAlreadyReported = errors.New("message already reported by this user")
+
+// ErrInvalidReportReason is returned when a report has an unknown reason
+var ErrInvalidReportReason = errors.New("invalid report reason")
+
+// ErrNoUnresolvedReports is returned when attempting to resolve the reports of a message that has no unresolved
+// reports
+var ErrNoUnresolvedReports = errors.New("message has no unresolved reports")
+
+var validReportReasons = map[types.ChatMessageReportReason]struct{}{
+	types.ChatMessageReportReasonSpam:          {},
+	types.ChatMessageReportReasonHarassment:    {},
+	types.ChatMessageReportReasonHateSpeech:    {},
+	types.ChatMessageReportReasonScam:          {},
+	types.ChatMessageReportReasonInappropriate: {},
+	types.ChatMessageReportReasonOther:         {},
+}
+
+// ReportQueueEntry groups the unresolved reports of a single message
+type ReportQueueEntry struct {
+	MessageID snowflake.ID
+	Author    string
+	// Content is the content of the message at the time of the most recent report
+	Content              string
+	Reports              []*types.ChatMessageReport
+	TrustedReporterCount int
+}
+
+// Hidden returns whether the message was automatically hidden due to reports from trusted users
+func (e *ReportQueueEntry) Hidden() bool {
+	for _, report := range e.Reports {
+		if report.CausedHiding {
+			return true
+		}
+	}
+	return false
+}
+
+type reportQueue struct {
+	mu      sync.RWMutex
+	loaded  bool
+	entries []*ReportQueueEntry
+}
+
+// ReloadReportQueue reloads the unresolved chat message reports from the database
+func (c *Manager) ReloadReportQueue(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	reports, err := types.GetUnresolvedChatMessageReports(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	// reports come sorted by date, so entries end up sorted by their oldest report
+	entries := []*ReportQueueEntry{}
+	byMessage := make(map[int64]*ReportQueueEntry)
+	for _, report := range reports {
+		entry, ok := byMessage[report.MessageID]
+		if !ok {
+			entry = &ReportQueueEntry{
+				MessageID: snowflake.ParseInt64(report.MessageID),
+				Author:    report.MessageAuthor,
+			}
+			byMessage[report.MessageID] = entry
+			entries = append(entries, entry)
+		}
+		entry.Content = report.MessageContent
+		entry.Reports = append(entry.Reports, report)
+		if report.TrustedReporter {
+			entry.TrustedReporterCount++
+		}
+	}
+
+	c.reports.mu.Lock()
+	defer c.reports.mu.Unlock()
+	c.reports.entries = entries
+	c.reports.loaded = true
+	return nil
+}
+
+// ReportQueue returns the messages with unresolved reports, starting with the message reported the longest ago
+func (c *Manager) ReportQueue(ctx context.Context) ([]*ReportQueueEntry, error) {
+	c.reports.mu.RLock()
+	loaded := c.reports.loaded
+	c.reports.mu.RUnlock()
+	if !loaded {
+		err := c.ReloadReportQueue(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	c.reports.mu.RLock()
+	defer c.reports.mu.RUnlock()
+	return c.reports.entries, nil
+}
+
+// ReportQueueEntryForMessage returns the unresolved reports of the message with the given ID
+func (c *Manager) ReportQueueEntryForMessage(ctx context.Context, messageID snowflake.ID) (*ReportQueueEntry, error) {
+	entries, err := c.ReportQueue(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for _, entry := range entries {
+		if entry.MessageID == messageID {
+			return entry, nil
+		}
+	}
+	return nil, stacktrace.Propagate(ErrNoUnresolvedReports, "")
+}
+
+// isTrustedReporter returns whether reports by the given user count towards automatically hiding messages.
+// Moderators and users with an active subscription are trusted, as long as they are not banned from chat
+func (c *Manager) isTrustedReporter(ctx context.Context, user auth.User) (bool, error) {
+	banned, err := c.moderationStore.LoadUserBannedFromChat(ctx, user.Address(), authinterceptor.RemoteAddressFromContext(ctx))
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	if banned {
+		return false, nil
+	}
+	if auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel) {
+		return true, nil
+	}
+	subscribed, err := c.pointsManager.IsUserCurrentlySubscribed(ctx, user)
+	return subscribed, stacktrace.Propagate(err, "")
+}
+
+// ReportMessage adds a report of a chat message to the moderator review queue.
+// When enough trusted users report the same message, it is hidden from everyone but its author
+func (c *Manager) ReportMessage(ctxCtx context.Context, reporter auth.User, messageID snowflake.ID, reason types.ChatMessageReportReason, comment string) (*types.ChatMessageReport, error) {
+	if _, ok := validReportReasons[reason]; !ok {
+		return nil, stacktrace.Propagate(ErrInvalidReportReason, "")
+	}
+
+	err := c.checkNotMuted(ctxCtx, reporter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	message, err := c.store.LoadMessage(ctxCtx, messageID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if message.Author == nil || message.Author.IsUnknown() || message.Author.Address() == reporter.Address() ||
+		message.Shadowbanned {
+		return nil, stacktrace.Propagate(ErrMessageNotReportable, "")
+	}
+	canView, err := c.canViewMessagesInChannel(ctxCtx, reporter, message.Channel)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !canView {
+		return nil, stacktrace.Propagate(ErrMessageNotReportable, "")
+	}
+
+	_, _, _, ok, err := c.reportRateLimiter.Take(ctxCtx, reporter.Address())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if !ok {
+		return nil, stacktrace.NewError("rate limit reached")
+	}
+
+	trusted, err := c.isTrustedReporter(ctxCtx, reporter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	existing, err := types.GetChatMessageReportsForMessage(ctx, messageID.Int64(), false)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	for _, r := range existing {
+		if r.Reporter == reporter.Address() {
+			return nil, stacktrace.Propagate(ErrMessageAlreadyReported, "")
+		}
+	}
+
+	report := &types.ChatMessageReport{
+		ID:              uuid.NewV4().String(),
+		MessageID:       messageID.Int64(),
+		MessageAuthor:   message.Author.Address(),
+		MessageContent:  message.Content,
+		Reporter:        reporter.Address(),
+		ReportedAt:      time.Now(),
+		Reason:          reason,
+		Comment:         comment,
+		TrustedReporter: trusted,
+	}
+	err = report.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if trusted {
+		hide, err := c.markReportedMessageForHiding(ctxCtx, messageID)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if hide {
+			c.hideReportedMessage(ctxCtx, message)
+		}
+	}
+
+	err = c.ReloadReportQueue(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.reportQueueUpdated.Notify(false)
+	go c.statsClient.Count("chat_message_reported", 1)
+	return report, nil
+}
+
+// markReportedMessageForHiding returns whether the message with the given ID has just reached the number of trusted
+// reporters required for it to be hidden, recording on its unresolved reports that they caused the hiding.
+// Reports are counted again after the new report is committed, so that concurrent reports can't both miss the
+// threshold, and are locked so that only one of them gets to hide the message
+func (c *Manager) markReportedMessageForHiding(ctxCtx context.Context, messageID snowflake.ID) (bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	reports, err := types.LockUnresolvedChatMessageReportsForMessage(ctx, messageID.Int64())
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	trustedReporters := 0
+	for _, r := range reports {
+		if r.CausedHiding {
+			// already hidden
+			return false, nil
+		}
+		if r.TrustedReporter {
+			trustedReporters++
+		}
+	}
+	if trustedReporters < ReportAutoHideThreshold {
+		return false, nil
+	}
+
+	for _, r := range reports {
+		r.CausedHiding = true
+		err = r.Update(ctx)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "")
+		}
+	}
+
+	return true, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// hideReportedMessage shadowbans a message that was reported by enough trusted users
+func (c *Manager) hideReportedMessage(ctx context.Context, message *chat.Message) {
+	err := c.store.ShadowbanMessage(ctx, message.ID)
+	if err != nil {
+		c.log.Println(stacktrace.Propagate(err, "failed to hide reported chat message"))
+		return
+	}
+	message.Shadowbanned = true
+	c.messageHidden.Notify(message, false)
+	c.log.Printf("Chat message %s by %s hidden after being reported by %d trusted users", message.ID, message.Author.Address(), ReportAutoHideThreshold)
+
+	if c.modLogWebhook == nil {
+		return
+	}
+	go func() {
+		_, err := c.modLogWebhook.SendContent(
+			fmt.Sprintf("Chat message from %s was automatically hidden after being reported by %d trusted users, pending review",
+				message.Author.Address()[:14],
+				ReportAutoHideThreshold))
+		if err != nil {
+			c.log.Println("Failed to send mod log webhook:", err)
+		}
+	}()
+}
+
+// ResolveMessageReports marks all unresolved reports of the message with the given ID as resolved, returning them.
+// Acting on the resolution (e.g. deleting the message) is up to the caller
+func (c *Manager) ResolveMessageReports(ctxCtx context.Context, messageID snowflake.ID, resolution types.ChatMessageReportResolution, moderator auth.User) ([]*types.ChatMessageReport, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	reports, err := types.GetChatMessageReportsForMessage(ctx, messageID.Int64(), true)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	if len(reports) == 0 {
+		return nil, stacktrace.Propagate(ErrNoUnresolvedReports, "")
+	}
+
+	now := time.Now()
+	moderatorAddress := moderator.Address()
+	resolutionString := string(resolution)
+	wasHidden := false
+	for _, report := range reports {
+		wasHidden = wasHidden || report.CausedHiding
+		report.ResolvedAt = sql.NullTime{Time: now, Valid: true}
+		report.ResolvedBy = &moderatorAddress
+		report.Resolution = &resolutionString
+		err = report.Update(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	if wasHidden && resolution == types.ChatMessageReportResolutionDismissed {
+		// the message was only hidden because of the reports, which were found to be unwarranted
+		err = c.store.UnshadowbanMessage(ctx, messageID)
+		if err != nil && !errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if err == nil {
+			c.log.Printf("Chat message %s restored after %s dismissed its reports", messageID, moderatorAddress)
+		}
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.ReloadReportQueue(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.reportQueueUpdated.Notify(false)
+	return reports, nil
+}
@@ -0,0 +1,258 @@
+package chatmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"golang.org/x/exp/slices"
+)
+
+// MuteEscalationLadder contains the durations of successive mutes of the same user, when the duration is not
+// explicitly specified. These are also the only durations that can be explicitly specified
+var MuteEscalationLadder = []time.Duration{1 * time.Minute, 10 * time.Minute, 1 * time.Hour, 24 * time.Hour}
+
+// MuteEscalationWindow is how far back previous mutes of an user are taken into account when escalating
+const MuteEscalationWindow = 30 * 24 * time.Hour
+
+// ErrInvalidMuteDuration is returned when the specified mute duration is not one of the durations in
+// MuteEscalationLadder
+var ErrInvalidMuteDuration = errors.New("invalid mute duration")
+
+// ErrUserNotMuted is returned when attempting to lift the mute of an user who is not muted
+var ErrUserNotMuted = errors.New("user not muted")
+
+// MutedError is returned when an user who is muted attempts to participate in chat
+type MutedError struct {
+	Until time.Time
+}
+
+func (e *MutedError) Error() string {
+	return fmt.Sprintf("user muted until %s", e.Until.Format(time.RFC3339))
+}
+
+type chatMutes struct {
+	mu     sync.RWMutex
+	loaded bool
+	// reward address to the mute in effect for that address that expires the latest
+	active map[string]*types.ChatMute
+}
+
+// ReloadMutes reloads the mutes in effect from the database
+func (c *Manager) ReloadMutes(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	mutes, err := types.GetActiveChatMutes(ctx, time.Now())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	active := make(map[string]*types.ChatMute)
+	for _, mute := range mutes {
+		if existing, ok := active[mute.Address]; !ok || mute.MutedUntil.After(existing.MutedUntil) {
+			active[mute.Address] = mute
+		}
+	}
+
+	c.mutes.mu.Lock()
+	defer c.mutes.mu.Unlock()
+	c.mutes.active = active
+	c.mutes.loaded = true
+	return nil
+}
+
+// ActiveMute returns the mute currently in effect for the given user, or nil if the user is not muted
+func (c *Manager) ActiveMute(ctx context.Context, user auth.User) (*types.ChatMute, error) {
+	if user == nil || user.IsUnknown() || user.ApplicationID() != "" {
+		return nil, nil
+	}
+
+	c.mutes.mu.RLock()
+	loaded := c.mutes.loaded
+	c.mutes.mu.RUnlock()
+	if !loaded {
+		err := c.ReloadMutes(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	c.mutes.mu.RLock()
+	defer c.mutes.mu.RUnlock()
+	mute, ok := c.mutes.active[user.Address()]
+	if !ok || !mute.Active(time.Now()) {
+		return nil, nil
+	}
+	return mute, nil
+}
+
+// checkNotMuted returns a MutedError if the given user is currently muted
+func (c *Manager) checkNotMuted(ctx context.Context, user auth.User) error {
+	mute, err := c.ActiveMute(ctx, user)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if mute != nil {
+		return stacktrace.Propagate(&MutedError{Until: mute.MutedUntil}, "")
+	}
+	return nil
+}
+
+// MuteUser temporarily prevents the user with the given address from participating in chat.
+// If duration is zero, the duration is picked from MuteEscalationLadder according to the number of times the user was
+// previously muted within MuteEscalationWindow.
+// mutedBy may be nil when the mute is applied automatically
+func (c *Manager) MuteUser(ctxCtx context.Context, address string, duration time.Duration, source types.ChatMuteSource, reason string, mutedBy auth.User, mutedByName string) (*types.ChatMute, error) {
+	if duration != 0 && !slices.Contains(MuteEscalationLadder, duration) {
+		return nil, stacktrace.Propagate(ErrInvalidMuteDuration, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	now := time.Now()
+	if duration == 0 {
+		previousMutes, err := types.CountChatMutesOfAddressSince(ctx, address, now.Add(-MuteEscalationWindow))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		duration = MuteEscalationLadder[min(previousMutes, len(MuteEscalationLadder)-1)]
+	}
+
+	mute := &types.ChatMute{
+		ID:            uuid.NewV4().String(),
+		Address:       address,
+		MutedAt:       now,
+		MutedUntil:    now.Add(duration),
+		Source:        source,
+		Reason:        reason,
+		ModeratorName: mutedByName,
+	}
+	if mutedBy != nil && !mutedBy.IsUnknown() {
+		mute.ModeratorAddress = mutedBy.Address()
+	}
+
+	err = mute.Update(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.ReloadMutes(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	// an existing mute may outlast the new one
+	effective, err := c.ActiveMute(ctxCtx, auth.NewAddressOnlyUser(address))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.userMuteUpdated.Notify(address, effective, false)
+	go c.statsClient.Count("chat_user_muted", 1)
+	return mute, nil
+}
+
+// UnmuteUser lifts all mutes in effect for the user with the given address, returning the lifted mutes
+func (c *Manager) UnmuteUser(ctxCtx context.Context, address, reason string, liftedBy auth.User) ([]*types.ChatMute, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	now := time.Now()
+	mutes, err := types.GetActiveChatMutes(ctx, now)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	lifted := []*types.ChatMute{}
+	liftedByAddress := liftedBy.Address()
+	for _, mute := range mutes {
+		if mute.Address != address {
+			continue
+		}
+		mute.LiftedAt = sql.NullTime{Time: now, Valid: true}
+		mute.LiftedBy = &liftedByAddress
+		mute.LiftReason = reason
+		err = mute.Update(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		lifted = append(lifted, mute)
+	}
+	if len(lifted) == 0 {
+		return nil, stacktrace.Propagate(ErrUserNotMuted, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	err = c.ReloadMutes(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	c.userMuteUpdated.Notify(address, nil, false)
+	return lifted, nil
+}
+
+// muteForContentFilterHits mutes the author of a message that matched chat filter rules with the mute action
+func (c *Manager) muteForContentFilterHits(ctx context.Context, author auth.User, hits []ContentFilterHit) {
+	var rule *types.ChatFilterRule
+	for _, hit := range hits {
+		if hit.Rule.Action == types.ChatFilterActionMute {
+			rule = hit.Rule
+			break
+		}
+	}
+	if rule == nil {
+		return
+	}
+
+	reason := fmt.Sprintf("Matched chat filter rule %s", rule.ID)
+	if rule.Description != "" {
+		reason = fmt.Sprintf("Matched chat filter rule %s (%s)", rule.ID, rule.Description)
+	}
+	mute, err := c.MuteUser(ctx, author.Address(), 0, types.ChatMuteSourceContentFilter, reason, nil, "content filter")
+	if err != nil {
+		c.log.Println(stacktrace.Propagate(err, "failed to mute user for content filter hit"))
+		return
+	}
+	c.log.Printf("User %s muted until %s by the chat content filter (rule %s)", author.Address(), mute.MutedUntil.Format(time.RFC3339), rule.ID)
+
+	if c.modLogWebhook == nil {
+		return
+	}
+	go func() {
+		_, err := c.modLogWebhook.SendContent(
+			fmt.Sprintf("User %s was automatically muted for %s by content filter rule `%s`",
+				author.Address()[:14],
+				mute.MutedUntil.Sub(mute.MutedAt).String(),
+				rule.ID))
+		if err != nil {
+			c.log.Println("Failed to send mod log webhook:", err)
+		}
+	}()
+}
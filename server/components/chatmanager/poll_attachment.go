@@ -0,0 +1,109 @@
+package chatmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dop251/goja"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/apprunner/gojautil"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MessageAttachmentTypePoll is the identifier for attachments of the poll type
+const MessageAttachmentTypePoll = "poll"
+
+// MessageAttachmentPollStorage is the storage model for a poll attachment. Implements MessageAttachmentStorage
+type MessageAttachmentPollStorage struct {
+	PollID string
+}
+
+var _ chat.MessageAttachmentStorage = &MessageAttachmentPollStorage{}
+
+func (a MessageAttachmentPollStorage) AttachmentType() string {
+	return MessageAttachmentTypePoll
+}
+
+func (a *MessageAttachmentPollStorage) SerializeForDatabase(context.Context) string {
+	return a.PollID
+}
+
+// MessageAttachmentPollView is the view model for a poll attachment. Implements MessageAttachmentView
+type MessageAttachmentPollView struct {
+	Poll *types.ChatPoll
+	// Tally contains the number of votes cast on each option, in the same order as the poll options
+	Tally []int
+}
+
+var _ chat.MessageAttachmentView = &MessageAttachmentPollView{}
+
+// TotalVotes returns the number of votes cast on all options of the poll
+func (a *MessageAttachmentPollView) TotalVotes() int {
+	total := 0
+	for _, votes := range a.Tally {
+		total += votes
+	}
+	return total
+}
+
+var chatPollVotingModeToProto = map[types.ChatPollVotingMode]proto.ChatPollVotingMode{
+	types.ChatPollVotingModeSingle: proto.ChatPollVotingMode_CHAT_POLL_VOTING_MODE_SINGLE,
+	types.ChatPollVotingModePoints: proto.ChatPollVotingMode_CHAT_POLL_VOTING_MODE_POINTS,
+}
+
+// SerializePollForAPI returns the protobuf representation of the poll
+func (a *MessageAttachmentPollView) SerializePollForAPI() *proto.ChatMessagePollAttachment {
+	options := make([]*proto.ChatPollOption, len(a.Poll.Options))
+	for i, option := range a.Poll.Options {
+		options[i] = &proto.ChatPollOption{
+			Text:  option,
+			Votes: uint32(a.Tally[i]),
+		}
+	}
+	return &proto.ChatMessagePollAttachment{
+		Id:            a.Poll.ID,
+		Question:      a.Poll.Question,
+		Options:       options,
+		VotingMode:    chatPollVotingModeToProto[a.Poll.VotingMode],
+		PointsPerVote: int32(a.Poll.PointsPerVote),
+		ClosesAt:      timestamppb.New(a.Poll.ClosesAt),
+		Closed:        a.Poll.Closed,
+		TotalVotes:    uint32(a.TotalVotes()),
+	}
+}
+
+func (a *MessageAttachmentPollView) SerializeForAPI(context.Context) *proto.ChatMessageAttachment {
+	return &proto.ChatMessageAttachment{
+		Attachment: &proto.ChatMessageAttachment_Poll{
+			Poll: a.SerializePollForAPI(),
+		},
+	}
+}
+
+func (a *MessageAttachmentPollView) SerializeForModLog(context.Context) string {
+	return fmt.Sprintf("Poll: %s (%s)", a.Poll.Question, strings.Join(a.Poll.Options, " / "))
+}
+
+func (a *MessageAttachmentPollView) SerializeForJS(ctx context.Context, vm *goja.Runtime) map[string]interface{} {
+	options := make([]map[string]interface{}, len(a.Poll.Options))
+	for i, option := range a.Poll.Options {
+		options[i] = map[string]interface{}{
+			"text":  option,
+			"votes": a.Tally[i],
+		}
+	}
+	return map[string]interface{}{
+		"type":          MessageAttachmentTypePoll,
+		"id":            a.Poll.ID,
+		"question":      a.Poll.Question,
+		"options":       options,
+		"votingMode":    string(a.Poll.VotingMode),
+		"pointsPerVote": a.Poll.PointsPerVote,
+		"closesAt":      gojautil.SerializeTime(vm, a.Poll.ClosesAt),
+		"closed":        a.Poll.Closed,
+		"totalVotes":    a.TotalVotes(),
+	}
+}
@@ -0,0 +1,409 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+const (
+	maxPollOptions          = 10
+	maxPollQuestionLength   = 200
+	maxPollOptionLength     = 100
+	minPollDuration         = 1 * time.Minute
+	maxPollDuration         = 7 * 24 * time.Hour
+	maxPollVotesPerPurchase = 100
+)
+
+// ErrInvalidPoll is returned when a poll has an invalid question, options, duration or voting mode
+var ErrInvalidPoll = errors.New("invalid poll")
+
+// ErrPollNotFound is returned when the specified poll does not exist or is not visible to the user
+var ErrPollNotFound = errors.New("poll not found")
+
+// ErrPollClosed is returned when attempting to vote in a poll that is already closed
+var ErrPollClosed = errors.New("poll closed")
+
+// ErrAlreadyVotedInPoll is returned when an user attempts to vote more than once in a single-vote poll
+var ErrAlreadyVotedInPoll = errors.New("already voted in poll")
+
+// ErrInvalidPollVote is returned when a vote is cast on an option that does not exist, or with an invalid number of
+// votes
+var ErrInvalidPollVote = errors.New("invalid poll vote")
+
+// PollUpdatedEventArgs contains the values for the PollUpdated event
+type PollUpdatedEventArgs struct {
+	MessageID snowflake.ID
+	// Channel is the ID of the channel the poll message belongs to
+	Channel string
+	Poll    *MessageAttachmentPollView
+}
+
+func (c *Manager) loadPollView(ctx *transaction.WrappingContext, pollID string) (*MessageAttachmentPollView, error) {
+	polls, err := types.GetChatPollsWithIDs(ctx, []string{pollID})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	poll, ok := polls[pollID]
+	if !ok {
+		return nil, stacktrace.Propagate(ErrPollNotFound, "")
+	}
+
+	tally, err := types.GetChatPollTally(ctx, poll.ID, len(poll.Options))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &MessageAttachmentPollView{
+		Poll:  poll,
+		Tally: tally,
+	}, nil
+}
+
+func (c *Manager) getPollAttachment(ctxCtx context.Context, pollID string) (chat.MessageAttachmentView, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	view, err := c.loadPollView(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, ErrPollNotFound) {
+			// the poll may have been removed along with its channel. return nil to omit the attachment but don't fail
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return view, nil
+}
+
+func validatePoll(question string, options []string, duration time.Duration, votingMode types.ChatPollVotingMode, pointsPerVote int) error {
+	if question == "" || utf8.RuneCountInString(question) > maxPollQuestionLength {
+		return stacktrace.Propagate(fmt.Errorf("%w: the question must be between 1 and %d characters long", ErrInvalidPoll, maxPollQuestionLength), "")
+	}
+	if len(options) < 2 || len(options) > maxPollOptions {
+		return stacktrace.Propagate(fmt.Errorf("%w: polls must have between 2 and %d options", ErrInvalidPoll, maxPollOptions), "")
+	}
+	for _, option := range options {
+		if option == "" || utf8.RuneCountInString(option) > maxPollOptionLength {
+			return stacktrace.Propagate(fmt.Errorf("%w: options must be between 1 and %d characters long", ErrInvalidPoll, maxPollOptionLength), "")
+		}
+	}
+	if duration < minPollDuration || duration > maxPollDuration {
+		return stacktrace.Propagate(fmt.Errorf("%w: the duration must be between %s and %s", ErrInvalidPoll, minPollDuration, maxPollDuration), "")
+	}
+	switch votingMode {
+	case types.ChatPollVotingModeSingle:
+		if pointsPerVote != 0 {
+			return stacktrace.Propagate(fmt.Errorf("%w: single-vote polls can't have a points cost", ErrInvalidPoll), "")
+		}
+	case types.ChatPollVotingModePoints:
+		if pointsPerVote <= 0 {
+			return stacktrace.Propagate(fmt.Errorf("%w: the points cost per vote must be positive", ErrInvalidPoll), "")
+		}
+	default:
+		return stacktrace.Propagate(fmt.Errorf("%w: unknown voting mode", ErrInvalidPoll), "")
+	}
+	return nil
+}
+
+// CreatePoll creates a chat message by the given user with a poll attachment, in the chat channel with the given ID.
+// The empty string identifies the main channel. The result of the poll is posted as a system message once it closes
+func (c *Manager) CreatePoll(ctxCtx context.Context, creator auth.User, channelID, question string, options []string,
+	duration time.Duration, votingMode types.ChatPollVotingMode, pointsPerVote int) (*chat.Message, *types.ChatPoll, error) {
+	question = strings.TrimSpace(question)
+	for i := range options {
+		options[i] = strings.TrimSpace(options[i])
+	}
+	err := validatePoll(question, options, duration, votingMode, pointsPerVote)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	channel, err := c.Channel(ctxCtx, creator, channelID)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	now := time.Now()
+	poll := &types.ChatPoll{
+		ID:            uuid.NewV4().String(),
+		CreatedAt:     now,
+		CreatedBy:     creator.Address(),
+		Channel:       channel.ID,
+		Question:      question,
+		Options:       options,
+		VotingMode:    votingMode,
+		PointsPerVote: pointsPerVote,
+		ClosesAt:      now.Add(duration),
+	}
+	err = poll.Update(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	message, err := c.CreateMessage(ctx, creator, channel.ID, "", nil, []chat.MessageAttachmentStorage{
+		&MessageAttachmentPollStorage{PollID: poll.ID},
+	})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	messageID := message.ID.Int64()
+	poll.MessageID = &messageID
+	err = poll.Update(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	go c.statsClient.Count("chat_poll_created", 1)
+	return message, poll, nil
+}
+
+// VoteInPoll casts votes on an option of a poll. In single-vote polls, votes must be 1 and each user can only vote
+// once. In points polls, users can vote multiple times, spending the points cost of the poll on each vote
+func (c *Manager) VoteInPoll(ctxCtx context.Context, voter auth.User, pollID string, optionIndex, votes int) error {
+	err := c.checkNotMuted(ctxCtx, voter)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	banned, err := c.moderationStore.LoadUserBannedFromChat(ctxCtx, voter.Address(), authinterceptor.RemoteAddressFromContext(ctxCtx))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if banned {
+		// like with messages by banned users, pretend everything went fine
+		return nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	polls, err := types.GetChatPollsWithIDs(ctx, []string{pollID})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	poll, ok := polls[pollID]
+	if !ok || poll.MessageID == nil {
+		return stacktrace.Propagate(ErrPollNotFound, "")
+	}
+	canView, err := c.canViewMessagesInChannel(ctx, voter, poll.Channel)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if !canView {
+		return stacktrace.Propagate(ErrPollNotFound, "")
+	}
+	if poll.Closed || !time.Now().Before(poll.ClosesAt) {
+		return stacktrace.Propagate(ErrPollClosed, "")
+	}
+	if optionIndex < 0 || optionIndex >= len(poll.Options) {
+		return stacktrace.Propagate(ErrInvalidPollVote, "")
+	}
+
+	// concurrent votes by the same user are serialized on their existing votes. Inserting a first vote is not covered
+	// by the lock, but the unique index on single-vote polls and the additive upsert keep the tally consistent
+	previousVotes, err := types.LockChatPollVotesOfVoter(ctx, poll.ID, voter.Address())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	switch poll.VotingMode {
+	case types.ChatPollVotingModeSingle:
+		if votes != 1 {
+			return stacktrace.Propagate(ErrInvalidPollVote, "")
+		}
+		if len(previousVotes) > 0 {
+			return stacktrace.Propagate(ErrAlreadyVotedInPoll, "")
+		}
+	case types.ChatPollVotingModePoints:
+		if votes < 1 || votes > maxPollVotesPerPurchase {
+			return stacktrace.Propagate(ErrInvalidPollVote, "")
+		}
+		_, err = c.pointsManager.CreateTransaction(ctx, voter, types.PointsTxTypeChatPollVote, -votes*poll.PointsPerVote,
+			pointsmanager.TxExtraField{
+				Key:   "poll",
+				Value: poll.ID,
+			})
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	added, err := types.AddChatPollVotes(ctx, &types.ChatPollVote{
+		PollID:      poll.ID,
+		Voter:       voter.Address(),
+		OptionIndex: optionIndex,
+		Votes:       votes,
+		UpdatedAt:   time.Now(),
+		SingleVote:  poll.VotingMode == types.ChatPollVotingModeSingle,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if !added {
+		return stacktrace.Propagate(ErrAlreadyVotedInPoll, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	c.notifyPollUpdated(ctxCtx, poll.ID)
+	go c.statsClient.Count("chat_poll_voted", 1)
+	return nil
+}
+
+func (c *Manager) notifyPollUpdated(ctxCtx context.Context, pollID string) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		c.log.Println(stacktrace.Propagate(err, ""))
+		return
+	}
+	defer ctx.Commit() // read-only tx
+
+	view, err := c.loadPollView(ctx, pollID)
+	if err != nil {
+		c.log.Println(stacktrace.Propagate(err, "failed to load updated poll"))
+		return
+	}
+	if view.Poll.MessageID == nil {
+		return
+	}
+	c.pollUpdated.Notify(PollUpdatedEventArgs{
+		MessageID: snowflake.ParseInt64(*view.Poll.MessageID),
+		Channel:   view.Poll.Channel,
+		Poll:      view,
+	}, false)
+}
+
+// PollClosingWorker periodically closes the polls whose closing time has passed, posting their results to chat
+func (c *Manager) PollClosingWorker(ctx context.Context) {
+	t := time.NewTicker(5 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			err := c.closeDuePolls(ctx)
+			if err != nil {
+				c.log.Println(stacktrace.Propagate(err, "failed to close due polls"))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Manager) closeDuePolls(ctxCtx context.Context) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	polls, err := types.GetChatPollsToClose(ctx, time.Now())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if len(polls) == 0 {
+		return nil
+	}
+	for _, poll := range polls {
+		poll.Closed = true
+		err = poll.Update(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	for _, poll := range polls {
+		err = c.postPollResult(ctxCtx, poll)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		c.notifyPollUpdated(ctxCtx, poll.ID)
+	}
+	return nil
+}
+
+func (c *Manager) postPollResult(ctxCtx context.Context, poll *types.ChatPoll) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	tally, err := types.GetChatPollTally(ctx, poll.ID, len(poll.Options))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	_, err = c.CreateSystemMessageInChannel(ctx.WithoutTx(), poll.Channel, pollResultMessage(poll, tally))
+	return stacktrace.Propagate(err, "")
+}
+
+func pluralizeVotes(votes int) string {
+	if votes == 1 {
+		return "1 vote"
+	}
+	return fmt.Sprintf("%d votes", votes)
+}
+
+func pollResultMessage(poll *types.ChatPoll, tally []int) string {
+	total := 0
+	maxVotes := 0
+	for _, votes := range tally {
+		total += votes
+		if votes > maxVotes {
+			maxVotes = votes
+		}
+	}
+	if total == 0 {
+		return fmt.Sprintf("_Poll \"%s\" closed without any votes._", poll.Question)
+	}
+
+	winners := []string{}
+	for i, votes := range tally {
+		if votes == maxVotes {
+			winners = append(winners, fmt.Sprintf("\"%s\"", poll.Options[i]))
+		}
+	}
+	if len(winners) > 1 {
+		return fmt.Sprintf("_Poll \"%s\" closed in a tie between %s, with %s each._",
+			poll.Question, strings.Join(winners, ", "), pluralizeVotes(maxVotes))
+	}
+	return fmt.Sprintf("_Poll \"%s\" closed. The winning option is %s, with %s (%d%% of %s)._",
+		poll.Question, winners[0], pluralizeVotes(maxVotes), maxVotes*100/total, pluralizeVotes(total))
+}
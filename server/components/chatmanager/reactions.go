@@ -0,0 +1,258 @@
+package chatmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MaxDistinctReactionsPerMessage is the maximum number of different reactions a message can have
+const MaxDistinctReactionsPerMessage = 20
+
+// ErrInvalidReaction is returned when a reaction is neither an emoji nor a chat emote
+var ErrInvalidReaction = errors.New("invalid reaction")
+
+// ErrTooManyReactions is returned when a new reaction can't be added because the message already has
+// MaxDistinctReactionsPerMessage different reactions
+var ErrTooManyReactions = errors.New("message has too many different reactions")
+
+// reactionEmoteRegexp matches reactions that refer to chat emotes, with or without shortcode
+var reactionEmoteRegexp = regexp.MustCompile(`^<([ae])(?::[a-zA-Z0-9_]+){0,1}:([0-9]{1,20})>$`)
+
+// AddReaction adds a reaction by the given user to the message with the given ID.
+// The reaction must be either a Unicode emoji or a chat emote tag
+func (c *Manager) AddReaction(ctxCtx context.Context, user auth.User, messageID snowflake.ID, reaction string) error {
+	return stacktrace.Propagate(c.updateReaction(ctxCtx, user, messageID, reaction, true), "")
+}
+
+// RemoveReaction removes a reaction by the given user from the message with the given ID
+func (c *Manager) RemoveReaction(ctxCtx context.Context, user auth.User, messageID snowflake.ID, reaction string) error {
+	return stacktrace.Propagate(c.updateReaction(ctxCtx, user, messageID, reaction, false), "")
+}
+
+func (c *Manager) updateReaction(ctxCtx context.Context, user auth.User, messageID snowflake.ID, reaction string, add bool) error {
+	if !c.enabled {
+		return stacktrace.NewError("chat currently disabled")
+	}
+
+	if user.ApplicationID() == "" {
+		_, _, _, ok, err := c.reactionRateLimiter.Take(ctxCtx, user.Address())
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if !ok {
+			return stacktrace.NewError("rate limit reached")
+		}
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	// for removals, let users remove reactions with emotes that have meanwhile become unavailable
+	reaction, err = c.processReactionForStorage(ctx, user, reaction, add)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	message, err := c.store.LoadMessage(ctx, messageID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if message.Shadowbanned && (message.Author == nil || message.Author.Address() != user.Address()) {
+		// users who can't see the message should not be able to find out that it exists
+		return stacktrace.Propagate(chat.ErrChatMessageNotFound, "")
+	}
+	canView, err := c.canViewMessagesInChannel(ctx, user, message.Channel)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if !canView {
+		return stacktrace.Propagate(chat.ErrChatMessageNotFound, "")
+	}
+
+	banned, err := c.moderationStore.LoadUserBannedFromChat(ctx, user.Address(), authinterceptor.RemoteAddressFromContext(ctx))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if banned {
+		// pretend that everything went fine
+		return nil
+	}
+
+	err = c.checkNotMuted(ctx, user)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	reactions, err := c.store.LoadReactions(ctx, []snowflake.ID{messageID}, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	count := 0
+	for _, r := range reactions[messageID] {
+		if r.Reaction == reaction {
+			count = r.Count
+		}
+	}
+
+	var changed bool
+	if add {
+		if count == 0 && len(reactions[messageID]) >= MaxDistinctReactionsPerMessage {
+			return stacktrace.Propagate(ErrTooManyReactions, "")
+		}
+		changed, err = c.store.AddReaction(ctx, messageID, user, reaction)
+		if changed {
+			count++
+		}
+	} else {
+		changed, err = c.store.RemoveReaction(ctx, messageID, user, reaction)
+		if changed {
+			count--
+		}
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	err = ctx.Commit()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	if changed {
+		c.messageReactionUpdated.Notify(MessageReactionUpdatedEventArgs{
+			MessageID:    messageID,
+			Channel:      message.Channel,
+			Shadowbanned: message.Shadowbanned,
+			Reactor:      user,
+			Reaction:     c.processReactionForLoading(ctxCtx, reaction),
+			Added:        add,
+			Count:        count,
+		}, false)
+		go c.statsClient.Count("chat_message_reaction_updated", 1)
+	}
+	return nil
+}
+
+// processReactionForStorage validates a reaction and normalizes it into the form in which it is stored.
+// Like in messages, the shortcodes of emotes are not stored
+func (c *Manager) processReactionForStorage(ctx context.Context, user auth.User, reaction string, checkAvailability bool) (string, error) {
+	if m := reactionEmoteRegexp.FindStringSubmatch(reaction); m != nil {
+		id, err := strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			return "", stacktrace.Propagate(ErrInvalidReaction, "")
+		}
+		emote, found := c.emoteCache.EmoteByID(ctx, snowflake.ID(id))
+		if !found || (emote.Animated && m[1] != "a") {
+			return "", stacktrace.Propagate(ErrInvalidReaction, "")
+		}
+		if checkAvailability {
+			if !emote.AvailableForNewMessages {
+				return "", stacktrace.Propagate(ErrInvalidReaction, "")
+			}
+			if emote.RequiresSubscription && user.ApplicationID() == "" {
+				currentlySubscribed, err := c.pointsManager.IsUserCurrentlySubscribed(ctx, user)
+				if err != nil {
+					return "", stacktrace.Propagate(err, "")
+				}
+				if !currentlySubscribed {
+					return "", stacktrace.NewError("user is not allowed to use this emote")
+				}
+			}
+		}
+		return fmt.Sprintf("<%s:%d>", m[1], emote.ID), nil
+	}
+
+	if !isEmoji(reaction) {
+		return "", stacktrace.Propagate(ErrInvalidReaction, "")
+	}
+	return reaction, nil
+}
+
+// processReactionForLoading adds the current shortcode to reactions that refer to chat emotes.
+// Returns an empty string if the reaction refers to an emote that no longer exists
+func (c *Manager) processReactionForLoading(ctx context.Context, reaction string) string {
+	m := reactionEmoteRegexp.FindStringSubmatch(reaction)
+	if m == nil {
+		return reaction
+	}
+	id, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return ""
+	}
+	emote, found := c.emoteCache.EmoteByID(ctx, snowflake.ID(id))
+	if !found {
+		return ""
+	}
+	return fmt.Sprintf("<%s:%s:%d>", m[1], emote.Shortcode, emote.ID)
+}
+
+// loadReactionsForMessages sets the Reactions field of each message, from the perspective of the viewer (which may
+// be nil)
+func (c *Manager) loadReactionsForMessages(ctx context.Context, viewer auth.User, messages []*chat.Message) error {
+	ids := make([]snowflake.ID, len(messages))
+	for i := range messages {
+		ids[i] = messages[i].ID
+	}
+	reactions, err := c.store.LoadReactions(ctx, ids, viewer)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	for _, message := range messages {
+		message.Reactions = nil
+		for _, r := range reactions[message.ID] {
+			r.Reaction = c.processReactionForLoading(ctx, r.Reaction)
+			if r.Reaction != "" {
+				message.Reactions = append(message.Reactions, r)
+			}
+		}
+	}
+	return nil
+}
+
+// isEmoji returns whether s is short and consists exclusively of emoji, including those made of multiple code points
+// (modifiers, joiners, variation selectors, flags and keycaps)
+func isEmoji(s string) bool {
+	if s == "" || len(s) > 64 || utf8.RuneCountInString(s) > 16 {
+		return false
+	}
+	hasSymbol, hasKeycap := false, false
+	for _, r := range s {
+		if r == '\u20e3' {
+			hasKeycap = true
+		}
+	}
+	for _, r := range s {
+		switch {
+		case r == utf8.RuneError:
+			return false
+		case unicode.Is(unicode.So, r):
+			hasSymbol = true
+		case hasKeycap && (r == '#' || r == '*' || (r >= '0' && r <= '9')):
+			hasSymbol = true
+		case r == '\u200d' || r == '\u20e3' || (r >= '\ufe00' && r <= '\ufe0f'):
+			// zero width joiner, keycap and variation selectors
+		case r >= '\U0001f3fb' && r <= '\U0001f3ff':
+			// skin tone modifiers
+		case r >= '\U000e0020' && r <= '\U000e007f':
+			// tags, used in subdivision flags
+		default:
+			return false
+		}
+	}
+	return hasSymbol
+}
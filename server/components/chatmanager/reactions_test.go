@@ -0,0 +1,34 @@
+package chatmanager
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEmoji(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]bool{
+		"😀":     true,
+		"👍🏽":    true,
+		"❤️":    true,
+		"👨‍👩‍👧": true,
+		"🇵🇹":    true,
+		"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f": true,
+		"1️⃣":                   true,
+		"#️⃣":                   true,
+		"😀😀":                    true,
+		"":                      false,
+		"a":                     false,
+		"1":                     false,
+		"😀a":                    false,
+		"\u200d":                false,
+		"\ufe0f":                false,
+		"\xff":                  false,
+		strings.Repeat("😀", 17): false,
+	}
+	for input, expected := range testCases {
+		require.Equal(t, expected, isEmoji(input), input)
+	}
+}
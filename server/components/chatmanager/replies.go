@@ -0,0 +1,102 @@
+package chatmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+)
+
+// MaxThreadReplies is the maximum number of replies loaded for a thread
+const MaxThreadReplies = 500
+
+// unreadRepliesMaxAge is how far back we look when counting unread replies, so that users who have never marked
+// their replies as read aren't greeted with replies from long-gone conversations
+const unreadRepliesMaxAge = 7 * 24 * time.Hour
+
+// UnreadRepliesUpdatedEventArgs contains the values for the UnreadRepliesUpdated event
+type UnreadRepliesUpdatedEventArgs struct {
+	// Reply is the new reply that caused the update, nil if the replies were marked as read
+	Reply                  *chat.Message
+	ProtobufRepresentation *proto.ChatMessage
+	UnreadCount            int
+}
+
+// LoadThread loads the message at the root of the thread the message with the given ID belongs to, along with its
+// replies, as seen by viewer (which may be nil)
+func (c *Manager) LoadThread(ctx context.Context, viewer auth.User, id snowflake.ID) (*chat.Message, []*chat.Message, error) {
+	message, err := c.store.LoadMessage(ctx, id)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	if message.Reference != nil {
+		// replies to replies don't reference the intermediate message's reference, so threads are one level deep
+		message, err = c.store.LoadMessage(ctx, message.Reference.ID)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "")
+		}
+	}
+	if message.Shadowbanned && (viewer == nil || message.Author == nil || message.Author.Address() != viewer.Address()) {
+		return nil, nil, stacktrace.Propagate(chat.ErrChatMessageNotFound, "")
+	}
+	canView, err := c.canViewMessagesInChannel(ctx, viewer, message.Channel)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	if !canView {
+		return nil, nil, stacktrace.Propagate(chat.ErrChatMessageNotFound, "")
+	}
+
+	replies, err := c.store.LoadReplies(ctx, viewer, message.ID, MaxThreadReplies)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+
+	all := append([]*chat.Message{message}, replies...)
+	err = c.processEmotesForLoadingMessages(ctx, all)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	err = c.loadReactionsForMessages(ctx, viewer, all)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "")
+	}
+	return message, replies, nil
+}
+
+// UnreadRepliesCount returns the number of replies to messages of the given user that the user has not yet read
+func (c *Manager) UnreadRepliesCount(ctx context.Context, user auth.User) (int, error) {
+	count, err := c.store.CountUnreadReplies(ctx, user, time.Now().Add(-unreadRepliesMaxAge))
+	return count, stacktrace.Propagate(err, "")
+}
+
+// MarkRepliesAsRead marks all replies to messages of the given user as read
+func (c *Manager) MarkRepliesAsRead(ctx context.Context, user auth.User) error {
+	err := c.store.MarkRepliesAsRead(ctx, user, time.Now())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	c.unreadRepliesUpdated.Notify(user.Address(), UnreadRepliesUpdatedEventArgs{}, false)
+	return nil
+}
+
+func (c *Manager) notifyReplyReceived(ctx context.Context, m *chat.Message, protoMessage *proto.ChatMessage) error {
+	if m.Shadowbanned || m.Reference == nil || m.Reference.Author == nil || m.Reference.Author.IsUnknown() ||
+		(m.Author != nil && m.Author.Address() == m.Reference.Author.Address()) {
+		return nil
+	}
+	count, err := c.UnreadRepliesCount(ctx, m.Reference.Author)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	c.unreadRepliesUpdated.Notify(m.Reference.Author.Address(), UnreadRepliesUpdatedEventArgs{
+		Reply:                  m,
+		ProtobufRepresentation: protoMessage,
+		UnreadCount:            count,
+	}, false)
+	return nil
+}
@@ -1,51 +1,64 @@
 package ipreputation
 
 import (
-	"bytes"
 	"context"
-	"io/ioutil"
+	"fmt"
 	"log"
-	"net/http"
-	"regexp"
-	"strconv"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/jamesog/iptoasn"
 	"github.com/palantir/stacktrace"
+	"github.com/patrickmn/go-cache"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
 )
 
+// unratedAddressRecheckInterval is for how long addresses whose reputation could not be determined are left alone
+// before being checked again
+const unratedAddressRecheckInterval = 10 * time.Minute
+
+// unknownReputation is the bad actor confidence assumed for addresses whose reputation could not be determined
+const unknownReputation = 0.5
+
+type addressReputation struct {
+	badActorConfidence float32
+	rated              bool
+}
+
 // Checker checks the reputation of IP addresses
 type Checker struct {
-	log            *log.Logger
-	reputation     map[string]float32
-	reputationLock sync.RWMutex
-	httpClient     http.Client
+	log          *log.Logger
+	reputation   *cache.Cache[string, addressReputation]
+	asnByAddress *cache.Cache[string, int]
 
 	badASNs     map[int]struct{}
 	badASNsLock sync.RWMutex
 
-	endpoint string
+	providers []WeightedProvider
 
 	checkQueue chan string
 }
 
-// NewChecker initializes and returns a new Checker
-func NewChecker(ctx context.Context, log *log.Logger, endpoint string) *Checker {
+// NewChecker initializes and returns a new Checker which combines the results of the given providers using a weighted
+// average. Providers with zero weight are only used for cross-checking the results of the others, and for
+// determining AS numbers.
+// Results are kept for cacheExpiration, after which addresses are checked again. If cacheExpiration is zero, results
+// are kept indefinitely
+func NewChecker(ctx context.Context, log *log.Logger, providers []WeightedProvider, cacheExpiration time.Duration) *Checker {
+	if cacheExpiration <= 0 {
+		cacheExpiration = cache.NoExpiration
+	}
 	c := &Checker{
-		log:        log,
-		reputation: make(map[string]float32),
-		checkQueue: make(chan string, 10000),
-		httpClient: http.Client{
-			Timeout: 10 * time.Second,
-		},
+		log:          log,
+		reputation:   cache.New[string, addressReputation](cacheExpiration, 10*time.Minute),
+		asnByAddress: cache.New[string, int](cacheExpiration, 10*time.Minute),
+		checkQueue:   make(chan string, 10000),
 
 		badASNs: make(map[int]struct{}),
 
-		endpoint: endpoint,
+		providers: providers,
 	}
 
 	c.updateBadASNsFromDatabase(ctx)
@@ -54,20 +67,37 @@ func NewChecker(ctx context.Context, log *log.Logger, endpoint string) *Checker
 }
 
 func (c *Checker) CanReceiveRewards(remoteAddress string) bool {
-	c.reputationLock.RLock()
-	defer c.reputationLock.RUnlock()
-	badActorConfidence, present := c.reputation[remoteAddress]
+	reputation, present := c.reputation.Get(remoteAddress)
 	if !present {
 		c.EnqueueAddressForChecking(remoteAddress)
 		return false // do not be generous and don't reward until they're checked
 	}
-	return badActorConfidence < 0.95
+	return reputation.badActorConfidence < 0.95
+}
+
+// AddressReputation returns the confidence, between 0 and 1, that the given remote address belongs to a bad actor.
+// The second return value is false if the address was checked but its reputation could not be determined, e.g.
+// because the providers failed, in which case the confidence is 0.5.
+// The last return value is false if the address has not been checked yet
+func (c *Checker) AddressReputation(remoteAddress string) (float32, bool, bool) {
+	reputation, present := c.reputation.Get(remoteAddress)
+	return reputation.badActorConfidence, reputation.rated, present
+}
+
+// AddressASN returns the AS number the given remote address was found to belong to when it was checked, and whether
+// that AS number is currently disallowed. The last return value is false if the AS number is not known
+func (c *Checker) AddressASN(remoteAddress string) (int, bool, bool) {
+	asn, present := c.asnByAddress.Get(remoteAddress)
+	if !present {
+		return 0, false, false
+	}
+
+	isBad, _, _ := c.isBadASN("", asn)
+	return asn, isBad, true
 }
 
 func (c *Checker) EnqueueAddressForChecking(remoteAddress string) {
-	c.reputationLock.RLock()
-	defer c.reputationLock.RUnlock()
-	if _, present := c.reputation[remoteAddress]; present || remoteAddress == "" {
+	if _, present := c.reputation.Get(remoteAddress); present || remoteAddress == "" {
 		return
 	}
 	// make this function never block by simply dropping the request if the queue is full
@@ -121,12 +151,7 @@ addLoop:
 	for {
 		select {
 		case addressToCheck := <-c.checkQueue:
-			addressAlreadyChecked := false
-			func() {
-				c.reputationLock.RLock()
-				defer c.reputationLock.RUnlock()
-				_, addressAlreadyChecked = c.reputation[addressToCheck]
-			}()
+			_, addressAlreadyChecked := c.reputation.Get(addressToCheck)
 			if _, present := goingToCheck[addressToCheck]; !present && !addressAlreadyChecked {
 				goingToCheck[addressToCheck] = struct{}{}
 				addressesToCheck = append(addressesToCheck, addressToCheck)
@@ -144,89 +169,113 @@ addLoop:
 		if err != nil {
 			c.log.Println("error checking IP info:", stacktrace.Propagate(err, ""))
 			for _, ip := range addressesToCheck {
-				c.setAddressReputation(ip, 0.5)
+				c.setAddressReputation(ip, unknownReputation, false)
 			}
 		}
 	}
 }
 
-func (c *Checker) setAddressReputation(address string, reputation float32) {
-	c.reputationLock.Lock()
-	defer c.reputationLock.Unlock()
-	c.reputation[address] = reputation
+func (c *Checker) setAddressReputation(address string, badActorConfidence float32, rated bool) {
+	reputation := addressReputation{
+		badActorConfidence: badActorConfidence,
+		rated:              rated,
+	}
+	if !rated {
+		// check again soon, as the failure may have been temporary
+		c.reputation.Set(address, reputation, unratedAddressRecheckInterval)
+		return
+	}
+	c.reputation.SetDefault(address, reputation)
 }
 
-var asRegexp = regexp.MustCompile(`AS([0-9]+)\s.*`)
+func (c *Checker) setAddressASN(address string, asn int) {
+	c.asnByAddress.SetDefault(address, asn)
+}
 
 func (c *Checker) checkIPs(ctx context.Context, addressesToCheck []string) error {
-	requestBody, err := sonic.Marshal(addressesToCheck)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+	results := make([]map[string]ProviderResult, len(c.providers))
+	failed := 0
+	for i, p := range c.providers {
+		var err error
+		results[i], err = p.Provider.CheckAddresses(ctx, addressesToCheck)
+		if err != nil {
+			c.log.Printf("IP reputation provider %s failed: %v", p.Provider.Name(), stacktrace.Propagate(err, ""))
+			failed++
+		}
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+	if failed == len(c.providers) {
+		return stacktrace.NewError("all IP reputation providers failed")
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
-	}
-	if resp.StatusCode != http.StatusOK {
-		return stacktrace.NewError("non-200 status code when checking IP reputation")
+	for _, address := range addressesToCheck {
+		badActorConfidence, rated := c.combineResults(address, results)
+		c.setAddressReputation(address, badActorConfidence, rated)
 	}
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		c.log.Println("error reading response body:", stacktrace.Propagate(err, ""))
-		return stacktrace.Propagate(err, "")
+	return nil
+}
+
+// combineResults returns the reputation of address given the results of each provider, in the same order as
+// c.providers. The second return value is false if no provider rated the address, in which case the reputation is 0.5
+func (c *Checker) combineResults(address string, results []map[string]ProviderResult) (float32, bool) {
+	var definitive *ProviderResult
+	var weightedSum, totalWeight float32
+	var lowest, highest float32 = 1, 0
+	ratings := []string{}
+	asn := 0
+	for i, p := range c.providers {
+		r, ok := results[i][address]
+		if !ok {
+			continue
+		}
+		if asn == 0 && r.ASN != 0 {
+			asn = r.ASN
+		}
+		if !r.Rated {
+			continue
+		}
+		if r.Definitive && definitive == nil {
+			definitive = &r
+		}
+		weightedSum += r.BadActorConfidence * p.Weight
+		totalWeight += p.Weight
+		lowest = min(lowest, r.BadActorConfidence)
+		highest = max(highest, r.BadActorConfidence)
+		ratings = append(ratings, fmt.Sprintf("%s: %.2f", p.Provider.Name(), r.BadActorConfidence))
 	}
 
-	type result struct {
-		Status  string `json:"status"`
-		AS      string `json:"as"`
-		Proxy   bool   `json:"proxy"`
-		Hosting bool   `json:"hosting"`
-		Query   string `json:"query"`
+	if highest-lowest >= 0.5 {
+		c.log.Printf("IP reputation providers disagree on IP %v (%s)", address, strings.Join(ratings, ", "))
 	}
 
-	response := []result{}
+	if asn != 0 {
+		c.setAddressASN(address, asn)
+	}
 
-	err = sonic.Unmarshal(body, &response)
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+	if definitive != nil {
+		c.log.Printf("IP %v has definitive reputation %.2f", address, definitive.BadActorConfidence)
+		return definitive.BadActorConfidence, true
 	}
 
-	for _, result := range response {
-		if result.Status != "success" {
-			c.log.Printf("Could not check reputation for IP %v due to non-success status", result.Query)
-			c.setAddressReputation(result.Query, 0.5)
-			continue
-		}
-		asn, err := extractASN(result.AS)
-		if err != nil {
-			c.log.Printf("Could not determine AS number for IP %v: %v", result.Query, err)
-		} else {
-			isBadASN, _, err := c.isBadASN("", asn)
-			if err != nil {
-				return stacktrace.Propagate(err, "")
-			}
-			if isBadASN {
-				c.log.Printf("IP %v is from disallowed ASN %d", result.Query, asn)
-				c.setAddressReputation(result.Query, 1)
-				continue
-			}
+	if asn != 0 {
+		isBadASN, _, err := c.isBadASN("", asn)
+		if err == nil && isBadASN {
+			c.log.Printf("IP %v is from disallowed ASN %d", address, asn)
+			return 1, true
 		}
+	}
 
-		if result.Proxy || result.Hosting {
-			c.log.Printf("IP %v is bad actor", result.Query)
-			c.setAddressReputation(result.Query, 1)
-			continue
-		}
-		c.log.Printf("IP %v seems good", result.Query)
-		c.setAddressReputation(result.Query, 0)
+	if totalWeight == 0 {
+		c.log.Printf("Could not check reputation for IP %v", address)
+		return unknownReputation, false
 	}
-	return nil
+
+	reputation := weightedSum / totalWeight
+	if reputation >= 0.95 {
+		c.log.Printf("IP %v is bad actor", address)
+	} else {
+		c.log.Printf("IP %v seems good", address)
+	}
+	return reputation, true
 }
 
 func (c *Checker) updateBadASNsFromDatabase(ctxCtx context.Context) {
@@ -254,15 +303,3 @@ func (c *Checker) updateBadASNsFromDatabase(ctxCtx context.Context) {
 	defer c.badASNsLock.Unlock()
 	c.badASNs = badASNsMap
 }
-
-func extractASN(as string) (int, error) {
-	matches := asRegexp.FindStringSubmatch(as)
-	if len(matches) >= 2 {
-		asn, err := strconv.Atoi(matches[1])
-		if err != nil {
-			return 0, stacktrace.Propagate(err, "")
-		}
-		return asn, nil
-	}
-	return 0, stacktrace.NewError("invalid AS string")
-}
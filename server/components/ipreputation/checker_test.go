@@ -0,0 +1,110 @@
+package ipreputation
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+)
+
+type namedProvider string
+
+func (p namedProvider) Name() string {
+	return string(p)
+}
+
+func (p namedProvider) CheckAddresses(ctx context.Context, addresses []string) (map[string]ProviderResult, error) {
+	return nil, nil
+}
+
+func TestCombineResults(t *testing.T) {
+	c := &Checker{
+		log:          log.New(io.Discard, "", 0),
+		asnByAddress: cache.New[string, int](time.Hour, time.Hour),
+		badASNs:      map[int]struct{}{666: {}},
+		providers: []WeightedProvider{
+			{Provider: namedProvider("a"), Weight: 3},
+			{Provider: namedProvider("b"), Weight: 1},
+			{Provider: namedProvider("asn_only"), Weight: 0},
+		},
+	}
+
+	type testCase struct {
+		results            []map[string]ProviderResult
+		expectedReputation float32
+		expectedRated      bool
+		expectedASN        int
+	}
+	// maps address to test case
+	testCases := map[string]testCase{
+		"weighted_average": {
+			results: []map[string]ProviderResult{
+				{"weighted_average": {Rated: true, BadActorConfidence: 0}},
+				{"weighted_average": {Rated: true, BadActorConfidence: 1}},
+				{},
+			},
+			expectedReputation: 0.25,
+			expectedRated:      true,
+		},
+		"unrated_results_ignored": {
+			results: []map[string]ProviderResult{
+				{"unrated_results_ignored": {Rated: false, BadActorConfidence: 1}},
+				{"unrated_results_ignored": {Rated: true, BadActorConfidence: 0.5}},
+				{},
+			},
+			expectedReputation: 0.5,
+			expectedRated:      true,
+		},
+		"definitive": {
+			results: []map[string]ProviderResult{
+				{"definitive": {Rated: true, BadActorConfidence: 0}},
+				{"definitive": {Rated: true, BadActorConfidence: 1, Definitive: true}},
+				{},
+			},
+			expectedReputation: 1,
+			expectedRated:      true,
+		},
+		"bad_asn": {
+			results: []map[string]ProviderResult{
+				{"bad_asn": {Rated: true, BadActorConfidence: 0}},
+				{},
+				{"bad_asn": {ASN: 666}},
+			},
+			expectedReputation: 1,
+			expectedRated:      true,
+			expectedASN:        666,
+		},
+		"good_asn": {
+			results: []map[string]ProviderResult{
+				{"good_asn": {Rated: true, BadActorConfidence: 0.1}},
+				{},
+				{"good_asn": {ASN: 1234}},
+			},
+			expectedReputation: 0.1,
+			expectedRated:      true,
+			expectedASN:        1234,
+		},
+		"not_rated": {
+			results: []map[string]ProviderResult{
+				{},
+				nil,
+				{"not_rated": {ASN: 1234}},
+			},
+			expectedReputation: unknownReputation,
+			expectedRated:      false,
+			expectedASN:        1234,
+		},
+	}
+	for address, tc := range testCases {
+		reputation, rated := c.combineResults(address, tc.results)
+		require.InDelta(t, tc.expectedReputation, reputation, 1e-6, address)
+		require.Equal(t, tc.expectedRated, rated, address)
+		asn, present := c.asnByAddress.Get(address)
+		require.Equal(t, tc.expectedASN != 0, present, address)
+		require.Equal(t, tc.expectedASN, asn, address)
+	}
+}
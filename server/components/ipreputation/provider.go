@@ -0,0 +1,59 @@
+package ipreputation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+)
+
+// Provider determines the reputation of IP addresses
+type Provider interface {
+	// Name returns the identifier of the provider, used in logs and to configure its weight
+	Name() string
+	// CheckAddresses returns the results for the given addresses.
+	// Addresses missing from the returned map could not be checked by the provider
+	CheckAddresses(ctx context.Context, addresses []string) (map[string]ProviderResult, error)
+}
+
+// ProviderResult is the result of checking the reputation of an IP address with a Provider
+type ProviderResult struct {
+	// Rated is false when the provider has no opinion on whether the address belongs to a bad actor,
+	// e.g. because it only knows the AS number of the address
+	Rated bool
+	// BadActorConfidence is the confidence, between 0 and 1, that the address belongs to a bad actor
+	BadActorConfidence float32
+	// Definitive results override those of all other providers, as well as the disallowed ASNs
+	Definitive bool
+	// ASN is the AS number the address belongs to, or 0 if not known
+	ASN int
+}
+
+// WeightedProvider is a Provider along with how much its results count when combined with those of other providers
+type WeightedProvider struct {
+	Provider Provider
+	Weight   float32
+}
+
+// ParseProviderWeights parses weights in the format "provider1=weight1,provider2=weight2"
+func ParseProviderWeights(s string) (map[string]float32, error) {
+	weights := make(map[string]float32)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weightString, found := strings.Cut(part, "=")
+		if !found {
+			return nil, stacktrace.NewError("missing weight for IP reputation provider %s", part)
+		}
+		name = strings.TrimSpace(name)
+		weight, err := strconv.ParseFloat(strings.TrimSpace(weightString), 32)
+		if err != nil || weight < 0 {
+			return nil, stacktrace.NewError("invalid weight for IP reputation provider %s", name)
+		}
+		weights[name] = float32(weight)
+	}
+	return weights, nil
+}
@@ -0,0 +1,105 @@
+package ipreputation
+
+import (
+	"bufio"
+	"context"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+)
+
+type cidrListEntry struct {
+	prefix netip.Prefix
+	deny   bool
+}
+
+type cidrListProvider struct {
+	entries []cidrListEntry
+}
+
+// NewCIDRListProvider returns a Provider that gives definitive results for the addresses covered by a static list of
+// allowed and denied networks, read from the file at the given path.
+// Each line of the file must be in the format "allow <network>" or "deny <network>", where network is an IP address
+// or a CIDR prefix. Empty lines and lines starting with # are ignored. When an address is covered by multiple
+// networks, the most specific one is used
+func NewCIDRListProvider(path string) (Provider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer f.Close()
+
+	p := &cidrListProvider{}
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || (fields[0] != "allow" && fields[0] != "deny") {
+			return nil, stacktrace.NewError("invalid entry on line %d of CIDR list", lineNumber)
+		}
+		prefix, err := parsePrefix(fields[1])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "invalid network on line %d of CIDR list", lineNumber)
+		}
+		p.entries = append(p.entries, cidrListEntry{
+			prefix: prefix,
+			deny:   fields[0] == "deny",
+		})
+	}
+	return p, stacktrace.Propagate(scanner.Err(), "")
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func (p *cidrListProvider) Name() string {
+	return "cidr_list"
+}
+
+func (p *cidrListProvider) CheckAddresses(ctx context.Context, addresses []string) (map[string]ProviderResult, error) {
+	results := make(map[string]ProviderResult)
+	for _, address := range addresses {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			continue
+		}
+		addr = addr.Unmap()
+
+		var match *cidrListEntry
+		for i := range p.entries {
+			entry := &p.entries[i]
+			if entry.prefix.Contains(addr) && (match == nil || entry.prefix.Bits() > match.prefix.Bits()) {
+				match = entry
+			}
+		}
+		if match == nil {
+			continue
+		}
+
+		r := ProviderResult{
+			Rated:      true,
+			Definitive: true,
+		}
+		if match.deny {
+			r.BadActorConfidence = 1
+		}
+		results[address] = r
+	}
+	return results, nil
+}
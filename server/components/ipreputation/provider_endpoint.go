@@ -0,0 +1,114 @@
+package ipreputation
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+)
+
+type endpointProvider struct {
+	log        *log.Logger
+	endpoint   string
+	httpClient http.Client
+}
+
+// NewEndpointProvider returns a Provider that checks addresses using a remote HTTP endpoint with an API compatible
+// with the ip-api.com batch endpoint. Addresses are considered bad actors if they are proxies or belong to hosting
+// providers
+func NewEndpointProvider(log *log.Logger, endpoint string) Provider {
+	return &endpointProvider{
+		log:      log,
+		endpoint: endpoint,
+		httpClient: http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (p *endpointProvider) Name() string {
+	return "endpoint"
+}
+
+var asRegexp = regexp.MustCompile(`AS([0-9]+)\s.*`)
+
+func (p *endpointProvider) CheckAddresses(ctx context.Context, addresses []string) (map[string]ProviderResult, error) {
+	requestBody, err := sonic.Marshal(addresses)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, stacktrace.NewError("non-200 status code when checking IP reputation")
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	type result struct {
+		Status  string `json:"status"`
+		AS      string `json:"as"`
+		Proxy   bool   `json:"proxy"`
+		Hosting bool   `json:"hosting"`
+		Query   string `json:"query"`
+	}
+
+	response := []result{}
+
+	err = sonic.Unmarshal(body, &response)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	results := make(map[string]ProviderResult, len(response))
+	for _, result := range response {
+		if result.Status != "success" {
+			p.log.Printf("Could not check reputation for IP %v due to non-success status", result.Query)
+			continue
+		}
+		r := ProviderResult{
+			Rated: true,
+		}
+		asn, err := extractASN(result.AS)
+		if err != nil {
+			p.log.Printf("Could not determine AS number for IP %v: %v", result.Query, err)
+		} else {
+			r.ASN = asn
+		}
+		if result.Proxy || result.Hosting {
+			r.BadActorConfidence = 1
+		}
+		results[result.Query] = r
+	}
+	return results, nil
+}
+
+func extractASN(as string) (int, error) {
+	matches := asRegexp.FindStringSubmatch(as)
+	if len(matches) >= 2 {
+		asn, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "")
+		}
+		return asn, nil
+	}
+	return 0, stacktrace.NewError("invalid AS string")
+}
@@ -0,0 +1,81 @@
+package ipreputation
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/palantir/stacktrace"
+)
+
+type mmdbProvider struct {
+	name       string
+	reader     *maxminddb.Reader
+	anonymizer bool
+}
+
+// NewMMDBProvider returns a Provider that checks addresses using a local database in the MaxMind DB format.
+// ASN databases (such as GeoLite2-ASN) only provide the AS number of addresses, while anonymizer databases (such as
+// GeoIP2-Anonymous-IP) rate addresses as bad actors if they belong to VPNs, proxies, Tor exit nodes or hosting providers.
+// The name of the provider is the base name of the database file without its extension, e.g. GeoLite2-ASN for
+// /path/to/GeoLite2-ASN.mmdb
+func NewMMDBProvider(path string) (Provider, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &mmdbProvider{
+		name:       strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+		reader:     reader,
+		anonymizer: strings.Contains(reader.Metadata.DatabaseType, "Anonymous"),
+	}, nil
+}
+
+func (p *mmdbProvider) Name() string {
+	return p.name
+}
+
+type mmdbRecord struct {
+	AutonomousSystemNumber uint `maxminddb:"autonomous_system_number"`
+
+	IsAnonymous        bool `maxminddb:"is_anonymous"`
+	IsAnonymousVPN     bool `maxminddb:"is_anonymous_vpn"`
+	IsHostingProvider  bool `maxminddb:"is_hosting_provider"`
+	IsPublicProxy      bool `maxminddb:"is_public_proxy"`
+	IsResidentialProxy bool `maxminddb:"is_residential_proxy"`
+	IsTorExitNode      bool `maxminddb:"is_tor_exit_node"`
+}
+
+func (p *mmdbProvider) CheckAddresses(ctx context.Context, addresses []string) (map[string]ProviderResult, error) {
+	results := make(map[string]ProviderResult, len(addresses))
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+
+		var record mmdbRecord
+		_, found, err := p.reader.LookupNetwork(ip, &record)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+
+		r := ProviderResult{
+			ASN: int(record.AutonomousSystemNumber),
+		}
+		if p.anonymizer {
+			// anonymizer databases only contain the addresses that are anonymizers
+			r.Rated = true
+			if found && (record.IsAnonymous || record.IsAnonymousVPN || record.IsHostingProvider ||
+				record.IsPublicProxy || record.IsResidentialProxy || record.IsTorExitNode) {
+				r.BadActorConfidence = 1
+			}
+		} else if !found {
+			continue
+		}
+		results[address] = r
+	}
+	return results, nil
+}
@@ -0,0 +1,36 @@
+package ipreputation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tnyim/jungletv/server/components/ipreputation"
+)
+
+func TestParseProviderWeights(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]map[string]float32{
+		"":                               {},
+		" , ":                            {},
+		"endpoint=1":                     {"endpoint": 1},
+		"endpoint=0.5,GeoLite2-ASN=0":    {"endpoint": 0.5, "GeoLite2-ASN": 0},
+		" endpoint = 2 , cidr_list=1.25": {"endpoint": 2, "cidr_list": 1.25},
+		"endpoint=1,endpoint=3":          {"endpoint": 3},
+	}
+	for input, expected := range testCases {
+		weights, err := ipreputation.ParseProviderWeights(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, weights, input)
+	}
+
+	invalidInputs := []string{
+		"endpoint",
+		"endpoint=",
+		"endpoint=-1",
+		"endpoint=one",
+	}
+	for _, input := range invalidInputs {
+		_, err := ipreputation.ParseProviderWeights(input)
+		require.Error(t, err, input)
+	}
+}
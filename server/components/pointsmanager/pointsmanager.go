@@ -186,6 +186,7 @@ var pointsTxAllowedDirectionByType = map[types.PointsTxType]pointsTxDirection{
 	types.PointsTxTypeSkipThresholdIncrease:       pointsTxDirectionDecrease,
 	types.PointsTxTypeConcealedEntryEnqueuing:     pointsTxDirectionDecrease,
 	types.PointsTxTypeApplicationDefined:          pointsTxDirectionIncreaseOrDecrease,
+	types.PointsTxTypeChatPollVote:                pointsTxDirectionDecrease,
 }
 
 // to save on DB storage space, for "uninteresting" transaction types, we collapse consecutive records of the same type
@@ -205,4 +206,5 @@ var pointsTxTypeMandatoryExtraFields = map[types.PointsTxType][]string{
 	types.PointsTxTypeQueueEntryReordering:        {"media", "direction"},
 	types.PointsTxTypeConcealedEntryEnqueuing:     {"media"},
 	types.PointsTxTypeApplicationDefined:          {"application_id", "application_version", "description"},
+	types.PointsTxTypeChatPollVote:                {"poll"},
 }
@@ -6,7 +6,6 @@ import (
 	"math/big"
 	"time"
 
-	"github.com/tnyim/jungletv/server/components/mediaqueue"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/stats"
 )
@@ -14,7 +13,7 @@ import (
 // Pricer manages pricing
 type Pricer struct {
 	log                       *log.Logger
-	mediaQueue                *mediaqueue.MediaQueue
+	mediaQueue                QueueLengthProvider
 	eligibleEstimator         EligibleSpectatorsEstimator
 	statsRegistry             *stats.Registry
 	minimumPricesMultiplier   int
@@ -22,13 +21,18 @@ type Pricer struct {
 	crowdfundedSkipMultiplier int
 }
 
+// QueueLengthProvider provides the length of the media queue, as considered for pricing purposes
+type QueueLengthProvider interface {
+	LengthUpToCursor() int
+}
+
 type EligibleSpectatorsEstimator interface {
 	EstimateEligibleSpectators() (int, bool)
 }
 
 // New returns an initialized pricer
 func New(log *log.Logger,
-	mediaQueue *mediaqueue.MediaQueue,
+	mediaQueue QueueLengthProvider,
 	statsRegistry *stats.Registry) *Pricer {
 	return &Pricer{
 		log:                       log,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/rand"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/palantir/stacktrace"
@@ -88,6 +89,11 @@ func (r *Handler) minDurationBetweenActivityChallengePointsReward(ctx context.Co
 func (r *Handler) produceActivityChallenge(ctx context.Context, spectator *spectator) {
 	hadChallengeStr := ""
 	defer r.log.Println("Produced activity challenge for spectator", spectator.user.Address(), spectator.remoteAddress, hadChallengeStr)
+
+	// the risk assessment may need the account history, which must not be loaded from the database while holding
+	// spectatorsMutex
+	history, historyErr := r.getSpectatorAccountHistory(ctx, spectator.user.Address())
+
 	r.spectatorsMutex.Lock()
 	defer r.spectatorsMutex.Unlock()
 	hadChallenge := spectator.activityChallenge != nil
@@ -101,16 +107,10 @@ func (r *Handler) produceActivityChallenge(ctx context.Context, spectator *spect
 			ID:           uuid.NewV4().String(),
 			ChallengedAt: time.Now(),
 			Types:        []ActivityChallengeType{ActivityChallengeTypeModerating},
-			Tolerance:    2 * time.Minute,
 		}
+		spectator.activityChallenge.Tolerance = r.activityChallengeTolerance(spectator.activityChallenge)
 		r.staffActivityManager.MarkAsActivityChallenged(ctx, spectator.user, spectator.activityChallenge.Tolerance)
 	} else {
-		spectator.activityChallenge = &ActivityChallenge{
-			ID:           uuid.NewV4().String(),
-			ChallengedAt: time.Now(),
-			Types:        []ActivityChallengeType{ActivityChallengeTypeButton},
-			Tolerance:    1 * time.Minute,
-		}
 		hardChallengeInterval := 1 * time.Hour
 		hasReduced, err := r.moderationStore.LoadPaymentAddressHasReducedHardChallengeFrequency(ctx, spectator.user.Address())
 		if err != nil {
@@ -119,17 +119,46 @@ func (r *Handler) produceActivityChallenge(ctx context.Context, spectator *spect
 			hardChallengeInterval = 3 * time.Hour
 		}
 
-		if time.Since(spectator.lastHardChallengeSolvedAt) > hardChallengeInterval {
-			spectator.activityChallenge.Types = append(spectator.activityChallenge.Types, ActivityChallengeTypeSegcha)
-			spectator.activityChallenge.Tolerance = 2 * time.Minute
-		}
-		/*
-			Turnstile challenges temporarily disabled until pass rate issues for mobile users can be investigated
+		// only assess the risk of the spectator if something needs it, and at most once
+		riskScore := sync.OnceValue(func() int {
+			return r.assessSpectatorRiskWithHistory(ctx, spectator, r.countConnectedSpectatorsOnRemoteAddress(spectator.remoteAddress),
+				history, historyErr).Score
+		})
+
+		hardChallengeTypes := r.hardActivityChallengeTypes()
+		selection := r.ActivityChallengeSelectionPolicy().Select(ActivityChallengeSelectionParams{
+			HardChallengeTypes:        hardChallengeTypes,
+			HardChallengeDue:          time.Since(spectator.lastHardChallengeSolvedAt) > hardChallengeInterval,
+			PreviousHardChallengeType: spectator.lastHardChallengeType,
+			RiskScore:                 riskScore,
+		})
 
-			if spectator.lastHardChallengeSolvedAt.IsZero() {
-				spectator.activityChallenge.Types = append(spectator.activityChallenge.Types, ActivityChallengeTypeTurnstile)
+		spectator.activityChallenge = &ActivityChallenge{
+			ID:           uuid.NewV4().String(),
+			ChallengedAt: time.Now(),
+			Difficulty:   selection.Difficulty,
+		}
+		for _, challengeType := range selection.Types {
+			provider, ok := r.activityChallengeProvider(challengeType)
+			if !ok {
+				continue
+			}
+			if preparingProvider, ok := provider.(PreparingActivityChallengeProvider); ok {
+				err := preparingProvider.Prepare(spectator.activityChallenge, riskScore())
+				if err != nil {
+					r.log.Println("Error preparing", challengeType, "activity challenge:", stacktrace.Propagate(err, ""))
+					continue
+				}
+			}
+			spectator.activityChallenge.Types = append(spectator.activityChallenge.Types, challengeType)
+			if slices.Contains(hardChallengeTypes, challengeType) {
+				spectator.lastHardChallengeType = challengeType
 			}
-		*/
+		}
+		if len(spectator.activityChallenge.Types) == 0 {
+			spectator.activityChallenge.Types = []ActivityChallengeType{ActivityChallengeTypeButton}
+		}
+		spectator.activityChallenge.Tolerance = r.activityChallengeTolerance(spectator.activityChallenge)
 	}
 	if hadChallenge || spectator.noToleranceOnNextChallenge {
 		spectator.activityChallenge.Tolerance = 0
@@ -170,19 +199,20 @@ func (r *Handler) SolveActivityChallenge(ctxCtx context.Context, challenge strin
 		legitimateAsOfThisChallenge = true
 	}
 	type checkData struct {
-		checkFn  ChallengeCheckFunction
+		provider CheckedActivityChallengeProvider
 		response string
 	}
 	var checks []checkData
 	cursor := 0
 	for _, challengeType := range spectator.activityChallenge.Types {
-		checkFn, ok := r.challengeCheckers[challengeType]
+		provider, _ := r.activityChallengeProvider(challengeType)
+		checkedProvider, ok := provider.(CheckedActivityChallengeProvider)
 		if !ok {
 			// this challenge type does not need/cannot be checked
 			continue
 		}
 		data := checkData{
-			checkFn: checkFn,
+			provider: checkedProvider,
 		}
 		if cursor < len(checkResponses) {
 			data.response = checkResponses[cursor]
@@ -193,7 +223,7 @@ func (r *Handler) SolveActivityChallenge(ctxCtx context.Context, challenge strin
 
 	captchaValid := true
 	for _, checkData := range checks {
-		thisValid, err := checkData.checkFn(ctxCtx, spectator.activityChallenge, checkData.response)
+		thisValid, err := checkData.provider.Check(ctxCtx, spectator.activityChallenge, checkData.response)
 		if err != nil {
 			r.log.Println("Error verifying activity challenge:", err)
 		}
@@ -207,6 +237,19 @@ func (r *Handler) SolveActivityChallenge(ctxCtx context.Context, challenge strin
 	} else if captchaValid && len(checks) > 0 {
 		spectator.lastHardChallengeSolvedAt = now
 	}
+	if captchaValid {
+		spectator.recentSolveLatencies = append(spectator.recentSolveLatencies, timeUntilChallengeResponse)
+		if len(spectator.recentSolveLatencies) > challengeSolveLatencySamples {
+			spectator.recentSolveLatencies = spectator.recentSolveLatencies[1:]
+		}
+	}
+	spectator.session.recordActivityChallengeResult(captchaValid)
+
+	if legitimateAsOfThisChallenge && timeUntilChallengeResponse <= spectator.activityChallenge.Tolerance {
+		spectator.activityChallengeStreak++
+	} else {
+		spectator.activityChallengeStreak = 0
+	}
 
 	if legitimateAsOfThisChallenge {
 		r.log.Println("Spectator", spectator.user.Address(), spectator.remoteAddress,
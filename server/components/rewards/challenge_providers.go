@@ -0,0 +1,274 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"golang.org/x/exp/slices"
+)
+
+// ErrUnknownActivityChallengeSelectionPolicy is returned when attempting to select an activity challenge selection
+// policy that is not registered
+var ErrUnknownActivityChallengeSelectionPolicy = errors.New("unknown activity challenge selection policy")
+
+// ActivityChallengeProvider provides one type of activity challenge
+type ActivityChallengeProvider interface {
+	// Type returns the activity challenge type provided
+	Type() ActivityChallengeType
+	// Tolerance returns for how long a spectator can take to respond to a challenge of this type at the given
+	// difficulty, before it stops being eligible for rewards
+	Tolerance(difficulty int) time.Duration
+}
+
+// PreparingActivityChallengeProvider is an ActivityChallengeProvider that needs to set up each challenge before it is
+// presented to the spectator
+type PreparingActivityChallengeProvider interface {
+	ActivityChallengeProvider
+	// Prepare sets up the challenge, for example by adding parameters to it. It may raise the difficulty of the challenge.
+	// riskScore is the current risk score of the challenged spectator
+	Prepare(challenge *ActivityChallenge, riskScore int) error
+}
+
+// CheckedActivityChallengeProvider is an ActivityChallengeProvider whose challenges are verified server-side.
+// Challenges of these types count as hard challenges, and each one consumes one of the responses submitted by the
+// spectator, in the order in which the types appear in the activity challenge
+type CheckedActivityChallengeProvider interface {
+	ActivityChallengeProvider
+	// Check verifies the response to the challenge
+	Check(ctx context.Context, challenge *ActivityChallenge, response string) (bool, error)
+}
+
+type simpleActivityChallengeProvider struct {
+	challengeType ActivityChallengeType
+	tolerance     time.Duration
+}
+
+func (p *simpleActivityChallengeProvider) Type() ActivityChallengeType {
+	return p.challengeType
+}
+
+func (p *simpleActivityChallengeProvider) Tolerance(int) time.Duration {
+	return p.tolerance
+}
+
+type checkedActivityChallengeProvider struct {
+	simpleActivityChallengeProvider
+	checkFn ChallengeCheckFunction
+}
+
+func (p *checkedActivityChallengeProvider) Check(ctx context.Context, challenge *ActivityChallenge, response string) (bool, error) {
+	return p.checkFn(ctx, challenge, response)
+}
+
+// NewCheckedActivityChallengeProvider returns a CheckedActivityChallengeProvider for challenges of the given type,
+// verified by checkFn
+func NewCheckedActivityChallengeProvider(challengeType ActivityChallengeType, tolerance time.Duration, checkFn ChallengeCheckFunction) CheckedActivityChallengeProvider {
+	return &checkedActivityChallengeProvider{
+		simpleActivityChallengeProvider: simpleActivityChallengeProvider{
+			challengeType: challengeType,
+			tolerance:     tolerance,
+		},
+		checkFn: checkFn,
+	}
+}
+
+// RegisterActivityChallengeProvider makes a type of activity challenge available for selection and verification.
+// Registering a provider for a type that already has one replaces the existing provider
+func (r *Handler) RegisterActivityChallengeProvider(provider ActivityChallengeProvider) {
+	r.challengeProvidersMutex.Lock()
+	defer r.challengeProvidersMutex.Unlock()
+	if _, present := r.challengeProviders[provider.Type()]; !present {
+		r.challengeProviderOrder = append(r.challengeProviderOrder, provider.Type())
+	}
+	r.challengeProviders[provider.Type()] = provider
+}
+
+func (r *Handler) activityChallengeProvider(challengeType ActivityChallengeType) (ActivityChallengeProvider, bool) {
+	r.challengeProvidersMutex.RLock()
+	defer r.challengeProvidersMutex.RUnlock()
+	provider, ok := r.challengeProviders[challengeType]
+	return provider, ok
+}
+
+// hardActivityChallengeTypes returns the types of the registered checked challenge providers, in registration order
+func (r *Handler) hardActivityChallengeTypes() []ActivityChallengeType {
+	r.challengeProvidersMutex.RLock()
+	defer r.challengeProvidersMutex.RUnlock()
+	types := []ActivityChallengeType{}
+	for _, challengeType := range r.challengeProviderOrder {
+		if _, checked := r.challengeProviders[challengeType].(CheckedActivityChallengeProvider); checked {
+			types = append(types, challengeType)
+		}
+	}
+	return types
+}
+
+// activityChallengeTolerance returns the longest tolerance among the types of the challenge
+func (r *Handler) activityChallengeTolerance(challenge *ActivityChallenge) time.Duration {
+	tolerance := time.Duration(0)
+	for _, challengeType := range challenge.Types {
+		if provider, ok := r.activityChallengeProvider(challengeType); ok {
+			tolerance = max(tolerance, provider.Tolerance(challenge.Difficulty))
+		}
+	}
+	return tolerance
+}
+
+// ActivityChallengeSelectionParams contains the information about a spectator that activity challenge selection
+// policies can take into account
+type ActivityChallengeSelectionParams struct {
+	// HardChallengeTypes are the types of registered challenges that are verified server-side, in registration order
+	HardChallengeTypes []ActivityChallengeType
+	// HardChallengeDue is true when the spectator has not solved a hard challenge for longer than the hard challenge
+	// interval that applies to them
+	HardChallengeDue bool
+	// PreviousHardChallengeType is the type of the latest hard challenge presented to the spectator, if any
+	PreviousHardChallengeType ActivityChallengeType
+	// RiskScore computes the current risk score of the spectator
+	RiskScore func() int
+}
+
+// ActivityChallengeSelection is the result of an activity challenge selection
+type ActivityChallengeSelection struct {
+	Types      []ActivityChallengeType
+	Difficulty int
+}
+
+// ActivityChallengeSelectionPolicy decides which types of activity challenges are presented to a spectator, and at
+// which difficulty
+type ActivityChallengeSelectionPolicy interface {
+	// Name returns the unique identifier of the policy
+	Name() string
+	// Description returns a human-readable explanation of how the policy selects challenges
+	Description() string
+	// Select returns the challenge types to present to a spectator and their difficulty.
+	// Types without a registered provider are ignored
+	Select(params ActivityChallengeSelectionParams) ActivityChallengeSelection
+}
+
+// DefaultActivityChallengeSelectionPolicyName is the name of the activity challenge selection policy used when no
+// other policy has been selected
+const DefaultActivityChallengeSelectionPolicyName = "periodic"
+
+var activityChallengeSelectionPolicies = map[string]ActivityChallengeSelectionPolicy{}
+
+// RegisterActivityChallengeSelectionPolicy makes an activity challenge selection policy available for selection.
+// Registering a policy with the same name as an existing one replaces the existing policy
+func RegisterActivityChallengeSelectionPolicy(policy ActivityChallengeSelectionPolicy) {
+	activityChallengeSelectionPolicies[policy.Name()] = policy
+}
+
+// ActivityChallengeSelectionPolicies returns all registered activity challenge selection policies, sorted by name
+func ActivityChallengeSelectionPolicies() []ActivityChallengeSelectionPolicy {
+	policies := make([]ActivityChallengeSelectionPolicy, 0, len(activityChallengeSelectionPolicies))
+	for _, policy := range activityChallengeSelectionPolicies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name() < policies[j].Name()
+	})
+	return policies
+}
+
+// ActivityChallengeSelectionPolicyWithName returns the registered activity challenge selection policy with the given name
+func ActivityChallengeSelectionPolicyWithName(name string) (ActivityChallengeSelectionPolicy, bool) {
+	policy, ok := activityChallengeSelectionPolicies[name]
+	return policy, ok
+}
+
+func init() {
+	RegisterActivityChallengeSelectionPolicy(&periodicSelectionPolicy{})
+	RegisterActivityChallengeSelectionPolicy(&rotatingSelectionPolicy{})
+	RegisterActivityChallengeSelectionPolicy(&riskEscalatingSelectionPolicy{})
+}
+
+type periodicSelectionPolicy struct{}
+
+func (p *periodicSelectionPolicy) Name() string {
+	return "periodic"
+}
+
+func (p *periodicSelectionPolicy) Description() string {
+	return "Button challenges, plus the first registered hard challenge type whenever a hard challenge is due"
+}
+
+func (p *periodicSelectionPolicy) Select(params ActivityChallengeSelectionParams) ActivityChallengeSelection {
+	selection := ActivityChallengeSelection{
+		Types: []ActivityChallengeType{ActivityChallengeTypeButton},
+	}
+	if params.HardChallengeDue && len(params.HardChallengeTypes) > 0 {
+		selection.Types = append(selection.Types, params.HardChallengeTypes[0])
+	}
+	return selection
+}
+
+type rotatingSelectionPolicy struct{}
+
+func (p *rotatingSelectionPolicy) Name() string {
+	return "rotating"
+}
+
+func (p *rotatingSelectionPolicy) Description() string {
+	return "Button challenges, plus a hard challenge whenever one is due, cycling through all registered hard challenge types"
+}
+
+func (p *rotatingSelectionPolicy) Select(params ActivityChallengeSelectionParams) ActivityChallengeSelection {
+	selection := ActivityChallengeSelection{
+		Types: []ActivityChallengeType{ActivityChallengeTypeButton},
+	}
+	if params.HardChallengeDue && len(params.HardChallengeTypes) > 0 {
+		// when the previous type is not found, the index is -1 and we start from the first type
+		next := (slices.Index(params.HardChallengeTypes, params.PreviousHardChallengeType) + 1) % len(params.HardChallengeTypes)
+		selection.Types = append(selection.Types, params.HardChallengeTypes[next])
+	}
+	return selection
+}
+
+type riskEscalatingSelectionPolicy struct{}
+
+func (p *riskEscalatingSelectionPolicy) Name() string {
+	return "risk_escalating"
+}
+
+func (p *riskEscalatingSelectionPolicy) Description() string {
+	return "Like periodic, but spectators with elevated risk scores are always presented a hard challenge, with difficulty increasing with the risk score"
+}
+
+func (p *riskEscalatingSelectionPolicy) Select(params ActivityChallengeSelectionParams) ActivityChallengeSelection {
+	selection := ActivityChallengeSelection{
+		Types: []ActivityChallengeType{ActivityChallengeTypeButton},
+	}
+	if len(params.HardChallengeTypes) == 0 {
+		return selection
+	}
+	riskScore := params.RiskScore()
+	if riskScore >= RiskScoreEligibilityThreshold/2 {
+		selection.Types = append(selection.Types, params.HardChallengeTypes[0])
+		selection.Difficulty = 1 + (riskScore-RiskScoreEligibilityThreshold/2)/(RiskScoreEligibilityThreshold/4)
+	} else if params.HardChallengeDue {
+		selection.Types = append(selection.Types, params.HardChallengeTypes[0])
+	}
+	return selection
+}
+
+// ActivityChallengeSelectionPolicy returns the policy currently used to select activity challenges
+func (r *Handler) ActivityChallengeSelectionPolicy() ActivityChallengeSelectionPolicy {
+	r.challengeProvidersMutex.RLock()
+	defer r.challengeProvidersMutex.RUnlock()
+	return r.challengeSelectionPolicy
+}
+
+// SetActivityChallengeSelectionPolicy sets the policy to use when producing future activity challenges
+func (r *Handler) SetActivityChallengeSelectionPolicy(name string) error {
+	policy, ok := ActivityChallengeSelectionPolicyWithName(name)
+	if !ok {
+		return stacktrace.Propagate(ErrUnknownActivityChallengeSelectionPolicy, "")
+	}
+	r.challengeProvidersMutex.Lock()
+	defer r.challengeProvidersMutex.Unlock()
+	r.challengeSelectionPolicy = policy
+	return nil
+}
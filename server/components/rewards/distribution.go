@@ -14,11 +14,9 @@ import (
 	"github.com/palantir/stacktrace"
 	uuid "github.com/satori/go.uuid"
 	"github.com/tnyim/jungletv/buildconfig"
-	"github.com/tnyim/jungletv/server/components/ipreputation"
 	"github.com/tnyim/jungletv/server/components/payment"
 	"github.com/tnyim/jungletv/server/components/pricer"
 	"github.com/tnyim/jungletv/server/media"
-	"github.com/tnyim/jungletv/server/stores/moderation"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -51,6 +49,8 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 
 	r.receiveCollectorPending(payment.NewAmount(rewardBudget.Int, rainBudget.Int))
 
+	policy := r.RewardPolicy()
+
 	r.spectatorsMutex.RLock()
 	defer r.spectatorsMutex.RUnlock()
 
@@ -63,18 +63,14 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 		}
 		if !banned {
 			// requester is eligible for receiving part of the rained amount that was not added by themselves
-			// the crowd receives 80% of the rained amount, and the requester receives 20% (since they wouldn't receive anything otherwise)
+			// the reward policy decides how much of it goes to the requester, the crowd receives the rest
 			totalRainMinusRequester := payment.NewAmount(big.NewInt(0).Sub(rainBudget.Int, rainedByRequester.Int))
-			// the requester receives 20% of the amount that wasn't rained by them
-			requesterReward = payment.NewAmount(big.NewInt(0).Mul(totalRainMinusRequester.Int, big.NewInt(2000)))
-			requesterReward = payment.NewAmount(big.NewInt(0).Div(requesterReward.Int, big.NewInt(10000)))
-			requesterReward.Div(requesterReward.Int, pricer.RewardRoundingFactor)
-			requesterReward.Mul(requesterReward.Int, pricer.RewardRoundingFactor)
+			requesterReward = policy.RequesterShareOfRain(totalRainMinusRequester)
 
 			rainBudget.Sub(rainBudget.Int, requesterReward.Int)
 
 			if requesterReward.Cmp(big.NewInt(0)) > 0 {
-				err = r.rewardRequester(ctx, media.QueueID(), requesterSpectator, requesterReward)
+				err = r.rewardRequester(ctx, media.QueueID(), requesterSpectator, requesterReward, policy.Name())
 				if err != nil {
 					return stacktrace.Propagate(err, "")
 				}
@@ -83,8 +79,7 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 	}
 	rewardBudget.Add(rewardBudget.Int, rainBudget.Int)
 
-	eligible := getEligibleSpectators(ctx, r.log, r.ipReputationChecker, r.moderationStore,
-		r.spectatorsByRemoteAddress, media.RequestedBy().Address(), media.PlayedFor())
+	eligible := r.getEligibleSpectators(ctx, r.log, media.RequestedBy().Address(), media.PlayedFor())
 	go r.statsClient.Gauge("eligible", len(eligible))
 	r.eligibleMovingAverage.Add(float64(len(eligible)))
 
@@ -101,15 +96,17 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 		return nil
 	}
 
-	amountForEach := ComputeReward(rewardBudget, len(eligible))
+	amounts := policy.Distribute(rewardBudget, rewardRecipientsFromSpectators(eligible), media.PlayedFor())
+	averageAmount := ComputeReward(sumOfRewards(amounts), len(eligible))
 	go func() {
 		r.statsClient.Gauge("reward_per_spectator",
-			float64(new(big.Int).Div(amountForEach.Int, pricer.RewardRoundingFactor).Int64())/100.0)
+			float64(new(big.Int).Div(averageAmount.Int, pricer.RewardRoundingFactor).Int64())/100.0)
 	}()
-	if amountForEach.Int.Cmp(big.NewInt(0)) <= 0 {
+	if len(amounts) == 0 {
 		r.log.Printf("Not rewarding because the amount for each user would be zero")
 	} else {
-		err = r.rewardEligible(ctx, media.QueueID(), eligible, rewardBudget, amountForEach)
+		r.log.Printf("Distributing rewards to %d of %d eligible spectators using the %s reward policy", len(amounts), len(eligible), policy.Name())
+		err = r.rewardEligible(ctx, media.QueueID(), eligible, amounts, policy.Name())
 		if err != nil {
 			return stacktrace.Propagate(err, "")
 		}
@@ -119,19 +116,17 @@ func (r *Handler) rewardUsers(ctx context.Context, media media.QueueEntry) error
 	return nil
 }
 
-func getEligibleSpectators(ctx context.Context,
+// spectatorsMutex must be held by the caller
+func (r *Handler) getEligibleSpectators(ctx context.Context,
 	l *log.Logger,
-	c *ipreputation.Checker,
-	moderationStore moderation.Store,
-	spectatorsByRemoteAddress map[string][]*spectator,
 	exceptAddress string,
 	mediaPlayedFor time.Duration) map[string]*spectator {
 	// maps addresses to spectators
 	toBeRewarded := make(map[string]*spectator)
 
 	spectatorsByUniquifiedRemoteAddress := make(map[string][]*spectator)
-	for k := range spectatorsByRemoteAddress {
-		spectators := spectatorsByRemoteAddress[k]
+	for k := range r.spectatorsByRemoteAddress {
+		spectators := r.spectatorsByRemoteAddress[k]
 		if len(spectators) == 0 {
 			continue
 		}
@@ -143,18 +138,17 @@ func getEligibleSpectators(ctx context.Context,
 
 	for k := range spectatorsByUniquifiedRemoteAddress {
 		spectators := spectatorsByUniquifiedRemoteAddress[k]
+		connectedOnRemoteAddress := 0
+		for _, spectator := range spectators {
+			if spectator.connectionCount > 0 {
+				connectedOnRemoteAddress++
+			}
+		}
 		// pick a random spectator to reward within this uniquified remote address
 		rand.Shuffle(len(spectators), func(i, j int) {
 			spectators[i], spectators[j] = spectators[j], spectators[i]
 		})
 		for j := range spectators {
-			if canReceive := c.CanReceiveRewards(spectators[j].remoteAddress); !canReceive {
-				canUseBadIP, err := moderationStore.LoadPaymentAddressSkipsIPReputationChecks(ctx, spectators[j].user.Address())
-				if err == nil && !canUseBadIP {
-					l.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress, "due to bad IP reputation")
-					continue
-				}
-			}
 			if !spectators[j].stoppedWatching.IsZero() {
 				// spectator not currently watching
 				continue
@@ -169,20 +163,21 @@ func getEligibleSpectators(ctx context.Context,
 				l.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress, "due to inactivity")
 				continue
 			}
-			// do not reward an illegitimate spectator
-			if !spectators[j].legitimate {
-				l.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress, "because it is not considered legitimate")
-				continue
-			}
 			// do not reward a banned spectator
-			if banned, err := moderationStore.LoadPaymentAddressBannedFromRewards(ctx, spectators[j].user.Address()); err == nil && banned {
+			if banned, err := r.moderationStore.LoadPaymentAddressBannedFromRewards(ctx, spectators[j].user.Address()); err == nil && banned {
 				l.Println("Skipped rewarding", spectators[j].user.Address(), "due to ban")
 				continue
 			}
-			if banned, err := moderationStore.LoadRemoteAddressBannedFromRewards(ctx, spectators[j].remoteAddress); err == nil && banned {
+			if banned, err := r.moderationStore.LoadRemoteAddressBannedFromRewards(ctx, spectators[j].remoteAddress); err == nil && banned {
 				l.Println("Skipped rewarding", spectators[j].user.Address(), "due to ban")
 				continue
 			}
+			// do not reward a spectator that is too likely to be abusing the system
+			if risk := r.assessSpectatorRisk(ctx, spectators[j], connectedOnRemoteAddress); !risk.Eligible() {
+				l.Println("Skipped rewarding", spectators[j].user.Address(), spectators[j].remoteAddress,
+					"due to risk score", risk.Score, "("+risk.signalsAboveZero()+")")
+				continue
+			}
 			// do not reward an address that would have received a reward via another remote address already
 			if _, present := toBeRewarded[spectators[j].user.Address()]; !present {
 				toBeRewarded[spectators[j].user.Address()] = spectators[j]
@@ -245,47 +240,58 @@ func (r *Handler) receiveCollectorPending(minExpectedBalance payment.Amount) {
 	wg.Wait()
 }
 
-func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligible map[string]*spectator, requestCost payment.Amount, amountForEach payment.Amount) error {
+func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligible map[string]*spectator, amounts map[string]payment.Amount, policyName string) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
 	}
 	defer ctx.Rollback()
 
-	addresses := make([]string, len(eligible))
-	i := 0
-	for address := range eligible {
-		addresses[i] = address
-		i++
-	}
-
-	newBalances, err := types.AdjustRewardBalanceOfAddresses(ctx, addresses, amountForEach.Decimal())
-	if err != nil {
-		return stacktrace.Propagate(err, "")
+	// group addresses by amount, so we can adjust the balances of all addresses receiving the same amount at once
+	// (with the equal split policy, this means a single query, as before)
+	addressesByAmount := make(map[string][]string)
+	amountsByKey := make(map[string]payment.Amount)
+	for address, amount := range amounts {
+		if _, isEligible := eligible[address]; !isEligible {
+			continue
+		}
+		key := amount.String()
+		addressesByAmount[key] = append(addressesByAmount[key], address)
+		amountsByKey[key] = amount
 	}
 
 	balancesByAddress := make(map[string]*types.RewardBalance)
-	for _, balance := range newBalances {
-		balancesByAddress[balance.RewardsAddress] = balance
+	for key, addresses := range addressesByAmount {
+		newBalances, err := types.AdjustRewardBalanceOfAddresses(ctx, addresses, amountsByKey[key].Decimal())
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		for _, balance := range newBalances {
+			balancesByAddress[balance.RewardsAddress] = balance
+		}
 	}
 
-	rewards := make([]*types.ReceivedReward, len(eligible))
-	rewardsIdx := 0
+	rewards := []*types.ReceivedReward{}
 	now := time.Now()
-	for _, spectator := range eligible {
-		rewardBalance, ok := balancesByAddress[spectator.user.Address()]
+	for address, spectator := range eligible {
+		amount, ok := amounts[address]
+		if !ok {
+			continue
+		}
+		rewardBalance, ok := balancesByAddress[address]
 		if ok {
-			spectator.onRewarded.Notify(SpectatorRewardedEventArgs{amountForEach, payment.NewAmountFromDecimal(rewardBalance.Balance)}, false)
+			spectator.onRewarded.Notify(SpectatorRewardedEventArgs{amount, payment.NewAmountFromDecimal(rewardBalance.Balance)}, false)
+			spectator.session.recordReward(amount)
 		}
 
-		rewards[rewardsIdx] = &types.ReceivedReward{
+		rewards = append(rewards, &types.ReceivedReward{
 			ID:             uuid.NewV4().String(),
-			RewardsAddress: spectator.user.Address(),
+			RewardsAddress: address,
 			ReceivedAt:     now,
-			Amount:         amountForEach.Decimal(),
+			Amount:         amount.Decimal(),
 			Media:          mediaID,
-		}
-		rewardsIdx++
+			Policy:         policyName,
+		})
 	}
 
 	err = types.InsertReceivedRewards(ctx, rewards)
@@ -296,7 +302,7 @@ func (r *Handler) rewardEligible(ctxCtx context.Context, mediaID string, eligibl
 	return stacktrace.Propagate(ctx.Commit(), "")
 }
 
-func (r *Handler) rewardRequester(ctxCtx context.Context, mediaID string, requester *spectator, reward payment.Amount) error {
+func (r *Handler) rewardRequester(ctxCtx context.Context, mediaID string, requester *spectator, reward payment.Amount, policyName string) error {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return stacktrace.Propagate(err, "")
@@ -314,6 +320,7 @@ func (r *Handler) rewardRequester(ctxCtx context.Context, mediaID string, reques
 	}
 
 	requester.onRewarded.Notify(SpectatorRewardedEventArgs{reward, payment.NewAmountFromDecimal(newBalances[0].Balance)}, false)
+	requester.session.recordReward(reward)
 
 	err = types.InsertReceivedRewards(ctx, []*types.ReceivedReward{{
 		ID:             uuid.NewV4().String(),
@@ -321,6 +328,7 @@ func (r *Handler) rewardRequester(ctxCtx context.Context, mediaID string, reques
 		ReceivedAt:     time.Now(),
 		Amount:         reward.Decimal(),
 		Media:          mediaID,
+		Policy:         policyName,
 	}})
 	if err != nil {
 		return stacktrace.Propagate(err, "")
@@ -387,6 +395,29 @@ func (r *Handler) desperatelyTryToFindFundsStuckInPaymentAccounts() error {
 	}
 }
 
+// rewardRecipientsFromSpectators returns the information about the given spectators that reward policies take into account
+func rewardRecipientsFromSpectators(spectators map[string]*spectator) []RewardRecipient {
+	now := time.Now()
+	recipients := make([]RewardRecipient, 0, len(spectators))
+	for address, spectator := range spectators {
+		recipients = append(recipients, RewardRecipient{
+			RewardsAddress:          address,
+			RemoteAddress:           spectator.remoteAddress,
+			WatchedFor:              now.Sub(spectator.startedWatching),
+			ActivityChallengeStreak: spectator.activityChallengeStreak,
+		})
+	}
+	return recipients
+}
+
+func sumOfRewards(amounts map[string]payment.Amount) payment.Amount {
+	sum := payment.NewAmount()
+	for _, amount := range amounts {
+		sum.Add(sum.Int, amount.Int)
+	}
+	return sum
+}
+
 // ComputeReward calculates how much each user should receive
 func ComputeReward(totalAmount payment.Amount, numUsers int) payment.Amount {
 	amountForEach := payment.NewAmount()
@@ -2,6 +2,7 @@ package rewards
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"log"
@@ -56,12 +57,20 @@ type Handler struct {
 	moderationStore       moderation.Store
 	staffActivityManager  *staffactivitymanager.Manager
 	eligibleMovingAverage *movingaverage.MovingAverage
-	challengeCheckers     map[ActivityChallengeType]ChallengeCheckFunction
 	versionHash           *string
 	pointsManager         *pointsmanager.Manager
+	sessionHashKey        []byte
 
 	rewardsDistributed event.Event[RewardsDistributedEventArgs]
 
+	rewardPolicy      RewardPolicy
+	rewardPolicyMutex sync.RWMutex
+
+	challengeProviders       map[ActivityChallengeType]ActivityChallengeProvider
+	challengeProviderOrder   []ActivityChallengeType
+	challengeSelectionPolicy ActivityChallengeSelectionPolicy
+	challengeProvidersMutex  sync.RWMutex
+
 	// spectatorsByRemoteAddress maps a remote address to a set of spectators
 	spectatorsByRemoteAddress map[string][]*spectator
 	// spectatorsByRewardAddress maps a reward address to a spectator
@@ -72,6 +81,7 @@ type Handler struct {
 
 	chatParticipation             *cache.Cache[string, struct{}]
 	chatLessFrequentParticipation *cache.Cache[string, struct{}]
+	accountHistoryCache           *cache.Cache[string, spectatorAccountHistory]
 }
 
 type Spectator interface {
@@ -108,8 +118,12 @@ type spectator struct {
 	onActivityChallenge        event.Event[*ActivityChallenge]
 	activityChallenge          *ActivityChallenge
 	lastHardChallengeSolvedAt  time.Time
+	lastHardChallengeType      ActivityChallengeType
+	activityChallengeStreak    int
 	connectionCount            int
+	recentSolveLatencies       []time.Duration
 	noToleranceOnNextChallenge bool
+	session                    *spectatorSession
 }
 
 // ActivityChallengeType is a type of activity challenge
@@ -124,6 +138,8 @@ var (
 	ActivityChallengeTypeTurnstile ActivityChallengeType = "turnstile"
 	// ActivityChallengeTypeModerating is a moderation activity challenge
 	ActivityChallengeTypeModerating ActivityChallengeType = "moderating"
+	// ActivityChallengeTypeProofOfWork is a memory-hard proof-of-work activity challenge
+	ActivityChallengeTypeProofOfWork ActivityChallengeType = "proof_of_work"
 )
 
 type ActivityChallenge struct {
@@ -131,6 +147,9 @@ type ActivityChallenge struct {
 	ID           string
 	Types        []ActivityChallengeType
 	Tolerance    time.Duration
+	Difficulty   int
+	// Parameters are set by the providers of the challenge types, for clients to be able to solve them
+	Parameters map[string]string
 }
 
 func (a *ActivityChallenge) SerializeForAPI() *proto.ActivityChallenge {
@@ -138,6 +157,7 @@ func (a *ActivityChallenge) SerializeForAPI() *proto.ActivityChallenge {
 		Id:           a.ID,
 		Types:        utils.CastStringLikeSlice[ActivityChallengeType, string](a.Types),
 		ChallengedAt: timestamppb.New(a.ChallengedAt),
+		Parameters:   a.Parameters,
 	}
 }
 
@@ -203,9 +223,18 @@ func NewHandler(log *log.Logger,
 	paymentAccountPool *payment.PaymentAccountPool,
 	moderationStore moderation.Store,
 	staffActivityManager *staffactivitymanager.Manager,
-	challengeCheckers map[ActivityChallengeType]ChallengeCheckFunction,
+	challengeProviders []ActivityChallengeProvider,
+	sessionHashKey []byte,
 	versionHash *string) (*Handler, error) {
-	return &Handler{
+	if len(sessionHashKey) == 0 {
+		sessionHashKey = make([]byte, 32)
+		_, err := rand.Read(sessionHashKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	r := &Handler{
 		log:                   log,
 		statsClient:           statsClient,
 		mediaQueue:            mediaQueue,
@@ -219,11 +248,16 @@ func NewHandler(log *log.Logger,
 		staffActivityManager:  staffActivityManager,
 		moderationStore:       moderationStore,
 		eligibleMovingAverage: movingaverage.New(3),
-		challengeCheckers:     maps.Clone(challengeCheckers),
 		pointsManager:         pointsManager,
+		sessionHashKey:        sessionHashKey,
 
 		rewardsDistributed: event.New[RewardsDistributedEventArgs](),
 
+		rewardPolicy: rewardPolicies[DefaultRewardPolicyName],
+
+		challengeProviders:       make(map[ActivityChallengeType]ActivityChallengeProvider),
+		challengeSelectionPolicy: activityChallengeSelectionPolicies[DefaultActivityChallengeSelectionPolicyName],
+
 		spectatorsByRemoteAddress:    make(map[string][]*spectator),
 		spectatorsByRewardAddress:    make(map[string]*spectator),
 		spectatorByActivityChallenge: make(map[string]*spectator),
@@ -232,7 +266,18 @@ func NewHandler(log *log.Logger,
 
 		chatParticipation:             cache.New[string, struct{}](2*time.Minute+45*time.Second, 10*time.Minute),
 		chatLessFrequentParticipation: cache.New[string, struct{}](15*time.Minute, 10*time.Minute),
-	}, nil
+		accountHistoryCache:           cache.New[string, spectatorAccountHistory](10*time.Minute, 10*time.Minute),
+	}
+
+	// moderators may have selected a different policy before the server restarted, but that choice is not persisted
+	r.log.Printf("Using the default reward policy, %s", DefaultRewardPolicyName)
+
+	r.RegisterActivityChallengeProvider(&simpleActivityChallengeProvider{ActivityChallengeTypeButton, 1 * time.Minute})
+	r.RegisterActivityChallengeProvider(&simpleActivityChallengeProvider{ActivityChallengeTypeModerating, 2 * time.Minute})
+	for _, provider := range challengeProviders {
+		r.RegisterActivityChallengeProvider(provider)
+	}
+	return r, nil
 }
 
 func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Spectator, error) {
@@ -269,6 +314,7 @@ func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Specta
 			s.nextActivityCheckTime = now.Add(d)
 			s.activityCheckTimer = time.NewTimer(d)
 			s.lastHardChallengeSolvedAt = time.Time{}
+			s.activityChallengeStreak = 0
 			s.noToleranceOnNextChallenge = true
 			s.remoteAddresses[remoteAddress] = struct{}{}
 		}
@@ -294,6 +340,7 @@ func (r *Handler) RegisterSpectator(ctx context.Context, user auth.User) (Specta
 			remoteAddresses: map[string]struct{}{
 				remoteAddress: {},
 			},
+			session: newSpectatorSession(r.sessionHashKey, remoteAddress),
 		}
 		r.spectatorsByRemoteAddress[s.remoteAddress] = append(r.spectatorsByRemoteAddress[s.remoteAddress], s)
 		r.spectatorsByRewardAddress[s.user.Address()] = s
@@ -341,7 +388,8 @@ func (r *Handler) UnregisterSpectator(ctx context.Context, sInterface Spectator)
 	return nil
 }
 
-func (r *Handler) purgeOldDisconnectedSpectators() {
+// purgeOldDisconnectedSpectators returns the records for the sessions of the purged spectators
+func (r *Handler) purgeOldDisconnectedSpectators() []*types.SpectatorSession {
 	r.spectatorsMutex.Lock()
 	defer r.spectatorsMutex.Unlock()
 
@@ -364,8 +412,10 @@ func (r *Handler) purgeOldDisconnectedSpectators() {
 	for _, slice := range r.spectatorsByRemoteAddress {
 		spectators = append(spectators, slice...)
 	}
+	sessions := []*types.SpectatorSession{}
 	for _, s := range spectators {
 		if !s.stoppedWatching.IsZero() && time.Since(s.stoppedWatching) > 15*time.Minute {
+			sessions = append(sessions, s.sessionRecord(s.stoppedWatching))
 			removeSpectator(r.spectatorsByRemoteAddress, s, s.remoteAddress)
 			delete(r.spectatorsByRewardAddress, s.user.Address())
 			if s.activityChallenge != nil {
@@ -374,6 +424,7 @@ func (r *Handler) purgeOldDisconnectedSpectators() {
 			r.log.Printf("Purged spectator with reward address %s and remote address %s", s.user.Address(), s.remoteAddress)
 		}
 	}
+	return sessions
 }
 
 func (r *Handler) Worker(ctx context.Context) error {
@@ -420,7 +471,12 @@ func (r *Handler) Worker(ctx context.Context) error {
 		case pendingWithdrawals := <-onPendingWithdrawalsCreated:
 			r.onPendingWithdrawalCreated(ctx, pendingWithdrawals)
 		case <-purgeTicker.C:
-			r.purgeOldDisconnectedSpectators()
+			sessions := r.purgeOldDisconnectedSpectators()
+			sessions = append(sessions, r.ongoingSpectatorSessions()...)
+			err := r.persistSpectatorSessions(ctx, sessions)
+			if err != nil {
+				r.log.Println("Error persisting spectator sessions:", err)
+			}
 		case entryAddedArgs := <-onEntryAdded:
 			err := r.handleQueueEntryAdded(ctx, entryAddedArgs.Entry)
 			if err != nil {
@@ -432,6 +488,13 @@ func (r *Handler) Worker(ctx context.Context) error {
 				return stacktrace.Propagate(err, "")
 			}
 		case <-ctx.Done():
+			// the context is done, but the sessions that are still ongoing must not be lost
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			err := r.persistSpectatorSessions(flushCtx, r.ongoingSpectatorSessions())
+			cancel()
+			if err != nil {
+				r.log.Println("Error persisting spectator sessions on shutdown:", err)
+			}
 			return nil
 		}
 	}
@@ -458,6 +521,12 @@ func (r *Handler) onMediaChanged(ctx context.Context, newMedia media.QueueEntry)
 	}
 	lastMedia := r.lastMedia
 
+	func() {
+		r.spectatorsMutex.RLock()
+		defer r.spectatorsMutex.RUnlock()
+		r.recordMediaWatchedBySpectators()
+	}()
+
 	go func() {
 		err := r.rewardUsers(ctx, lastMedia)
 		if err != nil {
@@ -590,6 +659,11 @@ func (r *Handler) CountConnectedSpectatorsOnRemoteAddress(remoteAddress string)
 	r.spectatorsMutex.RLock()
 	defer r.spectatorsMutex.RUnlock()
 
+	return r.countConnectedSpectatorsOnRemoteAddress(remoteAddress)
+}
+
+// spectatorsMutex must be held by the caller
+func (r *Handler) countConnectedSpectatorsOnRemoteAddress(remoteAddress string) int {
 	count := 0
 	uniquifiedNeedle := utils.GetUniquifiedIP(remoteAddress)
 	for k, spectators := range r.spectatorsByRemoteAddress {
@@ -0,0 +1,367 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/netip"
+	"sort"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+)
+
+// ErrUnknownRewardPolicy is returned when attempting to select a reward policy that is not registered
+var ErrUnknownRewardPolicy = errors.New("unknown reward policy")
+
+// RewardRecipient contains the information about an eligible spectator that reward policies can take into account
+type RewardRecipient struct {
+	RewardsAddress string
+	RemoteAddress  string
+	// WatchedFor is for how long the spectator has been watching without interruption
+	WatchedFor time.Duration
+	// ActivityChallengeStreak is the number of consecutive activity challenges solved by the spectator in time
+	ActivityChallengeStreak int
+}
+
+// RewardPolicy decides how the reward budget of a queue entry is split among the spectators eligible for receiving
+// rewards, as well as how much of the rain goes to the requester of the queue entry
+type RewardPolicy interface {
+	// Name returns the unique identifier of the policy, which is recorded alongside each reward distributed under it
+	Name() string
+	// Description returns a human-readable explanation of how the policy splits the budget
+	Description() string
+	// RequesterShareOfRain returns how much of the rain not contributed by the requester of the queue entry should go
+	// to the requester
+	RequesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount
+	// Distribute splits the budget among the recipients, returning the amount each rewards address should receive.
+	// Recipients that should not receive anything may be omitted from the result.
+	// Amounts must be multiples of pricer.RewardRoundingFactor and their sum must not exceed the budget
+	Distribute(budget payment.Amount, recipients []RewardRecipient, mediaPlayedFor time.Duration) map[string]payment.Amount
+}
+
+// DefaultRewardPolicyName is the name of the reward policy used when no other policy has been selected
+const DefaultRewardPolicyName = "equal_split"
+
+var rewardPolicies = map[string]RewardPolicy{}
+
+// RegisterRewardPolicy makes a reward policy available for selection. Registering a policy with the same name as an
+// existing one replaces the existing policy
+func RegisterRewardPolicy(policy RewardPolicy) {
+	rewardPolicies[policy.Name()] = policy
+}
+
+// RewardPolicies returns all registered reward policies, sorted by name
+func RewardPolicies() []RewardPolicy {
+	policies := make([]RewardPolicy, 0, len(rewardPolicies))
+	for _, policy := range rewardPolicies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name() < policies[j].Name()
+	})
+	return policies
+}
+
+// RewardPolicyWithName returns the registered reward policy with the given name
+func RewardPolicyWithName(name string) (RewardPolicy, bool) {
+	policy, ok := rewardPolicies[name]
+	return policy, ok
+}
+
+func init() {
+	RegisterRewardPolicy(&equalSplitPolicy{})
+	RegisterRewardPolicy(&watchTimeWeightedPolicy{})
+	RegisterRewardPolicy(&challengeStreakWeightedPolicy{})
+	RegisterRewardPolicy(&cappedPerNetworkPolicy{})
+}
+
+// requesterShareOfRain is the requester share of the rain used by the built-in policies:
+// the requester receives 20% of the amount that wasn't rained by them (since they wouldn't receive anything otherwise)
+func requesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount {
+	share := payment.NewAmount(big.NewInt(0).Mul(rainNotByRequester.Int, big.NewInt(2000)))
+	share = payment.NewAmount(big.NewInt(0).Div(share.Int, big.NewInt(10000)))
+	return floorReward(share)
+}
+
+// floorReward rounds an amount down to a multiple of the reward rounding factor, to prevent dust amounts
+func floorReward(amount payment.Amount) payment.Amount {
+	amount.Div(amount.Int, pricer.RewardRoundingFactor)
+	amount.Mul(amount.Int, pricer.RewardRoundingFactor)
+	return amount
+}
+
+// distributeWeighted splits the budget among recipients proportionally to the weight of each
+func distributeWeighted(budget payment.Amount, recipients []RewardRecipient, weight func(RewardRecipient) int64) map[string]payment.Amount {
+	weights := make([]int64, len(recipients))
+	totalWeight := big.NewInt(0)
+	for i, recipient := range recipients {
+		weights[i] = weight(recipient)
+		if weights[i] < 0 {
+			weights[i] = 0
+		}
+		totalWeight.Add(totalWeight, big.NewInt(weights[i]))
+	}
+
+	result := make(map[string]payment.Amount)
+	if totalWeight.Sign() <= 0 {
+		return result
+	}
+	for i, recipient := range recipients {
+		amount := payment.NewAmount()
+		amount.Mul(budget.Int, big.NewInt(weights[i]))
+		amount.Div(amount.Int, totalWeight)
+		amount = floorReward(amount)
+		if amount.Sign() > 0 {
+			result[recipient.RewardsAddress] = amount
+		}
+	}
+	return result
+}
+
+type equalSplitPolicy struct{}
+
+func (p *equalSplitPolicy) Name() string {
+	return DefaultRewardPolicyName
+}
+
+func (p *equalSplitPolicy) Description() string {
+	return "The budget is split equally among all eligible spectators"
+}
+
+func (p *equalSplitPolicy) RequesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount {
+	return requesterShareOfRain(rainNotByRequester)
+}
+
+func (p *equalSplitPolicy) Distribute(budget payment.Amount, recipients []RewardRecipient, _ time.Duration) map[string]payment.Amount {
+	result := make(map[string]payment.Amount)
+	if len(recipients) == 0 {
+		return result
+	}
+	amountForEach := ComputeReward(budget, len(recipients))
+	if amountForEach.Sign() <= 0 {
+		return result
+	}
+	for _, recipient := range recipients {
+		result[recipient.RewardsAddress] = amountForEach
+	}
+	return result
+}
+
+type watchTimeWeightedPolicy struct{}
+
+func (p *watchTimeWeightedPolicy) Name() string {
+	return "watch_time_weighted"
+}
+
+func (p *watchTimeWeightedPolicy) Description() string {
+	return "The budget is split proportionally to how much of the media each eligible spectator watched"
+}
+
+func (p *watchTimeWeightedPolicy) RequesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount {
+	return requesterShareOfRain(rainNotByRequester)
+}
+
+func (p *watchTimeWeightedPolicy) Distribute(budget payment.Amount, recipients []RewardRecipient, mediaPlayedFor time.Duration) map[string]payment.Amount {
+	return distributeWeighted(budget, recipients, func(recipient RewardRecipient) int64 {
+		watched := recipient.WatchedFor
+		if watched > mediaPlayedFor {
+			watched = mediaPlayedFor
+		}
+		// everyone eligible gets at least a minimal weight, so that nobody ends up with nothing
+		return int64(watched/time.Second) + 1
+	})
+}
+
+// maxRewardedChallengeStreak is the activity challenge streak after which spectators stop receiving a larger share
+const maxRewardedChallengeStreak = 10
+
+type challengeStreakWeightedPolicy struct{}
+
+func (p *challengeStreakWeightedPolicy) Name() string {
+	return "challenge_streak_weighted"
+}
+
+func (p *challengeStreakWeightedPolicy) Description() string {
+	return "The budget is split favoring spectators who consistently solve activity challenges in time, " +
+		"up to twice the share of those without a streak"
+}
+
+func (p *challengeStreakWeightedPolicy) RequesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount {
+	return requesterShareOfRain(rainNotByRequester)
+}
+
+func (p *challengeStreakWeightedPolicy) Distribute(budget payment.Amount, recipients []RewardRecipient, _ time.Duration) map[string]payment.Amount {
+	return distributeWeighted(budget, recipients, func(recipient RewardRecipient) int64 {
+		streak := recipient.ActivityChallengeStreak
+		if streak > maxRewardedChallengeStreak {
+			streak = maxRewardedChallengeStreak
+		}
+		return int64(maxRewardedChallengeStreak + streak)
+	})
+}
+
+// maxRewardedSpectatorsPerNetwork is the number of equal shares that the spectators of a single IP range can receive
+// in total under the capped_per_ip policy
+const maxRewardedSpectatorsPerNetwork = 3
+
+type cappedPerNetworkPolicy struct{}
+
+func (p *cappedPerNetworkPolicy) Name() string {
+	return "capped_per_ip"
+}
+
+func (p *cappedPerNetworkPolicy) Description() string {
+	return fmt.Sprintf("The budget is split equally among eligible spectators, but the spectators within the same IP range "+
+		"(IPv4 /24, IPv6 /48) receive at most %d shares in total, which they split equally. "+
+		"What is left over is split among everyone else", maxRewardedSpectatorsPerNetwork)
+}
+
+func (p *cappedPerNetworkPolicy) RequesterShareOfRain(rainNotByRequester payment.Amount) payment.Amount {
+	return requesterShareOfRain(rainNotByRequester)
+}
+
+func (p *cappedPerNetworkPolicy) Distribute(budget payment.Amount, recipients []RewardRecipient, _ time.Duration) map[string]payment.Amount {
+	recipientsByNetwork := make(map[string][]RewardRecipient)
+	for _, recipient := range recipients {
+		network := rewardNetworkOfRemoteAddress(recipient.RemoteAddress)
+		recipientsByNetwork[network] = append(recipientsByNetwork[network], recipient)
+	}
+
+	// each network gets as many shares as it has recipients, up to the cap. Since shares are computed over the
+	// capped total, what capped networks would have received goes to everyone else
+	sharesOfNetwork := func(networkRecipients []RewardRecipient) int64 {
+		if len(networkRecipients) > maxRewardedSpectatorsPerNetwork {
+			return maxRewardedSpectatorsPerNetwork
+		}
+		return int64(len(networkRecipients))
+	}
+	totalShares := int64(0)
+	for _, networkRecipients := range recipientsByNetwork {
+		totalShares += sharesOfNetwork(networkRecipients)
+	}
+
+	result := make(map[string]payment.Amount)
+	if totalShares == 0 {
+		return result
+	}
+	for _, networkRecipients := range recipientsByNetwork {
+		budgetForNetwork := payment.NewAmount()
+		budgetForNetwork.Mul(budget.Int, big.NewInt(sharesOfNetwork(networkRecipients)))
+		budgetForNetwork.Div(budgetForNetwork.Int, big.NewInt(totalShares))
+		amountForEach := ComputeReward(budgetForNetwork, len(networkRecipients))
+		if amountForEach.Sign() <= 0 {
+			continue
+		}
+		for _, recipient := range networkRecipients {
+			result[recipient.RewardsAddress] = amountForEach
+		}
+	}
+	return result
+}
+
+// rewardNetworkOfRemoteAddress returns the IP range a remote address is considered to belong to, for the purposes of
+// capping the rewards distributed per IP range
+func rewardNetworkOfRemoteAddress(remoteAddress string) string {
+	addr, err := netip.ParseAddr(remoteAddress)
+	if err != nil {
+		return remoteAddress
+	}
+	addr = addr.Unmap()
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return remoteAddress
+	}
+	return prefix.String()
+}
+
+// RewardPolicy returns the reward policy currently used to distribute rewards
+func (r *Handler) RewardPolicy() RewardPolicy {
+	r.rewardPolicyMutex.RLock()
+	defer r.rewardPolicyMutex.RUnlock()
+	return r.rewardPolicy
+}
+
+// SetRewardPolicy sets the reward policy to use in future reward distributions.
+// The selection is not persisted: the default policy is used again after the server restarts
+func (r *Handler) SetRewardPolicy(name string) error {
+	policy, ok := RewardPolicyWithName(name)
+	if !ok {
+		return stacktrace.Propagate(ErrUnknownRewardPolicy, "")
+	}
+	r.rewardPolicyMutex.Lock()
+	defer r.rewardPolicyMutex.Unlock()
+	r.rewardPolicy = policy
+	return nil
+}
+
+// RewardDistributionSimulation is the result of simulating how a reward budget would be split under a reward policy
+type RewardDistributionSimulation struct {
+	Policy          RewardPolicy
+	RequesterReward payment.Amount
+	// Rewards maps rewards addresses to the amount each would receive
+	Rewards map[string]payment.Amount
+}
+
+// SimulateRewardDistribution computes how the budget of the currently playing queue entry would be split under each
+// registered reward policy, if the entry stopped playing now. No rewards are distributed and no funds are moved
+func (r *Handler) SimulateRewardDistribution(ctx context.Context) (payment.Amount, int, []RewardDistributionSimulation, error) {
+	entry, playing := r.mediaQueue.CurrentlyPlaying()
+	if !playing {
+		return payment.NewAmount(), 0, []RewardDistributionSimulation{}, nil
+	}
+
+	requestedByValidUser := entry.RequestedBy() != nil && !entry.RequestedBy().IsUnknown() && !entry.RequestedBy().IsFromAlienChain()
+	rewardBudget := payment.NewAmount(entry.RequestCost().Int, r.skipManager.SkipAccountStatus().Balance.Int)
+	rainBudget := payment.NewAmount(r.skipManager.RainAccountStatus().Balance.Int)
+	rainedByRequester := r.skipManager.RainedByRequester()
+
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	requesterEligibleForRain := false
+	if requestedByValidUser && rainBudget.Sign() > 0 {
+		if _, isSpectator := r.spectatorsByRewardAddress[entry.RequestedBy().Address()]; isSpectator {
+			banned, err := r.moderationStore.LoadPaymentAddressBannedFromRewards(ctx, entry.RequestedBy().Address())
+			if err != nil {
+				return payment.NewAmount(), 0, nil, stacktrace.Propagate(err, "")
+			}
+			requesterEligibleForRain = !banned
+		}
+	}
+
+	// discard the log output, as it is meant to explain actual reward distributions
+	eligible := r.getEligibleSpectators(ctx, log.New(io.Discard, "", 0), entry.RequestedBy().Address(), entry.PlayedFor())
+	recipients := rewardRecipientsFromSpectators(eligible)
+
+	policies := RewardPolicies()
+	simulations := make([]RewardDistributionSimulation, len(policies))
+	for i, policy := range policies {
+		requesterReward := payment.NewAmount()
+		if requesterEligibleForRain {
+			requesterReward = policy.RequesterShareOfRain(payment.NewAmount(big.NewInt(0).Sub(rainBudget.Int, rainedByRequester.Int)))
+		}
+		budget := payment.NewAmount(rewardBudget.Int, rainBudget.Int)
+		budget.Sub(budget.Int, requesterReward.Int)
+
+		rewards := make(map[string]payment.Amount)
+		if len(recipients) > 0 {
+			rewards = policy.Distribute(budget, recipients, entry.PlayedFor())
+		}
+		simulations[i] = RewardDistributionSimulation{
+			Policy:          policy,
+			RequesterReward: requesterReward,
+			Rewards:         rewards,
+		}
+	}
+	return payment.NewAmount(rewardBudget.Int, rainBudget.Int), len(eligible), simulations, nil
+}
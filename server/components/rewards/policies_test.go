@@ -0,0 +1,60 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+)
+
+func TestCappedPerNetworkPolicy(t *testing.T) {
+	units := func(n int64) payment.Amount {
+		return payment.NewAmount(big.NewInt(0).Mul(pricer.RewardRoundingFactor, big.NewInt(n)))
+	}
+
+	recipients := []RewardRecipient{
+		{RewardsAddress: "single_a", RemoteAddress: "192.168.1.1"},
+		{RewardsAddress: "single_b", RemoteAddress: "2001:db8:1::1"},
+	}
+	for i := 0; i < 5; i++ {
+		recipients = append(recipients, RewardRecipient{
+			RewardsAddress: fmt.Sprintf("crowded_%d", i),
+			RemoteAddress:  fmt.Sprintf("10.0.0.%d", i+1),
+		})
+	}
+
+	// the crowded network gets 3 of the 5 shares, which its 5 spectators split
+	result := (&cappedPerNetworkPolicy{}).Distribute(units(1000), recipients, 0)
+	require.Len(t, result, 7)
+	require.Zero(t, result["single_a"].Cmp(units(200).Int))
+	require.Zero(t, result["single_b"].Cmp(units(200).Int))
+	for i := 0; i < 5; i++ {
+		require.Zero(t, result[fmt.Sprintf("crowded_%d", i)].Cmp(units(120).Int))
+	}
+
+	// without crowded networks, the policy behaves like an equal split
+	result = (&cappedPerNetworkPolicy{}).Distribute(units(1000), recipients[:2], 0)
+	require.Len(t, result, 2)
+	require.Zero(t, result["single_a"].Cmp(units(500).Int))
+	require.Zero(t, result["single_b"].Cmp(units(500).Int))
+
+	require.Empty(t, (&cappedPerNetworkPolicy{}).Distribute(units(1000), nil, 0))
+}
+
+func TestRewardNetworkOfRemoteAddress(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]string{
+		"10.0.0.1":           "10.0.0.0/24",
+		"10.0.0.255":         "10.0.0.0/24",
+		"::ffff:10.0.0.1":    "10.0.0.0/24",
+		"2001:db8:1:2::1":    "2001:db8:1::/48",
+		"2001:db8:1:ffff::1": "2001:db8:1::/48",
+		"not an address":     "not an address",
+	}
+	for input, expected := range testCases {
+		require.Equal(t, expected, rewardNetworkOfRemoteAddress(input), input)
+	}
+}
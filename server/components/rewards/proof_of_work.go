@@ -0,0 +1,111 @@
+package rewards
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"golang.org/x/crypto/argon2"
+)
+
+// parameters of the Argon2id function used in proof-of-work activity challenges.
+// Each attempt costs proofOfWorkMemory KiB of memory, which makes it impractical to solve many challenges in parallel
+const (
+	proofOfWorkTime      = 1
+	proofOfWorkMemory    = 16 * 1024
+	proofOfWorkThreads   = 1
+	proofOfWorkKeyLength = 32
+
+	// proofOfWorkBaseBits is the number of leading zero bits required at difficulty 0,
+	// i.e. an average of 2^proofOfWorkBaseBits attempts
+	proofOfWorkBaseBits = 6
+	// proofOfWorkMaxDifficulty caps the difficulty, as each level doubles the average solving time
+	proofOfWorkMaxDifficulty = 4
+	// proofOfWorkRiskPerDifficulty is by how much the risk score of a spectator must increase to raise the difficulty
+	proofOfWorkRiskPerDifficulty = RiskScoreEligibilityThreshold / 5
+)
+
+// keys of the activity challenge parameters set by the proof-of-work provider
+const (
+	ProofOfWorkParameterSalt      = "proof_of_work_salt"
+	ProofOfWorkParameterBits      = "proof_of_work_bits"
+	ProofOfWorkParameterTime      = "proof_of_work_time"
+	ProofOfWorkParameterMemory    = "proof_of_work_memory"
+	ProofOfWorkParameterThreads   = "proof_of_work_threads"
+	ProofOfWorkParameterKeyLength = "proof_of_work_key_length"
+)
+
+type proofOfWorkProvider struct{}
+
+// NewProofOfWorkActivityChallengeProvider returns a provider of memory-hard proof-of-work activity challenges.
+// Clients must find a nonce such that Argon2id(password = challenge ID + ":" + nonce, salt) has at least the requested
+// number of leading zero bits, and respond with the nonce in decimal
+func NewProofOfWorkActivityChallengeProvider() CheckedActivityChallengeProvider {
+	return &proofOfWorkProvider{}
+}
+
+func (p *proofOfWorkProvider) Type() ActivityChallengeType {
+	return ActivityChallengeTypeProofOfWork
+}
+
+func (p *proofOfWorkProvider) Tolerance(difficulty int) time.Duration {
+	// each difficulty level doubles the expected solving time
+	return 2*time.Minute + time.Duration(1<<min(max(difficulty, 0), proofOfWorkMaxDifficulty))*15*time.Second
+}
+
+func (p *proofOfWorkProvider) Prepare(challenge *ActivityChallenge, riskScore int) error {
+	salt := make([]byte, 16)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	challenge.Difficulty = min(max(challenge.Difficulty, riskScore/proofOfWorkRiskPerDifficulty), proofOfWorkMaxDifficulty)
+
+	if challenge.Parameters == nil {
+		challenge.Parameters = make(map[string]string)
+	}
+	challenge.Parameters[ProofOfWorkParameterSalt] = hex.EncodeToString(salt)
+	challenge.Parameters[ProofOfWorkParameterBits] = strconv.Itoa(proofOfWorkBaseBits + challenge.Difficulty)
+	challenge.Parameters[ProofOfWorkParameterTime] = strconv.Itoa(proofOfWorkTime)
+	challenge.Parameters[ProofOfWorkParameterMemory] = strconv.Itoa(proofOfWorkMemory)
+	challenge.Parameters[ProofOfWorkParameterThreads] = strconv.Itoa(proofOfWorkThreads)
+	challenge.Parameters[ProofOfWorkParameterKeyLength] = strconv.Itoa(proofOfWorkKeyLength)
+	return nil
+}
+
+func (p *proofOfWorkProvider) Check(ctx context.Context, challenge *ActivityChallenge, response string) (bool, error) {
+	salt, err := hex.DecodeString(challenge.Parameters[ProofOfWorkParameterSalt])
+	if err != nil || len(salt) == 0 {
+		return false, stacktrace.NewError("proof-of-work challenge was not prepared")
+	}
+	requiredBits, err := strconv.Atoi(challenge.Parameters[ProofOfWorkParameterBits])
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	nonce, err := strconv.ParseUint(response, 10, 64)
+	if err != nil {
+		// malformed response, not a server-side problem
+		return false, nil
+	}
+
+	hash := argon2.IDKey([]byte(challenge.ID+":"+strconv.FormatUint(nonce, 10)), salt,
+		proofOfWorkTime, proofOfWorkMemory, proofOfWorkThreads, proofOfWorkKeyLength)
+	return leadingZeroBits(hash) >= requiredBits, nil
+}
+
+func leadingZeroBits(b []byte) int {
+	count := 0
+	for _, v := range b {
+		if v != 0 {
+			return count + bits.LeadingZeros8(v)
+		}
+		count += 8
+	}
+	return count
+}
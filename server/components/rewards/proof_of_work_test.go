@@ -0,0 +1,80 @@
+package rewards
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadingZeroBits(t *testing.T) {
+	// maps input to expected result
+	testCases := map[string]int{
+		"":                 0,
+		"\x80":             0,
+		"\x01":             7,
+		"\x00":             8,
+		"\x00\x00":         16,
+		"\x00\x40\xff":     9,
+		"\x00\x00\x00\x01": 31,
+		"\x0f\x00":         4,
+	}
+	for input, expected := range testCases {
+		require.Equal(t, expected, leadingZeroBits([]byte(input)), "%x", input)
+	}
+}
+
+func TestProofOfWorkPrepare(t *testing.T) {
+	p := &proofOfWorkProvider{}
+
+	// maps risk score to expected difficulty
+	testCases := map[int]int{
+		0:                                  0,
+		proofOfWorkRiskPerDifficulty:       1,
+		RiskScoreEligibilityThreshold:      proofOfWorkMaxDifficulty,
+		10 * RiskScoreEligibilityThreshold: proofOfWorkMaxDifficulty,
+	}
+	for riskScore, expected := range testCases {
+		challenge := &ActivityChallenge{ID: "challenge"}
+		require.NoError(t, p.Prepare(challenge, riskScore))
+		require.Equal(t, expected, challenge.Difficulty, riskScore)
+		require.Equal(t, strconv.Itoa(proofOfWorkBaseBits+expected), challenge.Parameters[ProofOfWorkParameterBits])
+		require.Len(t, challenge.Parameters[ProofOfWorkParameterSalt], 32)
+	}
+
+	// the difficulty selected by the selection policy is kept if it is higher
+	challenge := &ActivityChallenge{ID: "challenge", Difficulty: 2}
+	require.NoError(t, p.Prepare(challenge, 0))
+	require.Equal(t, 2, challenge.Difficulty)
+}
+
+func TestProofOfWorkCheck(t *testing.T) {
+	p := &proofOfWorkProvider{}
+	ctx := context.Background()
+
+	_, err := p.Check(ctx, &ActivityChallenge{ID: "challenge"}, "0")
+	require.Error(t, err)
+
+	challenge := &ActivityChallenge{ID: "challenge"}
+	require.NoError(t, p.Prepare(challenge, 0))
+
+	passed, err := p.Check(ctx, challenge, "not a nonce")
+	require.NoError(t, err)
+	require.False(t, passed)
+
+	// no nonce can produce more leading zero bits than the key has
+	challenge.Parameters[ProofOfWorkParameterBits] = strconv.Itoa(proofOfWorkKeyLength*8 + 1)
+	passed, err = p.Check(ctx, challenge, "0")
+	require.NoError(t, err)
+	require.False(t, passed)
+
+	// lower the requirement so that a solution can be found quickly
+	challenge.Parameters[ProofOfWorkParameterBits] = "2"
+	solved := false
+	for nonce := uint64(0); nonce < 200 && !solved; nonce++ {
+		solved, err = p.Check(ctx, challenge, strconv.FormatUint(nonce, 10))
+		require.NoError(t, err)
+	}
+	require.True(t, solved)
+}
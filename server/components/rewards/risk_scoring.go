@@ -0,0 +1,235 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// RiskScoreEligibilityThreshold is the risk score at or above which spectators are not eligible for rewards
+const RiskScoreEligibilityThreshold = 100
+
+// number of activity challenge solve latencies kept per spectator, for the purposes of risk scoring
+const challengeSolveLatencySamples = 5
+
+// RiskSignal is a single contribution to the risk score of a spectator
+type RiskSignal struct {
+	Name        string
+	Score       int
+	Explanation string
+}
+
+// RiskAssessment is the aggregation of the risk signals of a spectator
+type RiskAssessment struct {
+	Score   int
+	Signals []RiskSignal
+}
+
+// Eligible returns whether the assessed spectator is not risky enough to be excluded from rewards
+func (a RiskAssessment) Eligible() bool {
+	return a.Score < RiskScoreEligibilityThreshold
+}
+
+func (a *RiskAssessment) add(name string, score int, explanation string, args ...interface{}) {
+	a.Score += score
+	a.Signals = append(a.Signals, RiskSignal{
+		Name:        name,
+		Score:       score,
+		Explanation: fmt.Sprintf(explanation, args...),
+	})
+}
+
+// signalsAboveZero returns a human-readable summary of the signals that contributed to the score
+func (a RiskAssessment) signalsAboveZero() string {
+	s := ""
+	for _, signal := range a.Signals {
+		if signal.Score > 0 {
+			if s != "" {
+				s += "; "
+			}
+			s += fmt.Sprintf("%s +%d: %s", signal.Name, signal.Score, signal.Explanation)
+		}
+	}
+	return s
+}
+
+type spectatorAccountHistory struct {
+	firstRewardAt     time.Time
+	everRewarded      bool
+	firstSessionAt    time.Time
+	hadSessions       bool
+	recentWithdrawals int
+}
+
+func (r *Handler) getSpectatorAccountHistory(ctxCtx context.Context, address string) (spectatorAccountHistory, error) {
+	if history, present := r.accountHistoryCache.Get(address); present {
+		return history, nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return spectatorAccountHistory{}, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var history spectatorAccountHistory
+	history.firstRewardAt, history.everRewarded, err = types.GetFirstRewardReceivedAt(ctx, address)
+	if err != nil {
+		return spectatorAccountHistory{}, stacktrace.Propagate(err, "")
+	}
+	history.firstSessionAt, history.hadSessions, err = types.GetFirstSpectatorSessionStartedAt(ctx, address)
+	if err != nil {
+		return spectatorAccountHistory{}, stacktrace.Propagate(err, "")
+	}
+	history.recentWithdrawals, err = types.CountWithdrawalsForAddressSince(ctx, address, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return spectatorAccountHistory{}, stacktrace.Propagate(err, "")
+	}
+
+	r.accountHistoryCache.SetDefault(address, history)
+	return history, nil
+}
+
+// assessSpectatorRisk aggregates the risk signals of a spectator. spectatorsOnSameRemoteAddress is the number of
+// connected spectators (including this one) sharing its uniquified remote address.
+// spectatorsMutex must be held by the caller
+func (r *Handler) assessSpectatorRisk(ctx context.Context, spectator *spectator, spectatorsOnSameRemoteAddress int) RiskAssessment {
+	history, err := r.getSpectatorAccountHistory(ctx, spectator.user.Address())
+	return r.assessSpectatorRiskWithHistory(ctx, spectator, spectatorsOnSameRemoteAddress, history, err)
+}
+
+// assessSpectatorRiskWithHistory is like assessSpectatorRisk, but takes the account history of the spectator (or the
+// error obtained when loading it), so that callers can load it before taking spectatorsMutex.
+// spectatorsMutex must be held by the caller
+func (r *Handler) assessSpectatorRiskWithHistory(ctx context.Context, spectator *spectator, spectatorsOnSameRemoteAddress int,
+	history spectatorAccountHistory, historyErr error) RiskAssessment {
+	assessment := RiskAssessment{}
+	address := spectator.user.Address()
+
+	skipsIPReputationChecks, err := r.moderationStore.LoadPaymentAddressSkipsIPReputationChecks(ctx, address)
+	if err != nil {
+		r.log.Println(stacktrace.Propagate(err, ""))
+	}
+	if skipsIPReputationChecks {
+		assessment.add("ip_reputation", 0, "IP reputation checks are skipped for this address")
+	} else {
+		badActorConfidence, rated, checked := r.ipReputationChecker.AddressReputation(spectator.remoteAddress)
+		switch {
+		case !checked:
+			// do not be generous and don't reward until they're checked
+			r.ipReputationChecker.EnqueueAddressForChecking(spectator.remoteAddress)
+			assessment.add("ip_reputation", RiskScoreEligibilityThreshold, "remote address reputation has not been checked yet")
+		case !rated:
+			// this happens when the reputation providers are failing, in which case everyone would be penalized alike
+			assessment.add("ip_reputation", 0, "remote address reputation could not be determined")
+		case badActorConfidence >= 0.95:
+			assessment.add("ip_reputation", RiskScoreEligibilityThreshold, "remote address appears to be a VPN, proxy or hosting provider")
+		default:
+			assessment.add("ip_reputation", int(badActorConfidence*50), "bad actor confidence for the remote address is %.2f", badActorConfidence)
+		}
+
+		asn, badASN, asnKnown := r.ipReputationChecker.AddressASN(spectator.remoteAddress)
+		switch {
+		case !asnKnown:
+			assessment.add("asn_reputation", 0, "AS number of the remote address is unknown")
+		case badASN:
+			assessment.add("asn_reputation", RiskScoreEligibilityThreshold, "remote address belongs to disallowed AS%d", asn)
+		default:
+			assessment.add("asn_reputation", 0, "remote address belongs to AS%d", asn)
+		}
+	}
+
+	if spectatorsOnSameRemoteAddress > 1 {
+		assessment.add("spectators_per_remote_address", min((spectatorsOnSameRemoteAddress-1)*15, 45),
+			"%d connected spectators share the remote address", spectatorsOnSameRemoteAddress)
+	} else {
+		assessment.add("spectators_per_remote_address", 0, "no other connected spectators share the remote address")
+	}
+
+	if len(spectator.recentSolveLatencies) >= 3 {
+		latencies := make([]time.Duration, len(spectator.recentSolveLatencies))
+		copy(latencies, spectator.recentSolveLatencies)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		median := latencies[len(latencies)/2]
+		score := 0
+		if median < 750*time.Millisecond {
+			score = 30
+		} else if median < 1500*time.Millisecond {
+			score = 15
+		}
+		assessment.add("challenge_solve_latency", score, "median activity challenge solve latency is %s over the last %d challenges",
+			median.Round(time.Millisecond), len(latencies))
+	} else {
+		assessment.add("challenge_solve_latency", 0, "too few activity challenges solved to judge solve latency")
+	}
+
+	skipsIntegrityChecks, err := r.moderationStore.LoadPaymentAddressSkipsClientIntegrityChecks(ctx, address)
+	if err != nil {
+		r.log.Println(stacktrace.Propagate(err, ""))
+	}
+	switch {
+	case !spectator.legitimate:
+		assessment.add("client_integrity", RiskScoreEligibilityThreshold, "failed the client integrity checks of the latest activity challenge")
+	case skipsIntegrityChecks:
+		assessment.add("client_integrity", 10, "client integrity checks are skipped for this address")
+	default:
+		assessment.add("client_integrity", min(spectator.legitimacyFailures*10, 30),
+			"failed client integrity checks %d times while connected", spectator.legitimacyFailures)
+	}
+
+	if historyErr != nil {
+		r.log.Println(stacktrace.Propagate(historyErr, ""))
+		// the remaining signals can't be computed, so don't be generous
+		assessment.add("account_history", RiskScoreEligibilityThreshold, "reward and withdrawal history of the address could not be loaded")
+		return assessment
+	}
+
+	// addresses that never received rewards must still age, otherwise the other signals could keep them from ever
+	// receiving their first reward
+	firstSeenAt := spectator.startedWatching
+	if history.hadSessions && history.firstSessionAt.Before(firstSeenAt) {
+		firstSeenAt = history.firstSessionAt
+	}
+	if history.everRewarded && history.firstRewardAt.Before(firstSeenAt) {
+		firstSeenAt = history.firstRewardAt
+	}
+	switch {
+	case time.Since(firstSeenAt) < 24*time.Hour:
+		assessment.add("account_age", 20, "address was first seen less than a day ago")
+	case time.Since(firstSeenAt) < 7*24*time.Hour:
+		assessment.add("account_age", 10, "address was first seen less than a week ago")
+	default:
+		assessment.add("account_age", 0, "address was first seen %d days ago", int(time.Since(firstSeenAt)/(24*time.Hour)))
+	}
+
+	withdrawalsScore := 0
+	if history.recentWithdrawals >= 10 {
+		withdrawalsScore = 20
+	} else if history.recentWithdrawals >= 5 {
+		withdrawalsScore = 10
+	}
+	assessment.add("withdrawal_pattern", withdrawalsScore, "%d withdrawals in the last 24 hours", history.recentWithdrawals)
+
+	return assessment
+}
+
+// SpectatorRiskAssessment returns the current risk assessment for the spectator with the given rewards address
+func (r *Handler) SpectatorRiskAssessment(ctx context.Context, address string) (RiskAssessment, bool) {
+	history, historyErr := r.getSpectatorAccountHistory(ctx, address)
+
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	spectator, ok := r.spectatorsByRewardAddress[address]
+	if !ok {
+		return RiskAssessment{}, false
+	}
+
+	return r.assessSpectatorRiskWithHistory(ctx, spectator, r.countConnectedSpectatorsOnRemoteAddress(spectator.remoteAddress),
+		history, historyErr), true
+}
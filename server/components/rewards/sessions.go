@@ -0,0 +1,128 @@
+package rewards
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	uuid "github.com/satori/go.uuid"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// spectatorSession accumulates what happens while a spectator is watching.
+// A session lasts from the moment the spectator is first registered until the moment they are purged, so reconnecting
+// shortly after disconnecting does not start a new session.
+// Ongoing sessions are saved periodically and on shutdown, and their record is updated until they end
+type spectatorSession struct {
+	mu                sync.Mutex
+	id                string
+	remoteAddressHash string
+	mediaWatched      int
+	challengesPassed  int
+	challengesFailed  int
+	rewardsEarned     payment.Amount
+}
+
+func newSpectatorSession(hashKey []byte, remoteAddress string) *spectatorSession {
+	// we don't need the actual remote address to tell sessions from the same place apart, so don't store it.
+	// the hash is keyed as the space of addresses is small enough to hash exhaustively
+	mac := hmac.New(sha256.New, hashKey)
+	mac.Write([]byte(utils.GetUniquifiedIP(remoteAddress)))
+	return &spectatorSession{
+		id:                uuid.NewV4().String(),
+		remoteAddressHash: hex.EncodeToString(mac.Sum(nil)),
+		rewardsEarned:     payment.NewAmount(),
+	}
+}
+
+func (s *spectatorSession) recordMediaWatched() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mediaWatched++
+}
+
+func (s *spectatorSession) recordActivityChallengeResult(passed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if passed {
+		s.challengesPassed++
+	} else {
+		s.challengesFailed++
+	}
+}
+
+func (s *spectatorSession) recordReward(amount payment.Amount) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rewardsEarned = payment.NewAmount(s.rewardsEarned.Int, amount.Int)
+}
+
+// sessionRecord returns the database record for the session of a spectator, as if it ended at the given instant.
+// spectatorsMutex must be held by the caller
+func (s *spectator) sessionRecord(endedAt time.Time) *types.SpectatorSession {
+	s.session.mu.Lock()
+	defer s.session.mu.Unlock()
+	return &types.SpectatorSession{
+		ID:                s.session.id,
+		RewardsAddress:    s.user.Address(),
+		RemoteAddressHash: s.session.remoteAddressHash,
+		StartedAt:         s.startedWatching,
+		EndedAt:           endedAt,
+		MediaWatched:      s.session.mediaWatched,
+		ChallengesPassed:  s.session.challengesPassed,
+		ChallengesFailed:  s.session.challengesFailed,
+		RewardsEarned:     s.session.rewardsEarned.Decimal(),
+	}
+}
+
+// spectatorsMutex must be held by the caller
+func (r *Handler) recordMediaWatchedBySpectators() {
+	for _, s := range r.spectatorsByRewardAddress {
+		if s.connectionCount > 0 {
+			s.session.recordMediaWatched()
+		}
+	}
+}
+
+// ongoingSpectatorSessions returns the records for the sessions of the spectators that were not purged yet.
+// Sessions of spectators that are still watching are recorded as ending now
+func (r *Handler) ongoingSpectatorSessions() []*types.SpectatorSession {
+	r.spectatorsMutex.RLock()
+	defer r.spectatorsMutex.RUnlock()
+
+	now := time.Now()
+	sessions := make([]*types.SpectatorSession, 0, len(r.spectatorsByRewardAddress))
+	for _, s := range r.spectatorsByRewardAddress {
+		endedAt := s.stoppedWatching
+		if endedAt.IsZero() {
+			endedAt = now
+		}
+		sessions = append(sessions, s.sessionRecord(endedAt))
+	}
+	return sessions
+}
+
+func (r *Handler) persistSpectatorSessions(ctxCtx context.Context, sessions []*types.SpectatorSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	err = types.UpdateSpectatorSessions(ctx, sessions)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
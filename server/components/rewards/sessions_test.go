@@ -0,0 +1,30 @@
+package rewards
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpectatorSessionRemoteAddressHash(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	otherKey := []byte("fedcba9876543210")
+
+	a := newSpectatorSession(key, "2001:db8::1")
+	require.Len(t, a.remoteAddressHash, 64)
+	require.NotEqual(t, a.id, newSpectatorSession(key, "2001:db8::1").id)
+
+	// addresses in the same /64 are considered to be the same
+	require.Equal(t, a.remoteAddressHash, newSpectatorSession(key, "2001:db8::2").remoteAddressHash)
+	require.NotEqual(t, a.remoteAddressHash, newSpectatorSession(key, "2001:db8:0:1::1").remoteAddressHash)
+
+	// the hash depends on the key, so it can't be reversed by hashing every address
+	require.NotEqual(t, a.remoteAddressHash, newSpectatorSession(otherKey, "2001:db8::1").remoteAddressHash)
+	unkeyed := sha256.Sum256([]byte("2001:db8::/64"))
+	require.NotEqual(t, hex.EncodeToString(unkeyed[:]), a.remoteAddressHash)
+
+	b := newSpectatorSession(key, "10.0.0.1")
+	require.NotEqual(t, b.remoteAddressHash, newSpectatorSession(key, "10.0.0.2").remoteAddressHash)
+}
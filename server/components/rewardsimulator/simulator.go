@@ -0,0 +1,351 @@
+package rewardsimulator
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// queueReconstructionMargin is how far before the start of the simulated time range we look for played media, in
+// order to reconstruct the conditions of the queue when the first simulated entries were enqueued
+const queueReconstructionMargin = 24 * time.Hour
+
+// eligibleEstimationWindow is the number of previous queue entries whose eligible spectator counts are averaged to
+// estimate the number of eligible spectators, mirroring what the rewards handler does
+const eligibleEstimationWindow = 3
+
+const queryBatchSize = 500
+
+// Options configures a replay of historical reward distributions
+type Options struct {
+	// Since and Until delimit the time range to replay, according to when each queue entry started playing
+	Since time.Time
+	Until time.Time
+	// Policy is the reward policy used to split the budget of each queue entry
+	Policy rewards.RewardPolicy
+	// FinalPricesMultiplier and MinimumPricesMultiplier are the price multipliers to use in the sandboxed pricer.
+	// Values lower than 1 leave the respective pricer default in place
+	FinalPricesMultiplier   int
+	MinimumPricesMultiplier int
+}
+
+// UserResult contains the actual and simulated amounts for a single user
+type UserResult struct {
+	RewardsAddress        string
+	ActualRewards         payment.Amount
+	SimulatedRewards      payment.Amount
+	ActualRequestCosts    payment.Amount
+	SimulatedRequestCosts payment.Amount
+}
+
+// RewardsDifference returns how much more (or less, if negative) the user would have received in the simulation
+func (u *UserResult) RewardsDifference() payment.Amount {
+	return payment.NewAmount(new(big.Int).Sub(u.SimulatedRewards.Int, u.ActualRewards.Int))
+}
+
+// RequestCostsDifference returns how much more (or less, if negative) the user would have paid in the simulation
+func (u *UserResult) RequestCostsDifference() payment.Amount {
+	return payment.NewAmount(new(big.Int).Sub(u.SimulatedRequestCosts.Int, u.ActualRequestCosts.Int))
+}
+
+// Result contains the outcome of a replay
+type Result struct {
+	MediaCount                int
+	ActualRequestCosts        payment.Amount
+	SimulatedRequestCosts     payment.Amount
+	ActualRewards             payment.Amount
+	SimulatedRewards          payment.Amount
+	ActualRequesterRewards    payment.Amount
+	SimulatedRequesterRewards payment.Amount
+	// Users contains the results for each user involved in the replayed time range,
+	// sorted by decreasing absolute difference in received rewards
+	Users []*UserResult
+}
+
+// sandboxConditions reproduces the queue conditions at the time a queue entry was enqueued, for the sandboxed pricers
+type sandboxConditions struct {
+	queueLength int
+	eligible    int
+}
+
+func (c *sandboxConditions) LengthUpToCursor() int {
+	return c.queueLength
+}
+
+func (c *sandboxConditions) EstimateEligibleSpectators() (int, bool) {
+	return c.eligible, true
+}
+
+type mediaHistory struct {
+	media              *types.PlayedMedia
+	crowdRewards       map[string]payment.Amount
+	requesterReward    payment.Amount
+	hasRequesterReward bool
+	skipTotal          payment.Amount
+	rainTotal          payment.Amount
+	rainedByRequester  payment.Amount
+}
+
+// Run replays the played media, received rewards and crowdfunded transactions in the specified time range through a
+// sandboxed pricer and the specified reward policy, comparing the outcome with what actually happened.
+// It only reads from the database and never interacts with wallets.
+//
+// Some information is not recorded historically and is therefore approximated:
+//   - the enqueuing mode (enqueue, play next or play now) and concealment of each entry: request costs are scaled by
+//     the ratio between the enqueue price under the sandboxed multipliers and under the default multipliers;
+//   - the spectators eligible for rewards: those who actually received rewards for each entry are considered eligible,
+//     all of them having watched the entire entry from a distinct remote address and without activity challenge streaks;
+//   - the eligibility of the requester for a share of the rain: requesters who received a share are considered eligible.
+func Run(ctxCtx context.Context, options Options) (*Result, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	playedMedia, err := types.GetPlayedMediaStartedBetween(ctx, options.Since.Add(-queueReconstructionMargin), options.Until)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	history, err := loadMediaHistory(ctx, playedMedia)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	discardLog := log.New(io.Discard, "", 0)
+	conditions := &sandboxConditions{}
+	referencePricer := pricer.New(discardLog, conditions, nil)
+	referencePricer.SetEligibleSpectatorsEstimator(conditions)
+	sandboxPricer := pricer.New(discardLog, conditions, nil)
+	sandboxPricer.SetEligibleSpectatorsEstimator(conditions)
+	sandboxPricer.SetFinalPricesMultiplier(options.FinalPricesMultiplier)
+	sandboxPricer.SetMinimumPricesMultiplier(options.MinimumPricesMultiplier)
+
+	result := &Result{
+		ActualRequestCosts:        payment.NewAmount(),
+		SimulatedRequestCosts:     payment.NewAmount(),
+		ActualRewards:             payment.NewAmount(),
+		SimulatedRewards:          payment.NewAmount(),
+		ActualRequesterRewards:    payment.NewAmount(),
+		SimulatedRequesterRewards: payment.NewAmount(),
+	}
+	users := make(map[string]*UserResult)
+	getUser := func(address string) *UserResult {
+		u, ok := users[address]
+		if !ok {
+			u = &UserResult{
+				RewardsAddress:        address,
+				ActualRewards:         payment.NewAmount(),
+				SimulatedRewards:      payment.NewAmount(),
+				ActualRequestCosts:    payment.NewAmount(),
+				SimulatedRequestCosts: payment.NewAmount(),
+			}
+			users[address] = u
+		}
+		return u
+	}
+
+	for _, h := range history {
+		m := h.media
+		if m.StartedAt.Before(options.Since) {
+			// only used for reconstructing the queue conditions
+			continue
+		}
+		result.MediaCount++
+
+		conditions.queueLength = reconstructQueueLength(history, h)
+		conditions.eligible = estimateEligibleSpectators(history, h)
+
+		actualCost := payment.NewAmountFromDecimal(m.RequestCost)
+		simulatedCost := payment.NewAmount(actualCost.Int)
+		referencePrice := referencePricer.ComputeEnqueuePricing(time.Duration(m.MediaLength), m.Unskippable, false).EnqueuePrice
+		if referencePrice.Sign() > 0 {
+			sandboxPrice := sandboxPricer.ComputeEnqueuePricing(time.Duration(m.MediaLength), m.Unskippable, false).EnqueuePrice
+			simulatedCost.Mul(simulatedCost.Int, sandboxPrice.Int)
+			simulatedCost.Div(simulatedCost.Int, referencePrice.Int)
+			simulatedCost.Div(simulatedCost.Int, pricer.PriceRoundingFactor)
+			simulatedCost.Mul(simulatedCost.Int, pricer.PriceRoundingFactor)
+		}
+		result.ActualRequestCosts.Add(result.ActualRequestCosts.Int, actualCost.Int)
+		result.SimulatedRequestCosts.Add(result.SimulatedRequestCosts.Int, simulatedCost.Int)
+		if m.RequestedBy != "" {
+			requester := getUser(m.RequestedBy)
+			requester.ActualRequestCosts.Add(requester.ActualRequestCosts.Int, actualCost.Int)
+			requester.SimulatedRequestCosts.Add(requester.SimulatedRequestCosts.Int, simulatedCost.Int)
+		}
+
+		for address, amount := range h.crowdRewards {
+			u := getUser(address)
+			u.ActualRewards.Add(u.ActualRewards.Int, amount.Int)
+			result.ActualRewards.Add(result.ActualRewards.Int, amount.Int)
+		}
+		if h.hasRequesterReward {
+			u := getUser(m.RequestedBy)
+			u.ActualRewards.Add(u.ActualRewards.Int, h.requesterReward.Int)
+			result.ActualRequesterRewards.Add(result.ActualRequesterRewards.Int, h.requesterReward.Int)
+		}
+
+		if len(h.crowdRewards) == 0 {
+			// the entry was either reimbursed or had no budget, and that wouldn't change
+			continue
+		}
+
+		rainBudget := payment.NewAmount(h.rainTotal.Int)
+		if h.hasRequesterReward {
+			requesterReward := options.Policy.RequesterShareOfRain(
+				payment.NewAmount(new(big.Int).Sub(h.rainTotal.Int, h.rainedByRequester.Int)))
+			rainBudget.Sub(rainBudget.Int, requesterReward.Int)
+
+			u := getUser(m.RequestedBy)
+			u.SimulatedRewards.Add(u.SimulatedRewards.Int, requesterReward.Int)
+			result.SimulatedRequesterRewards.Add(result.SimulatedRequesterRewards.Int, requesterReward.Int)
+		}
+		budget := payment.NewAmount(simulatedCost.Int, h.skipTotal.Int, rainBudget.Int)
+
+		playedFor := m.EndedAt.Time.Sub(m.StartedAt)
+		recipients := make([]rewards.RewardRecipient, 0, len(h.crowdRewards))
+		for address := range h.crowdRewards {
+			recipients = append(recipients, rewards.RewardRecipient{
+				RewardsAddress: address,
+				RemoteAddress:  address,
+				WatchedFor:     playedFor,
+			})
+		}
+		for address, amount := range options.Policy.Distribute(budget, recipients, playedFor) {
+			u := getUser(address)
+			u.SimulatedRewards.Add(u.SimulatedRewards.Int, amount.Int)
+			result.SimulatedRewards.Add(result.SimulatedRewards.Int, amount.Int)
+		}
+	}
+
+	result.Users = make([]*UserResult, 0, len(users))
+	for _, u := range users {
+		result.Users = append(result.Users, u)
+	}
+	sort.Slice(result.Users, func(i, j int) bool {
+		di := new(big.Int).Abs(result.Users[i].RewardsDifference().Int)
+		dj := new(big.Int).Abs(result.Users[j].RewardsDifference().Int)
+		if c := di.Cmp(dj); c != 0 {
+			return c > 0
+		}
+		return result.Users[i].RewardsAddress < result.Users[j].RewardsAddress
+	})
+	return result, nil
+}
+
+func loadMediaHistory(ctx *transaction.WrappingContext, playedMedia []*types.PlayedMedia) ([]*mediaHistory, error) {
+	history := make([]*mediaHistory, len(playedMedia))
+	historyByMediaID := make(map[string]*mediaHistory, len(playedMedia))
+	for i, m := range playedMedia {
+		history[i] = &mediaHistory{
+			media:             m,
+			crowdRewards:      make(map[string]payment.Amount),
+			requesterReward:   payment.NewAmount(),
+			skipTotal:         payment.NewAmount(),
+			rainTotal:         payment.NewAmount(),
+			rainedByRequester: payment.NewAmount(),
+		}
+		historyByMediaID[m.ID] = history[i]
+	}
+
+	for start := 0; start < len(playedMedia); start += queryBatchSize {
+		end := start + queryBatchSize
+		if end > len(playedMedia) {
+			end = len(playedMedia)
+		}
+		ids := make([]string, 0, end-start)
+		for _, m := range playedMedia[start:end] {
+			ids = append(ids, m.ID)
+		}
+
+		receivedRewards, err := types.GetReceivedRewardsForMedia(ctx, ids)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		for _, reward := range receivedRewards {
+			h := historyByMediaID[reward.Media]
+			amount := payment.NewAmountFromDecimal(reward.Amount)
+			if reward.RewardsAddress == h.media.RequestedBy {
+				// requesters are never eligible for the crowd rewards of their own entries
+				h.requesterReward.Add(h.requesterReward.Int, amount.Int)
+				h.hasRequesterReward = true
+				continue
+			}
+			if existing, ok := h.crowdRewards[reward.RewardsAddress]; ok {
+				amount.Add(amount.Int, existing.Int)
+			}
+			h.crowdRewards[reward.RewardsAddress] = amount
+		}
+
+		crowdfundedTransactions, err := types.GetCrowdfundedTransactionsForMedia(ctx, ids)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		for _, tx := range crowdfundedTransactions {
+			h := historyByMediaID[*tx.ForMedia]
+			amount := payment.NewAmountFromDecimal(tx.Amount)
+			switch tx.TransactionType {
+			case types.CrowdfundedTransactionTypeSkip:
+				h.skipTotal.Add(h.skipTotal.Int, amount.Int)
+			case types.CrowdfundedTransactionTypeRain:
+				h.rainTotal.Add(h.rainTotal.Int, amount.Int)
+				if tx.FromAddress == h.media.RequestedBy {
+					h.rainedByRequester.Add(h.rainedByRequester.Int, amount.Int)
+				}
+			}
+		}
+	}
+	return history, nil
+}
+
+// reconstructQueueLength returns the length of the queue when the specified entry was enqueued, including the entry
+// that was playing at the time
+func reconstructQueueLength(history []*mediaHistory, entry *mediaHistory) int {
+	length := 0
+	enqueuedAt := entry.media.EnqueuedAt
+	for _, h := range history {
+		if h == entry || h.media.EnqueuedAt.After(enqueuedAt) {
+			continue
+		}
+		if h.media.EndedAt.Valid && h.media.EndedAt.Time.After(enqueuedAt) {
+			length++
+		}
+	}
+	return length
+}
+
+// estimateEligibleSpectators returns the average number of spectators rewarded for the entries that finished playing
+// most recently before the specified entry was enqueued
+func estimateEligibleSpectators(history []*mediaHistory, entry *mediaHistory) int {
+	previous := []*mediaHistory{}
+	for _, h := range history {
+		if h != entry && h.media.EndedAt.Valid && !h.media.EndedAt.Time.After(entry.media.EnqueuedAt) && len(h.crowdRewards) > 0 {
+			previous = append(previous, h)
+		}
+	}
+	if len(previous) == 0 {
+		return 1
+	}
+	sort.Slice(previous, func(i, j int) bool {
+		return previous[i].media.EndedAt.Time.After(previous[j].media.EndedAt.Time)
+	})
+	if len(previous) > eligibleEstimationWindow {
+		previous = previous[:eligibleEstimationWindow]
+	}
+	total := 0
+	for _, h := range previous {
+		total += len(h.crowdRewards)
+	}
+	return total / len(previous)
+}
@@ -411,6 +411,13 @@ func (s *Manager) RainAccountStatus() *RainAccountStatus {
 	}
 }
 
+// RainedByRequester returns how much of the current rain balance was contributed by the requester of the current media
+func (s *Manager) RainedByRequester() payment.Amount {
+	s.accountMovementLock.Lock()
+	defer s.accountMovementLock.Unlock()
+	return payment.NewAmount(s.rainedByRequester.Int)
+}
+
 func (s *Manager) UpdateSkipThreshold(resetChange bool) {
 	defer func() {
 		// start by deferring this since SkipAccountStatus must be called outside of the lock
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/proto"
 	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/appeditor"
 	"github.com/tnyim/jungletv/server/components/apprunner"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
@@ -295,6 +297,136 @@ func (s *grpcServer) ImportApplication(ctx context.Context, r *proto.ImportAppli
 	return &proto.ImportApplicationResponse{}, nil
 }
 
+func (s *grpcServer) ExportApplicationBundle(ctx context.Context, r *proto.ExportApplicationBundleRequest) (*proto.ExportApplicationBundleResponse, error) {
+	bundleContent, err := s.appEditor.CreateApplicationBundle(ctx, r.ApplicationId)
+	if err != nil {
+		if errors.Is(err, appeditor.ErrBundleSigningNotConfigured) {
+			return nil, status.Error(codes.FailedPrecondition, "application bundle signing is not configured on this server")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.ExportApplicationBundleResponse{
+		BundleName:    r.ApplicationId + ".jtvbundle.zip",
+		BundleType:    "application/zip",
+		BundleContent: bundleContent,
+	}, nil
+}
+
+func convertBundleVerificationError(err error) error {
+	switch {
+	case errors.Is(err, appeditor.ErrBundleInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, appeditor.ErrBundleSignatureInvalid):
+		return status.Error(codes.InvalidArgument, "invalid bundle signature")
+	case errors.Is(err, appeditor.ErrBundlePublisherNotTrusted):
+		return status.Error(codes.PermissionDenied, "bundle publisher is not trusted")
+	case errors.Is(err, appeditor.ErrBundleRuntimeVersionUnsupported):
+		return status.Error(codes.FailedPrecondition, "bundle requires a newer application runtime")
+	case errors.Is(err, appeditor.ErrBundleRuntimeDowngrade):
+		return status.Error(codes.FailedPrecondition, "bundle would downgrade the application runtime version")
+	case errors.Is(err, appeditor.ErrBundleChangedSincePreview):
+		return status.Error(codes.FailedPrecondition, "bundle does not match the previewed bundle")
+	}
+	return stacktrace.Propagate(err, "")
+}
+
+func (s *grpcServer) PreviewApplicationBundleImport(ctx context.Context, r *proto.PreviewApplicationBundleImportRequest) (*proto.PreviewApplicationBundleImportResponse, error) {
+	preview, err := s.appEditor.PreviewApplicationBundleImport(ctx, r.BundleContent, !r.AppendOnly)
+	if err != nil {
+		return nil, convertBundleVerificationError(err)
+	}
+
+	manifestFiles := make([]*proto.ApplicationBundleManifestFile, len(preview.Manifest.Files))
+	for i, file := range preview.Manifest.Files {
+		manifestFiles[i] = &proto.ApplicationBundleManifestFile{
+			Name:   file.Name,
+			Type:   file.Type,
+			Public: file.Public,
+			Size:   file.Size,
+			Sha256: file.SHA256,
+		}
+	}
+
+	fileChanges := make([]*proto.ApplicationBundleFileChange, len(preview.FileChanges))
+	for i, change := range preview.FileChanges {
+		fileChanges[i] = &proto.ApplicationBundleFileChange{
+			Name:          change.Name,
+			Change:        convertBundleFileChangeType(change.Change),
+			TypeChanged:   change.TypeChanged,
+			PublicChanged: change.PublicChanged,
+			Diff:          change.Diff,
+		}
+	}
+
+	response := &proto.PreviewApplicationBundleImportResponse{
+		Manifest: &proto.ApplicationBundleManifest{
+			FormatVersion:      int32(preview.Manifest.FormatVersion),
+			ApplicationId:      preview.Manifest.ApplicationID,
+			ApplicationVersion: timestamppb.New(preview.Manifest.ApplicationVersion),
+			Author:             preview.Manifest.Author,
+			RuntimeVersion:     int32(preview.Manifest.RuntimeVersion),
+			CreatedAt:          timestamppb.New(preview.Manifest.CreatedAt),
+			PublisherKey:       preview.Manifest.PublisherKey,
+			Files:              manifestFiles,
+		},
+		PublisherName:      preview.PublisherName,
+		BundleChecksum:     preview.BundleChecksum,
+		ApplicationExists:  preview.ApplicationExists,
+		ApplicationRunning: preview.ApplicationRunning,
+		FileChanges:        fileChanges,
+	}
+	if preview.ApplicationExists {
+		response.CurrentApplicationVersion = timestamppb.New(time.Time(preview.CurrentVersion))
+	}
+	return response, nil
+}
+
+func convertBundleFileChangeType(orig appeditor.BundleFileChangeType) proto.ApplicationBundleFileChangeType {
+	switch orig {
+	case appeditor.BundleFileAdded:
+		return proto.ApplicationBundleFileChangeType_APPLICATION_BUNDLE_FILE_ADDED
+	case appeditor.BundleFileModified:
+		return proto.ApplicationBundleFileChangeType_APPLICATION_BUNDLE_FILE_MODIFIED
+	case appeditor.BundleFileDeleted:
+		return proto.ApplicationBundleFileChangeType_APPLICATION_BUNDLE_FILE_DELETED
+	default:
+		return proto.ApplicationBundleFileChangeType_APPLICATION_BUNDLE_FILE_UNCHANGED
+	}
+}
+
+func (s *grpcServer) ImportApplicationBundle(ctx context.Context, r *proto.ImportApplicationBundleRequest) (*proto.ImportApplicationBundleResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	manifest, err := s.appEditor.ImportApplicationBundle(ctx, r.BundleContent, r.BundleChecksum, !r.AppendOnly, moderator)
+	if err != nil {
+		return nil, convertBundleVerificationError(err)
+	}
+
+	s.log.Printf("Application bundle for application with ID %s (version %v, author %s) imported by %s (remote address %s)",
+		manifest.ApplicationID, manifest.ApplicationVersion, manifest.Author, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Bundle for application with ID `%s` (author %s) imported by: %s (%s)",
+				manifest.ApplicationID,
+				manifest.Author,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ImportApplicationBundleResponse{
+		ApplicationId: manifest.ApplicationID,
+	}, nil
+}
+
 func (s *grpcServer) TypeScriptTypeDefinitions(ctx context.Context, r *proto.TypeScriptTypeDefinitionsRequest) (*proto.TypeScriptTypeDefinitionsResponse, error) {
 	fileContents := []byte{}
 	if s.typeScriptTypeDefinitionsFile != "" {
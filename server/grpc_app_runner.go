@@ -27,17 +27,25 @@ func (s *grpcServer) LaunchApplication(ctx context.Context, r *proto.LaunchAppli
 		return nil, status.Error(codes.Unauthenticated, "missing user claims")
 	}
 
-	err := s.appRunner.LaunchApplication(r.Id)
+	launch := s.appRunner.LaunchApplication
+	debuggingMsg := ""
+	if r.EnableDebugging {
+		launch = s.appRunner.LaunchApplicationWithDebugging
+		debuggingMsg = " with debugging enabled"
+	}
+
+	err := launch(r.Id)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
-	s.log.Printf("Application with ID %s launched by %s (remote address %s)", r.Id, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+	s.log.Printf("Application with ID %s launched%s by %s (remote address %s)", r.Id, debuggingMsg, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
 
 	if s.modLogWebhook != nil {
 		_, err = s.modLogWebhook.SendContent(
-			fmt.Sprintf("Application with ID `%s` launched by: %s (%s)",
+			fmt.Sprintf("Application with ID `%s` launched%s by: %s (%s)",
 				r.Id,
+				debuggingMsg,
 				moderator.Address()[:14],
 				moderator.ModeratorName()))
 		if err != nil {
@@ -282,3 +290,266 @@ func (s *grpcServer) EvaluateExpressionOnApplication(ctx context.Context, r *pro
 		ExecutionTime: durationpb.New(executionTime),
 	}, nil
 }
+
+func (s *grpcServer) DebugApplication(r *proto.DebugApplicationRequest, stream proto.JungleTV_DebugApplicationServer) error {
+	moderator := authinterceptor.UserClaimsFromContext(stream.Context())
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	session, err := s.appRunner.AttachDebugger(r.ApplicationId)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrApplicationNotInstantiated) {
+			return status.Error(codes.NotFound, "application not running")
+		}
+		if errors.Is(err, apprunner.ErrApplicationNotDebuggable) {
+			return status.Error(codes.FailedPrecondition, "application not launched with debugging enabled")
+		}
+		if errors.Is(err, apprunner.ErrDebuggerAlreadyAttached) {
+			return status.Error(codes.FailedPrecondition, "a debugger is already attached to this application")
+		}
+		return stacktrace.Propagate(err, "")
+	}
+	// the debugger is detached when the client disconnects, so that the application does not remain paused forever
+	defer session.Detach()
+
+	onPaused, pausedU := session.Paused().Subscribe(event.BufferAll)
+	defer pausedU()
+	onResumed, resumedU := session.Resumed().Subscribe(event.BufferAll)
+	defer resumedU()
+	onDetached, detachedU := session.Detached().Subscribe(event.BufferFirst)
+	defer detachedU()
+
+	s.log.Printf("Debugger attached to application with ID %s by %s (remote address %s)", r.ApplicationId, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(stream.Context()))
+
+	err = stream.Send(&proto.ApplicationDebuggerEvent{
+		Event: &proto.ApplicationDebuggerEvent_Attached{
+			Attached: &proto.ApplicationDebuggerAttachedEvent{
+				SessionId: session.ID(),
+			},
+		},
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	heartbeat := time.NewTicker(5 * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case state := <-onPaused:
+			err = stream.Send(&proto.ApplicationDebuggerEvent{
+				Event: &proto.ApplicationDebuggerEvent_Paused{
+					Paused: convertDebuggerPausedState(state),
+				},
+			})
+		case <-onResumed:
+			err = stream.Send(&proto.ApplicationDebuggerEvent{
+				Event: &proto.ApplicationDebuggerEvent_Resumed{
+					Resumed: &proto.ApplicationDebuggerResumedEvent{},
+				},
+			})
+		case <-onDetached:
+			return nil
+		case <-heartbeat.C:
+			err = stream.Send(&proto.ApplicationDebuggerEvent{
+				Event: &proto.ApplicationDebuggerEvent_IsHeartbeat{
+					IsHeartbeat: true,
+				},
+			})
+		case <-stream.Context().Done():
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to send debugger event")
+		}
+	}
+}
+
+func convertDebuggerPausedState(orig apprunner.DebuggerPausedState) *proto.ApplicationDebuggerPausedEvent {
+	reason, ok := map[apprunner.DebuggerPauseReason]proto.ApplicationDebuggerPauseReason{
+		apprunner.DebuggerPauseReasonBreakpoint:     proto.ApplicationDebuggerPauseReason_APPLICATION_DEBUGGER_PAUSE_REASON_BREAKPOINT,
+		apprunner.DebuggerPauseReasonStep:           proto.ApplicationDebuggerPauseReason_APPLICATION_DEBUGGER_PAUSE_REASON_STEP,
+		apprunner.DebuggerPauseReasonPauseRequested: proto.ApplicationDebuggerPauseReason_APPLICATION_DEBUGGER_PAUSE_REASON_PAUSE_REQUESTED,
+	}[orig.Reason]
+	if !ok {
+		reason = proto.ApplicationDebuggerPauseReason_UNKNOWN_APPLICATION_DEBUGGER_PAUSE_REASON
+	}
+	callStack := make([]*proto.ApplicationDebuggerStackFrame, len(orig.CallStack))
+	for i, frame := range orig.CallStack {
+		callStack[i] = &proto.ApplicationDebuggerStackFrame{
+			FunctionName:   frame.FunctionName,
+			Location:       convertDebuggerLocation(frame.Location),
+			ScopeAvailable: frame.ScopeAvailable,
+		}
+	}
+	return &proto.ApplicationDebuggerPausedEvent{
+		Reason:    reason,
+		Location:  convertDebuggerLocation(orig.Location),
+		CallStack: callStack,
+	}
+}
+
+func convertDebuggerLocation(orig apprunner.DebuggerLocation) *proto.ApplicationDebuggerLocation {
+	return &proto.ApplicationDebuggerLocation{
+		File:   orig.File,
+		Line:   int32(orig.Line),
+		Column: int32(orig.Column),
+	}
+}
+
+func (s *grpcServer) ApplicationDebuggerCommand(ctx context.Context, r *proto.ApplicationDebuggerCommandRequest) (*proto.ApplicationDebuggerCommandResponse, error) {
+	session, err := s.appRunner.DebuggerSession(r.ApplicationId, r.SessionId)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrDebuggerSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "debugger session not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.ApplicationDebuggerCommandResponse{}
+	switch command := r.Command.(type) {
+	case *proto.ApplicationDebuggerCommandRequest_SetBreakpoints:
+		lines := make([]int, len(command.SetBreakpoints.Lines))
+		for i, line := range command.SetBreakpoints.Lines {
+			lines[i] = int(line)
+		}
+		var validLines []int
+		validLines, err = session.SetBreakpoints(command.SetBreakpoints.File, lines)
+		result := &proto.ApplicationDebuggerSetBreakpointsResult{
+			ValidLines: make([]int32, len(validLines)),
+		}
+		for i, line := range validLines {
+			result.ValidLines[i] = int32(line)
+		}
+		response.Result = &proto.ApplicationDebuggerCommandResponse_SetBreakpoints{
+			SetBreakpoints: result,
+		}
+	case *proto.ApplicationDebuggerCommandRequest_Resume:
+		err = session.Resume(ctx)
+	case *proto.ApplicationDebuggerCommandRequest_StepOver:
+		err = session.StepOver(ctx)
+	case *proto.ApplicationDebuggerCommandRequest_StepInto:
+		err = session.StepInto(ctx)
+	case *proto.ApplicationDebuggerCommandRequest_StepOut:
+		err = session.StepOut(ctx)
+	case *proto.ApplicationDebuggerCommandRequest_Pause:
+		err = session.RequestPause()
+	case *proto.ApplicationDebuggerCommandRequest_Evaluate:
+		var successful bool
+		var result string
+		successful, result, err = session.Evaluate(ctx, int(command.Evaluate.FrameIndex), command.Evaluate.Expression)
+		response.Result = &proto.ApplicationDebuggerCommandResponse_Evaluate{
+			Evaluate: &proto.ApplicationDebuggerEvaluateResult{
+				Successful: successful,
+				Result:     result,
+			},
+		}
+	case *proto.ApplicationDebuggerCommandRequest_Scope:
+		var variables []apprunner.DebuggerVariable
+		variables, err = session.Scope(ctx, int(command.Scope.FrameIndex))
+		result := &proto.ApplicationDebuggerScopeResult{
+			Variables: make([]*proto.ApplicationDebuggerVariable, len(variables)),
+		}
+		for i, variable := range variables {
+			result.Variables[i] = &proto.ApplicationDebuggerVariable{
+				Name:  variable.Name,
+				Value: variable.Value,
+			}
+		}
+		response.Result = &proto.ApplicationDebuggerCommandResponse_Scope{
+			Scope: result,
+		}
+	case *proto.ApplicationDebuggerCommandRequest_Detach:
+		session.Detach()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown debugger command")
+	}
+	if err != nil {
+		if errors.Is(err, apprunner.ErrDebuggerSessionDetached) {
+			return nil, status.Error(codes.NotFound, "debugger session not found")
+		}
+		if errors.Is(err, apprunner.ErrApplicationNotPaused) {
+			return nil, status.Error(codes.FailedPrecondition, "application execution not paused")
+		}
+		if errors.Is(err, apprunner.ErrInvalidStackFrame) {
+			return nil, status.Error(codes.InvalidArgument, "invalid stack frame")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return response, nil
+}
+
+func (s *grpcServer) StartApplicationProfiler(ctx context.Context, r *proto.StartApplicationProfilerRequest) (*proto.StartApplicationProfilerResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	err := s.appRunner.StartProfilingApplication(r.ApplicationId)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrApplicationNotInstantiated) || errors.Is(err, apprunner.ErrApplicationInstanceNotRunning) {
+			return nil, status.Error(codes.NotFound, "application not running")
+		}
+		if errors.Is(err, apprunner.ErrProfilerAlreadyActive) {
+			return nil, status.Error(codes.FailedPrecondition, "another application is already being profiled")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Profiler started for application with ID %s by %s (remote address %s)", r.ApplicationId, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	return &proto.StartApplicationProfilerResponse{}, nil
+}
+
+func (s *grpcServer) StopApplicationProfiler(ctx context.Context, r *proto.StopApplicationProfilerRequest) (*proto.StopApplicationProfilerResponse, error) {
+	profile, duration, err := s.appRunner.StopProfilingApplication(r.ApplicationId)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrProfilerNotActive) {
+			return nil, status.Error(codes.FailedPrecondition, "profiler not active for this application")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.StopApplicationProfilerResponse{
+		Profile:  profile,
+		Duration: durationpb.New(duration),
+	}, nil
+}
+
+func (s *grpcServer) ApplicationHeapSnapshot(ctx context.Context, r *proto.ApplicationHeapSnapshotRequest) (*proto.ApplicationHeapSnapshotResponse, error) {
+	maxEntries := int(r.MaxEntries)
+	if maxEntries <= 0 || maxEntries > 1000 {
+		maxEntries = 1000
+	}
+
+	snapshot, err := s.appRunner.TakeHeapSnapshot(ctx, r.ApplicationId, maxEntries)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrApplicationNotInstantiated) || errors.Is(err, apprunner.ErrApplicationInstanceNotRunning) {
+			return nil, status.Error(codes.NotFound, "application not running")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	entries := make([]*proto.ApplicationHeapSnapshotEntry, len(snapshot.Entries))
+	for i, entry := range snapshot.Entries {
+		entries[i] = &proto.ApplicationHeapSnapshotEntry{
+			RootName:      entry.RootName,
+			RetainedSize:  entry.RetainedSize,
+			ReachableSize: entry.ReachableSize,
+			ObjectCount:   int32(entry.ObjectCount),
+		}
+	}
+
+	return &proto.ApplicationHeapSnapshotResponse{
+		Entries:          entries,
+		TotalSize:        snapshot.TotalSize,
+		TotalObjectCount: int32(snapshot.TotalObjectCount),
+		Truncated:        snapshot.Truncated,
+		ExecutionTime:    durationpb.New(snapshot.ExecutionTime),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/apprunner"
+	"github.com/tnyim/jungletv/server/components/apprunner/modules/settings"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) ApplicationSettings(ctx context.Context, r *proto.ApplicationSettingsRequest) (*proto.ApplicationSettingsResponse, error) {
+	values, err := s.appRunner.ApplicationSettings(ctx, r.ApplicationId)
+	if err != nil {
+		if errors.Is(err, apprunner.ErrApplicationNotFound) {
+			return nil, status.Error(codes.NotFound, "application not found")
+		}
+		if errors.Is(err, settings.ErrInvalidSchema) {
+			return nil, status.Errorf(codes.FailedPrecondition, "the application has an invalid %s file", settings.SchemaFileName)
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoSettings := make([]*proto.ApplicationSetting, len(values))
+	for i, value := range values {
+		protoSettings[i], err = s.convertApplicationSettingValue(ctx, value)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	return &proto.ApplicationSettingsResponse{
+		Settings: protoSettings,
+	}, nil
+}
+
+func (s *grpcServer) convertApplicationSettingValue(ctx context.Context, value apprunner.ApplicationSettingValue) (*proto.ApplicationSetting, error) {
+	d := value.Definition
+	defaultValueJSON, err := sonic.MarshalString(d.Default)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	valueJSON, err := sonic.MarshalString(value.Value)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	optionsJSON := make([]string, len(d.Options))
+	for i, option := range d.Options {
+		optionsJSON[i], err = sonic.MarshalString(option)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	result := &proto.ApplicationSetting{
+		Key:              d.Key,
+		Type:             string(d.Type),
+		Label:            d.Label,
+		Description:      d.Description,
+		DefaultValueJson: defaultValueJSON,
+		ValueJson:        valueJSON,
+		IsDefault:        value.IsDefault,
+		Minimum:          d.Minimum,
+		Maximum:          d.Maximum,
+		OptionsJson:      optionsJSON,
+	}
+	if d.MinLength != nil {
+		minLength := int32(*d.MinLength)
+		result.MinLength = &minLength
+	}
+	if d.MaxLength != nil {
+		maxLength := int32(*d.MaxLength)
+		result.MaxLength = &maxLength
+	}
+	if d.Pattern != "" {
+		pattern := d.Pattern
+		result.Pattern = &pattern
+	}
+	if !value.IsDefault {
+		result.UpdatedAt = timestamppb.New(value.UpdatedAt)
+		result.UpdatedBy = s.userSerializer(ctx, auth.NewAddressOnlyUser(value.UpdatedBy))
+	}
+	return result, nil
+}
+
+func (s *grpcServer) UpdateApplicationSetting(ctx context.Context, r *proto.UpdateApplicationSettingRequest) (*proto.UpdateApplicationSettingResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	var err error
+	var newValueDescription string
+	switch update := r.Update.(type) {
+	case *proto.UpdateApplicationSettingRequest_ValueJson:
+		err = s.appRunner.SetApplicationSetting(ctx, r.ApplicationId, r.Key, update.ValueJson, moderator)
+		newValueDescription = fmt.Sprintf("set to `%s`", update.ValueJson)
+	case *proto.UpdateApplicationSettingRequest_ResetToDefault:
+		err = s.appRunner.ResetApplicationSetting(ctx, r.ApplicationId, r.Key)
+		newValueDescription = "reset to its default value"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing update")
+	}
+	if err != nil {
+		var validationError *settings.ValidationError
+		if errors.Is(err, apprunner.ErrApplicationNotFound) {
+			return nil, status.Error(codes.NotFound, "application not found")
+		} else if errors.Is(err, settings.ErrSettingNotFound) {
+			return nil, status.Error(codes.NotFound, "setting not found")
+		} else if errors.Is(err, settings.ErrInvalidSchema) {
+			return nil, status.Errorf(codes.FailedPrecondition, "the application has an invalid %s file", settings.SchemaFileName)
+		} else if errors.As(err, &validationError) {
+			return nil, status.Error(codes.InvalidArgument, validationError.Reason)
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Setting %s of application with ID %s %s by %s (remote address %s)", r.Key, r.ApplicationId, newValueDescription, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Setting `%s` of application with ID `%s` %s by: %s (%s)",
+				r.Key,
+				r.ApplicationId,
+				newValueDescription,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.UpdateApplicationSettingResponse{}, nil
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -19,6 +20,7 @@ import (
 	"github.com/tnyim/jungletv/server/components/stats"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/event"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,6 +28,22 @@ import (
 )
 
 func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.JungleTV_ConsumeChatServer) error {
+	ctx := stream.Context()
+	user := authinterceptor.UserClaimsFromContext(ctx)
+
+	channel, err := s.chat.Channel(ctx, user, r.ChannelId)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrChatChannelNotFound) {
+			return status.Error(codes.NotFound, "chat channel not found")
+		} else if errors.Is(err, chatmanager.ErrChatChannelAccessDenied) {
+			return status.Error(codes.PermissionDenied, "chat channel access denied")
+		}
+		return stacktrace.Propagate(err, "")
+	}
+
+	onChannelUpdated, channelUpdatedU := s.chat.OnChannelUpdated().Subscribe(channel.ID, event.BufferFirst)
+	defer channelUpdatedU()
+
 	onChatDisabled, chatDisabledU := s.chat.OnChatDisabled().Subscribe(event.BufferFirst)
 	defer chatDisabledU()
 
@@ -38,15 +56,26 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 	onMessageDeleted, messageDeletedU := s.chat.OnMessageDeleted().Subscribe(event.BufferFirst)
 	defer messageDeletedU()
 
+	onMessageEdited, messageEditedU := s.chat.OnMessageEdited().Subscribe(event.BufferFirst)
+	defer messageEditedU()
+
+	onMessageHidden, messageHiddenU := s.chat.OnMessageHidden().Subscribe(event.BufferFirst)
+	defer messageHiddenU()
+
+	onMessageReactionUpdated, messageReactionUpdatedU := s.chat.OnMessageReactionUpdated().Subscribe(event.BufferFirst)
+	defer messageReactionUpdatedU()
+
+	onPollUpdated, pollUpdatedU := s.chat.OnPollUpdated().Subscribe(event.BufferFirst)
+	defer pollUpdatedU()
+
 	onVersionHashChanged, versionHashChangedU := s.versionHashChanged.Subscribe(event.BufferFirst)
 	defer versionHashChangedU()
 
-	ctx := stream.Context()
-	user := authinterceptor.UserClaimsFromContext(ctx)
-
 	onUserBlocked := make(<-chan string)
 	onUserUnblocked := make(<-chan string)
 	onChangedOwnNickname := make(<-chan string)
+	onUnreadRepliesUpdated := make(<-chan chatmanager.UnreadRepliesUpdatedEventArgs)
+	onMuteUpdated := make(<-chan *types.ChatMute)
 	if user != nil && !user.IsUnknown() {
 		var userBlockedU func()
 		onUserBlocked, userBlockedU = s.chat.OnUserBlockedBy().Subscribe(user.Address(), event.BufferFirst)
@@ -59,6 +88,14 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 		var changedOwnNicknameU func()
 		onChangedOwnNickname, changedOwnNicknameU = s.chat.OnUserChangedNickname().Subscribe(user.Address(), event.BufferFirst)
 		defer changedOwnNicknameU()
+
+		var unreadRepliesUpdatedU func()
+		onUnreadRepliesUpdated, unreadRepliesUpdatedU = s.chat.OnUnreadRepliesUpdated().Subscribe(user.Address(), event.BufferFirst)
+		defer unreadRepliesUpdatedU()
+
+		var muteUpdatedU func()
+		onMuteUpdated, muteUpdatedU = s.chat.OnUserMuteUpdated().Subscribe(user.Address(), event.BufferFirst)
+		defer muteUpdatedU()
 	}
 
 	heartbeat := time.NewTicker(5 * time.Second)
@@ -102,8 +139,39 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 		}
 	}
 
+	if user != nil && !user.IsUnknown() {
+		// replies may have been received while the user was not connected
+		unreadReplies, err := s.chat.UnreadRepliesCount(ctx, user)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		initialEvents = append(initialEvents, &proto.ChatUpdateEvent{
+			Event: &proto.ChatUpdateEvent_UnreadRepliesUpdated{
+				UnreadRepliesUpdated: &proto.ChatUnreadRepliesUpdatedEvent{
+					UnreadCount: uint32(unreadReplies),
+				},
+			},
+		})
+
+		mute, err := s.chat.ActiveMute(ctx, user)
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+		if mute != nil {
+			initialEvents = append(initialEvents, serializeChatMuteUpdate(mute))
+		}
+	}
+
+	initialEvents = append(initialEvents, &proto.ChatUpdateEvent{
+		Event: &proto.ChatUpdateEvent_ChannelUpdated{
+			ChannelUpdated: &proto.ChatChannelUpdatedEvent{
+				Channel: s.serializeChatChannel(ctx, channel, false),
+			},
+		},
+	})
+
 	chatEnabled, disabledReason := s.chat.Enabled()
-	if chatEnabled {
+	if chatEnabled && channel.Enabled {
 		initialHistorySize := r.InitialHistorySize
 		if initialHistorySize > 1000 {
 			initialHistorySize = 1000
@@ -112,7 +180,7 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 		if user != nil {
 			u = user
 		}
-		_, protoMessages, err := s.chat.LoadNumLatestMessages(ctx, u, int(initialHistorySize))
+		_, protoMessages, err := s.chat.LoadNumLatestMessages(ctx, channel.ID, u, int(initialHistorySize))
 		if err != nil {
 			return stacktrace.Propagate(err, "failed to load chat messages")
 		}
@@ -146,23 +214,66 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 		var err error
 		select {
 		case reason := <-onChatDisabled:
-			err = stream.Send(&proto.ChatUpdate{
-				Events: []*proto.ChatUpdateEvent{{
-					Event: &proto.ChatUpdateEvent_Disabled{
-						Disabled: &proto.ChatDisabledEvent{
-							Reason: reason.SerializeForAPI(),
+			if channel.Enabled {
+				err = stream.Send(&proto.ChatUpdate{
+					Events: []*proto.ChatUpdateEvent{{
+						Event: &proto.ChatUpdateEvent_Disabled{
+							Disabled: &proto.ChatDisabledEvent{
+								Reason: reason.SerializeForAPI(),
+							},
 						},
-					},
-				}}})
+					}}})
+			}
 		case <-onChatEnabled:
-			err = stream.Send(&proto.ChatUpdate{
-				Events: []*proto.ChatUpdateEvent{{
-					Event: &proto.ChatUpdateEvent_Enabled{
-						Enabled: &proto.ChatEnabledEvent{},
+			if channel.Enabled {
+				err = stream.Send(&proto.ChatUpdate{
+					Events: []*proto.ChatUpdateEvent{{
+						Event: &proto.ChatUpdateEvent_Enabled{
+							Enabled: &proto.ChatEnabledEvent{},
+						},
+					}}})
+			}
+		case updatedChannel := <-onChannelUpdated:
+			if updatedChannel == nil {
+				return status.Error(codes.NotFound, "chat channel removed")
+			}
+			if _, err := s.chat.Channel(ctx, user, updatedChannel.ID); err != nil {
+				if errors.Is(err, chatmanager.ErrChatChannelAccessDenied) {
+					return status.Error(codes.PermissionDenied, "chat channel access denied")
+				}
+				return stacktrace.Propagate(err, "")
+			}
+			events := []*proto.ChatUpdateEvent{{
+				Event: &proto.ChatUpdateEvent_ChannelUpdated{
+					ChannelUpdated: &proto.ChatChannelUpdatedEvent{
+						Channel: s.serializeChatChannel(ctx, updatedChannel, false),
 					},
-				}}})
+				},
+			}}
+			if chatEnabled, _ := s.chat.Enabled(); chatEnabled && updatedChannel.Enabled != channel.Enabled {
+				if updatedChannel.Enabled {
+					events = append(events, &proto.ChatUpdateEvent{
+						Event: &proto.ChatUpdateEvent_Enabled{
+							Enabled: &proto.ChatEnabledEvent{},
+						},
+					})
+				} else {
+					events = append(events, &proto.ChatUpdateEvent{
+						Event: &proto.ChatUpdateEvent_Disabled{
+							Disabled: &proto.ChatDisabledEvent{
+								Reason: chatmanager.DisabledReasonUnspecified.SerializeForAPI(),
+							},
+						},
+					})
+				}
+			}
+			channel = updatedChannel
+			err = stream.Send(&proto.ChatUpdate{Events: events})
 		case args := <-onMessageCreated:
 			msg := args.Message
+			if msg.Channel != channel.ID {
+				continue
+			}
 			if !msg.Shadowbanned || (msg.Author != nil && user != nil && msg.Author.Address() == user.Address()) {
 				err = stream.Send(&proto.ChatUpdate{
 					Events: []*proto.ChatUpdateEvent{{
@@ -182,6 +293,90 @@ func (s *grpcServer) ConsumeChat(r *proto.ConsumeChatRequest, stream proto.Jungl
 						},
 					},
 				}}})
+		case msg := <-onMessageEdited:
+			if msg.Channel != channel.ID {
+				continue
+			}
+			if !msg.Shadowbanned || (msg.Author != nil && user != nil && msg.Author.Address() == user.Address()) {
+				err = stream.Send(&proto.ChatUpdate{
+					Events: []*proto.ChatUpdateEvent{{
+						Event: &proto.ChatUpdateEvent_MessageEdited{
+							MessageEdited: &proto.ChatMessageEditedEvent{
+								Id:       msg.ID.Int64(),
+								Content:  msg.Content,
+								EditedAt: timestamppb.New(*msg.EditedAt),
+							},
+						},
+					}}})
+			} else {
+				// the edit caused the message to become shadowbanned, so it must disappear for everyone else
+				err = stream.Send(&proto.ChatUpdate{
+					Events: []*proto.ChatUpdateEvent{{
+						Event: &proto.ChatUpdateEvent_MessageDeleted{
+							MessageDeleted: &proto.ChatMessageDeletedEvent{
+								Id: msg.ID.Int64(),
+							},
+						},
+					}}})
+			}
+		case args := <-onUnreadRepliesUpdated:
+			err = stream.Send(&proto.ChatUpdate{
+				Events: []*proto.ChatUpdateEvent{{
+					Event: &proto.ChatUpdateEvent_UnreadRepliesUpdated{
+						UnreadRepliesUpdated: &proto.ChatUnreadRepliesUpdatedEvent{
+							UnreadCount: uint32(args.UnreadCount),
+							Reply:       args.ProtobufRepresentation,
+						},
+					},
+				}}})
+		case msg := <-onMessageHidden:
+			if msg.Channel != channel.ID || (msg.Author != nil && user != nil && msg.Author.Address() == user.Address()) {
+				// the author keeps seeing their hidden message, just like with shadowbanned messages
+				continue
+			}
+			err = stream.Send(&proto.ChatUpdate{
+				Events: []*proto.ChatUpdateEvent{{
+					Event: &proto.ChatUpdateEvent_MessageDeleted{
+						MessageDeleted: &proto.ChatMessageDeletedEvent{
+							Id: msg.ID.Int64(),
+						},
+					},
+				}}})
+		case args := <-onPollUpdated:
+			if args.Channel != channel.ID {
+				continue
+			}
+			err = stream.Send(&proto.ChatUpdate{
+				Events: []*proto.ChatUpdateEvent{{
+					Event: &proto.ChatUpdateEvent_PollUpdated{
+						PollUpdated: &proto.ChatPollUpdatedEvent{
+							MessageId: args.MessageID.Int64(),
+							Poll:      args.Poll.SerializePollForAPI(),
+						},
+					},
+				}}})
+		case mute := <-onMuteUpdated:
+			err = stream.Send(&proto.ChatUpdate{
+				Events: []*proto.ChatUpdateEvent{serializeChatMuteUpdate(mute)},
+			})
+		case args := <-onMessageReactionUpdated:
+			if args.Channel != channel.ID {
+				continue
+			}
+			if !args.Shadowbanned || (user != nil && args.Reactor.Address() == user.Address()) {
+				err = stream.Send(&proto.ChatUpdate{
+					Events: []*proto.ChatUpdateEvent{{
+						Event: &proto.ChatUpdateEvent_MessageReactionUpdated{
+							MessageReactionUpdated: &proto.ChatMessageReactionUpdatedEvent{
+								MessageId:      args.MessageID.Int64(),
+								Reaction:       args.Reaction,
+								Count:          uint32(args.Count),
+								ReactorAddress: args.Reactor.Address(),
+								Added:          args.Added,
+							},
+						},
+					}}})
+			}
 		case <-heartbeat.C:
 			err = stream.Send(&proto.ChatUpdate{
 				Events: []*proto.ChatUpdateEvent{{
@@ -283,12 +478,25 @@ func (s *grpcServer) SendChatMessage(ctx context.Context, r *proto.SendChatMessa
 				CreatedAt: message.CreatedAt,
 				Author:    message.Author,
 				Content:   message.Content,
+				Channel:   message.Channel,
 			}
 		}
 	}
 
-	m, err := s.chat.CreateMessage(ctx, user, r.Content, messageReference, attachments)
+	m, err := s.chat.CreateMessage(ctx, user, r.ChannelId, r.Content, messageReference, attachments)
 	if err != nil {
+		if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+			return nil, mutedErr
+		}
+		if errors.Is(err, chatmanager.ErrMessageRejectedByContentFilter) {
+			return nil, status.Error(codes.InvalidArgument, "message rejected by content filter")
+		} else if errors.Is(err, chatmanager.ErrChatChannelNotFound) {
+			return nil, status.Error(codes.NotFound, "chat channel not found")
+		} else if errors.Is(err, chatmanager.ErrChatChannelAccessDenied) {
+			return nil, status.Error(codes.PermissionDenied, "chat channel access denied")
+		} else if errors.Is(err, chatmanager.ErrChatChannelDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, "chat channel disabled")
+		}
 		return nil, stacktrace.Propagate(err, "")
 	}
 	if !r.Trusted {
@@ -299,6 +507,40 @@ func (s *grpcServer) SendChatMessage(ctx context.Context, r *proto.SendChatMessa
 	}, nil
 }
 
+func (s *grpcServer) EditChatMessage(ctx context.Context, r *proto.EditChatMessageRequest) (*proto.EditChatMessageResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+	r.Content = strings.Map(func(r rune) rune {
+		if unicode.IsGraphic(r) || r == '\n' || r == '\u200d' {
+			return r
+		}
+		return -1
+	}, r.Content)
+	if len(r.Content) > 512 {
+		return nil, status.Error(codes.InvalidArgument, "message too long")
+	}
+
+	_, err := s.chat.EditMessage(ctx, user, snowflake.ParseInt64(r.Id), r.Content)
+	if err != nil {
+		if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+			return nil, mutedErr
+		}
+		if errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "message not found")
+		} else if errors.Is(err, chatmanager.ErrMessageNotEditable) {
+			return nil, status.Error(codes.FailedPrecondition, "message can't be edited")
+		} else if errors.Is(err, chatmanager.ErrChatChannelDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, "chat channel disabled")
+		} else if errors.Is(err, chatmanager.ErrMessageRejectedByContentFilter) {
+			return nil, status.Error(codes.InvalidArgument, "message rejected by content filter")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.EditChatMessageResponse{}, nil
+}
+
 var disallowedEmojiRegex = regexp.MustCompile("[🛡️🔰🛡⚔️⚔🗡️🗡🗡️]")
 
 func (s *grpcServer) SetChatNickname(ctx context.Context, r *proto.SetChatNicknameRequest) (*proto.SetChatNicknameResponse, error) {
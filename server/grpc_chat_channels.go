@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// serializeChatChannel converts a chat channel to its API representation.
+// Who last updated the channel is only included for moderation purposes
+func (s *grpcServer) serializeChatChannel(ctx context.Context, channel *types.ChatChannel, includeUpdatedBy bool) *proto.ChatChannel {
+	result := &proto.ChatChannel{
+		Id:                      channel.ID,
+		Title:                   channel.Title,
+		Enabled:                 channel.Enabled,
+		Slowmode:                channel.Slowmode,
+		RequiredPermissionLevel: channel.RequiredPermissionLevel,
+		ApplicationId:           channel.ApplicationID,
+		UpdatedAt:               timestamppb.New(channel.UpdatedAt),
+	}
+	if includeUpdatedBy && channel.UpdatedBy != "" {
+		result.UpdatedBy = s.userSerializer(ctx, auth.NewAddressOnlyUser(channel.UpdatedBy))
+	}
+	return result
+}
+
+func (s *grpcServer) ChatChannels(ctx context.Context, r *proto.ChatChannelsRequest) (*proto.ChatChannelsResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+
+	channels, err := s.chat.ChannelsAccessibleBy(ctx, user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	isAdmin := auth.UserPermissionLevelIsAtLeast(user, auth.AdminPermissionLevel)
+	protoChannels := make([]*proto.ChatChannel, len(channels))
+	for i, channel := range channels {
+		protoChannels[i] = s.serializeChatChannel(ctx, channel, isAdmin)
+	}
+
+	return &proto.ChatChannelsResponse{
+		Channels: protoChannels,
+	}, nil
+}
+
+func (s *grpcServer) SetChatChannel(ctx context.Context, r *proto.ChatChannel) (*proto.SetChatChannelResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel := &types.ChatChannel{
+		ID:                      r.Id,
+		Title:                   r.Title,
+		Enabled:                 r.Enabled,
+		Slowmode:                r.Slowmode,
+		RequiredPermissionLevel: r.RequiredPermissionLevel,
+		ApplicationID:           r.ApplicationId,
+	}
+
+	err := s.chat.SaveChannel(ctx, channel, moderator)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrInvalidChatChannel) {
+			return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	ownership := ""
+	if channel.ApplicationID != nil {
+		ownership = fmt.Sprintf(", owned by application %s", *channel.ApplicationID)
+	}
+
+	s.log.Printf("Chat channel %s (enabled %v, slowmode %v, permission level %q%s) updated by %s (remote address %s)", channel.ID, channel.Enabled, channel.Slowmode, channel.RequiredPermissionLevel, ownership, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Chat channel `%s` (\"%s\", enabled: %v, slowmode: %v, permission level: `%s`%s) updated by moderator: %s (%s)",
+				channel.ID,
+				channel.Title,
+				channel.Enabled,
+				channel.Slowmode,
+				channel.RequiredPermissionLevel,
+				ownership,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetChatChannelResponse{}, nil
+}
+
+func (s *grpcServer) RemoveChatChannel(ctx context.Context, r *proto.RemoveChatChannelRequest) (*proto.RemoveChatChannelResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	channel, err := s.chat.RemoveChannel(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrChatChannelNotFound) {
+			return nil, status.Error(codes.NotFound, "chat channel not found")
+		} else if errors.Is(err, chatmanager.ErrCannotRemoveMainChannel) {
+			return nil, status.Error(codes.FailedPrecondition, "the main chat channel can't be removed")
+		} else if errors.Is(err, chatmanager.ErrChatChannelHasMessages) {
+			return nil, status.Error(codes.FailedPrecondition, "chat channels with messages can't be removed, disable them instead")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Chat channel %s removed by %s (remote address %s)", channel.ID, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Chat channel `%s` (\"%s\") removed by moderator: %s (%s)",
+				channel.ID,
+				channel.Title,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveChatChannelResponse{}, nil
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var chatMessageReportReasonToProto = map[types.ChatMessageReportReason]proto.ChatMessageReportReason{
+	types.ChatMessageReportReasonSpam:          proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_SPAM,
+	types.ChatMessageReportReasonHarassment:    proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_HARASSMENT,
+	types.ChatMessageReportReasonHateSpeech:    proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_HATE_SPEECH,
+	types.ChatMessageReportReasonScam:          proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_SCAM,
+	types.ChatMessageReportReasonInappropriate: proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_INAPPROPRIATE,
+	types.ChatMessageReportReasonOther:         proto.ChatMessageReportReason_CHAT_MESSAGE_REPORT_REASON_OTHER,
+}
+
+func (s *grpcServer) ReportChatMessage(ctx context.Context, r *proto.ReportChatMessageRequest) (*proto.ReportChatMessageResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if len(r.Comment) > 512 {
+		return nil, status.Error(codes.InvalidArgument, "comment too long")
+	}
+
+	var reason types.ChatMessageReportReason
+	for typesReason, protoReason := range chatMessageReportReasonToProto {
+		if protoReason == r.Reason {
+			reason = typesReason
+		}
+	}
+
+	_, err := s.chat.ReportMessage(ctx, user, snowflake.ParseInt64(r.MessageId), reason, strings.TrimSpace(r.Comment))
+	if err != nil {
+		if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+			return nil, mutedErr
+		}
+		switch {
+		case errors.Is(err, chat.ErrChatMessageNotFound):
+			return nil, status.Error(codes.NotFound, "message not found")
+		case errors.Is(err, chatmanager.ErrMessageNotReportable):
+			return nil, status.Error(codes.FailedPrecondition, "message can't be reported")
+		case errors.Is(err, chatmanager.ErrMessageAlreadyReported):
+			return nil, status.Error(codes.AlreadyExists, "message already reported")
+		case errors.Is(err, chatmanager.ErrInvalidReportReason):
+			return nil, status.Error(codes.InvalidArgument, "invalid report reason")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.ReportChatMessageResponse{}, nil
+}
+
+func (s *grpcServer) serializeChatMessageReportQueue(ctx context.Context) ([]*proto.ChatMessageReportQueueEntry, error) {
+	entries, err := s.chat.ReportQueue(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoEntries := make([]*proto.ChatMessageReportQueueEntry, len(entries))
+	for i, entry := range entries {
+		protoReports := make([]*proto.ChatMessageReport, len(entry.Reports))
+		for j, report := range entry.Reports {
+			protoReports[j] = &proto.ChatMessageReport{
+				Id:              report.ID,
+				Reporter:        s.userSerializer(ctx, auth.NewAddressOnlyUser(report.Reporter)),
+				ReportedAt:      timestamppb.New(report.ReportedAt),
+				Reason:          chatMessageReportReasonToProto[report.Reason],
+				Comment:         report.Comment,
+				TrustedReporter: report.TrustedReporter,
+			}
+		}
+		protoEntries[i] = &proto.ChatMessageReportQueueEntry{
+			MessageId:            entry.MessageID.Int64(),
+			Author:               s.userSerializer(ctx, auth.NewAddressOnlyUser(entry.Author)),
+			Content:              entry.Content,
+			Reports:              protoReports,
+			TrustedReporterCount: uint32(entry.TrustedReporterCount),
+			Hidden:               entry.Hidden(),
+		}
+	}
+	return protoEntries, nil
+}
+
+func (s *grpcServer) ResolveChatMessageReports(ctx context.Context, r *proto.ResolveChatMessageReportsRequest) (*proto.ResolveChatMessageReportsResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	var resolution types.ChatMessageReportResolution
+	switch r.Resolution {
+	case proto.ChatMessageReportResolution_CHAT_MESSAGE_REPORT_RESOLUTION_DISMISS:
+		resolution = types.ChatMessageReportResolutionDismissed
+	case proto.ChatMessageReportResolution_CHAT_MESSAGE_REPORT_RESOLUTION_DELETE:
+		resolution = types.ChatMessageReportResolutionDeleted
+	case proto.ChatMessageReportResolution_CHAT_MESSAGE_REPORT_RESOLUTION_BAN:
+		resolution = types.ChatMessageReportResolutionBanned
+		if r.BanReason == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing ban reason")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid resolution")
+	}
+
+	messageID := snowflake.ParseInt64(r.MessageId)
+	entry, err := s.chat.ReportQueueEntryForMessage(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrNoUnresolvedReports) {
+			return nil, status.Error(codes.NotFound, "message has no unresolved reports")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	if resolution == types.ChatMessageReportResolutionDeleted || resolution == types.ChatMessageReportResolutionBanned {
+		// the message may have already been deleted through other means
+		_, err = s.RemoveChatMessage(ctx, &proto.RemoveChatMessageRequest{Id: r.MessageId})
+		if err != nil && !errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	if resolution == types.ChatMessageReportResolutionBanned {
+		_, err = s.BanUser(ctx, &proto.BanUserRequest{
+			Address:    entry.Author,
+			ChatBanned: true,
+			Reason:     r.BanReason,
+			Duration:   r.BanDuration,
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	reports, err := s.chat.ResolveMessageReports(ctx, messageID, resolution, moderator)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrNoUnresolvedReports) {
+			return nil, status.Error(codes.NotFound, "message has no unresolved reports")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("%d report(s) of chat message %d resolved (%s) by %s (remote address %s)", len(reports), r.MessageId, resolution, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("%d report(s) of chat message from %s resolved as **%s** by moderator: %s (%s)",
+				len(reports),
+				entry.Author[:14],
+				resolution,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.ResolveChatMessageReportsResponse{}, nil
+}
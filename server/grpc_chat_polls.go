@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) CreateChatPoll(ctx context.Context, r *proto.CreateChatPollRequest) (*proto.CreateChatPollResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	var votingMode types.ChatPollVotingMode
+	switch r.VotingMode {
+	case proto.ChatPollVotingMode_CHAT_POLL_VOTING_MODE_SINGLE:
+		votingMode = types.ChatPollVotingModeSingle
+	case proto.ChatPollVotingMode_CHAT_POLL_VOTING_MODE_POINTS:
+		votingMode = types.ChatPollVotingModePoints
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid voting mode")
+	}
+
+	if r.Duration == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing duration")
+	}
+
+	message, poll, err := s.chat.CreatePoll(ctx, moderator, r.ChannelId, r.Question, r.Options, r.Duration.AsDuration(), votingMode, int(r.PointsPerVote))
+	if err != nil {
+		switch {
+		case errors.Is(err, chatmanager.ErrInvalidPoll):
+			return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+		case errors.Is(err, chatmanager.ErrChatChannelNotFound), errors.Is(err, chatmanager.ErrChatChannelAccessDenied):
+			return nil, status.Error(codes.NotFound, "chat channel not found")
+		case errors.Is(err, chatmanager.ErrChatChannelDisabled):
+			return nil, status.Error(codes.FailedPrecondition, "chat channel disabled")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Chat poll %s (%s, %d options) created in channel %s by %s (remote address %s)", poll.ID, poll.VotingMode, len(poll.Options), poll.Channel, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Moderator %s (%s) created chat poll `%s` in channel `%s`: %s (%s)",
+				moderator.Address()[:14],
+				moderator.ModeratorName(),
+				poll.ID,
+				poll.Channel,
+				poll.Question,
+				strings.Join(poll.Options, " / ")))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.CreateChatPollResponse{
+		MessageId: message.ID.Int64(),
+		PollId:    poll.ID,
+	}, nil
+}
+
+func (s *grpcServer) VoteInChatPoll(ctx context.Context, r *proto.VoteInChatPollRequest) (*proto.VoteInChatPollResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	err := s.chat.VoteInPoll(ctx, user, r.PollId, int(r.OptionIndex), int(r.Votes))
+	if err != nil {
+		if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+			return nil, mutedErr
+		}
+		switch {
+		case errors.Is(err, chatmanager.ErrPollNotFound):
+			return nil, status.Error(codes.NotFound, "poll not found")
+		case errors.Is(err, chatmanager.ErrPollClosed):
+			return nil, status.Error(codes.FailedPrecondition, "poll closed")
+		case errors.Is(err, chatmanager.ErrAlreadyVotedInPoll):
+			return nil, status.Error(codes.AlreadyExists, "already voted in this poll")
+		case errors.Is(err, chatmanager.ErrInvalidPollVote):
+			return nil, status.Error(codes.InvalidArgument, "invalid vote")
+		case errors.Is(err, types.ErrInsufficientPointsBalance):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient points balance")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	return &proto.VoteInChatPollResponse{}, nil
+}
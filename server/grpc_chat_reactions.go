@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) AddChatMessageReaction(ctx context.Context, r *proto.AddChatMessageReactionRequest) (*proto.AddChatMessageReactionResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	err := s.chat.AddReaction(ctx, user, snowflake.ParseInt64(r.MessageId), r.Reaction)
+	if err != nil {
+		return nil, convertChatMessageReactionError(err)
+	}
+	return &proto.AddChatMessageReactionResponse{}, nil
+}
+
+func (s *grpcServer) RemoveChatMessageReaction(ctx context.Context, r *proto.RemoveChatMessageReactionRequest) (*proto.RemoveChatMessageReactionResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	err := s.chat.RemoveReaction(ctx, user, snowflake.ParseInt64(r.MessageId), r.Reaction)
+	if err != nil {
+		return nil, convertChatMessageReactionError(err)
+	}
+	return &proto.RemoveChatMessageReactionResponse{}, nil
+}
+
+func convertChatMessageReactionError(err error) error {
+	if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+		return mutedErr
+	}
+	switch {
+	case errors.Is(err, chat.ErrChatMessageNotFound):
+		return status.Error(codes.NotFound, "message not found")
+	case errors.Is(err, chatmanager.ErrInvalidReaction):
+		return status.Error(codes.InvalidArgument, "invalid reaction")
+	case errors.Is(err, chatmanager.ErrTooManyReactions):
+		return status.Error(codes.FailedPrecondition, "message has too many different reactions")
+	}
+	return stacktrace.Propagate(err, "")
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) ChatMessageThread(ctx context.Context, r *proto.ChatMessageThreadRequest) (*proto.ChatMessageThreadResponse, error) {
+	var viewer auth.User
+	if user := authinterceptor.UserClaimsFromContext(ctx); user != nil {
+		viewer = user
+	}
+
+	root, replies, err := s.chat.LoadThread(ctx, viewer, snowflake.ParseInt64(r.MessageId))
+	if err != nil {
+		if errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "message not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoReplies := make([]*proto.ChatMessage, len(replies))
+	for i := range replies {
+		protoReplies[i] = replies[i].SerializeForAPI(ctx, s.userSerializer)
+	}
+
+	return &proto.ChatMessageThreadResponse{
+		Root:    root.SerializeForAPI(ctx, s.userSerializer),
+		Replies: protoReplies,
+	}, nil
+}
+
+func (s *grpcServer) MarkChatRepliesAsRead(ctx context.Context, r *proto.MarkChatRepliesAsReadRequest) (*proto.MarkChatRepliesAsReadResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	err := s.chat.MarkRepliesAsRead(ctx, user)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.MarkChatRepliesAsReadResponse{}, nil
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/event"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *grpcServer) ConsumeDirectMessages(r *proto.ConsumeDirectMessagesRequest, stream proto.JungleTV_ConsumeDirectMessagesServer) error {
+	ctx := stream.Context()
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	onDirectMessage, directMessageU := s.chat.OnDirectMessage().Subscribe(user.Address(), event.BufferFirst)
+	defer directMessageU()
+
+	onEnabledChanged, enabledChangedU := s.chat.OnDirectMessagesEnabledChanged().Subscribe(user.Address(), event.BufferFirst)
+	defer enabledChangedU()
+
+	onVersionHashChanged, versionHashChangedU := s.versionHashChanged.Subscribe(event.BufferFirst)
+	defer versionHashChangedU()
+
+	heartbeat := time.NewTicker(5 * time.Second)
+	defer heartbeat.Stop()
+	var seq uint32
+
+	enabled, err := s.chat.DirectMessagesEnabled(ctx, user)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	initialEvents := []*proto.DirectMessagesUpdateEvent{{
+		Event: &proto.DirectMessagesUpdateEvent_Enabled{
+			Enabled: &proto.DirectMessagesEnabledEvent{
+				Enabled: enabled,
+			},
+		},
+	}}
+
+	conversations, err := s.chat.LoadDirectMessageConversations(ctx, user)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	for _, conversation := range conversations {
+		initialEvents = append(initialEvents, &proto.DirectMessagesUpdateEvent{
+			Event: &proto.DirectMessagesUpdateEvent_Conversation{
+				Conversation: &proto.DirectMessageConversationSummary{
+					OtherParty:    s.userSerializer(ctx, conversation.OtherParty),
+					LatestMessage: conversation.LatestMessage.SerializeForAPI(ctx, s.userSerializer),
+				},
+			},
+		})
+	}
+
+	err = stream.Send(&proto.DirectMessagesUpdate{
+		Events: initialEvents,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to send initial events")
+	}
+
+	for {
+		var err error
+		select {
+		case args := <-onDirectMessage:
+			err = stream.Send(&proto.DirectMessagesUpdate{
+				Events: []*proto.DirectMessagesUpdateEvent{{
+					Event: &proto.DirectMessagesUpdateEvent_MessageCreated{
+						MessageCreated: &proto.DirectMessageCreatedEvent{
+							OtherParty: s.userSerializer(ctx, args.OtherParty),
+							Message:    args.ProtobufRepresentation,
+						},
+					},
+				}}})
+		case enabled := <-onEnabledChanged:
+			err = stream.Send(&proto.DirectMessagesUpdate{
+				Events: []*proto.DirectMessagesUpdateEvent{{
+					Event: &proto.DirectMessagesUpdateEvent_Enabled{
+						Enabled: &proto.DirectMessagesEnabledEvent{
+							Enabled: enabled,
+						},
+					},
+				}}})
+		case <-heartbeat.C:
+			err = stream.Send(&proto.DirectMessagesUpdate{
+				Events: []*proto.DirectMessagesUpdateEvent{{
+					Event: &proto.DirectMessagesUpdateEvent_Heartbeat{
+						Heartbeat: &proto.ChatHeartbeatEvent{
+							Sequence: seq,
+						},
+					},
+				}}})
+			seq++
+		case <-onVersionHashChanged:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "failed to send direct messages update")
+		}
+	}
+}
+
+func (s *grpcServer) SendDirectMessage(ctx context.Context, r *proto.SendDirectMessageRequest) (*proto.SendDirectMessageResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+	r.Content = strings.Map(func(r rune) rune {
+		if unicode.IsGraphic(r) || r == '\n' || r == '\u200d' {
+			return r
+		}
+		return -1
+	}, r.Content)
+	if len(strings.TrimSpace(r.Content)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message empty")
+	}
+	if len(r.Content) > 512 {
+		return nil, status.Error(codes.InvalidArgument, "message too long")
+	}
+
+	m, err := s.chat.SendDirectMessage(ctx, user, auth.NewAddressOnlyUser(r.RecipientAddress), r.Content)
+	if err != nil {
+		if mutedErr := chatMuteStatusError(err); mutedErr != nil {
+			return nil, mutedErr
+		}
+		if errors.Is(err, chatmanager.ErrDirectMessagesNotAllowed) {
+			return nil, status.Error(codes.FailedPrecondition, "direct messages not allowed between these users")
+		} else if errors.Is(err, chatmanager.ErrMessageRejectedByContentFilter) {
+			return nil, status.Error(codes.InvalidArgument, "message rejected by content filter")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.SendDirectMessageResponse{
+		Id: m.ID.Int64(),
+	}, nil
+}
+
+func (s *grpcServer) DirectMessageConversation(ctx context.Context, r *proto.DirectMessageConversationRequest) (*proto.DirectMessageConversationResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	before := time.Now()
+	if r.Before != nil {
+		before = r.Before.AsTime()
+	}
+	numMessages := r.NumMessages
+	if numMessages == 0 || numMessages > 100 {
+		numMessages = 100
+	}
+
+	messages, err := s.chat.LoadDirectMessages(ctx, user, auth.NewAddressOnlyUser(r.OtherPartyAddress), before, int(numMessages))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoMessages := make([]*proto.ChatMessage, len(messages))
+	for i := range messages {
+		protoMessages[i] = messages[i].SerializeForAPI(ctx, s.userSerializer)
+	}
+	return &proto.DirectMessageConversationResponse{
+		Messages: protoMessages,
+	}, nil
+}
+
+func (s *grpcServer) SetDirectMessagesEnabled(ctx context.Context, r *proto.SetDirectMessagesEnabledRequest) (*proto.SetDirectMessagesEnabledResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	err := s.chat.SetDirectMessagesEnabled(ctx, user, r.Enabled)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.SetDirectMessagesEnabledResponse{}, nil
+}
+
+func (s *grpcServer) ReportDirectMessageConversation(ctx context.Context, r *proto.ReportDirectMessageConversationRequest) (*proto.ReportDirectMessageConversationResponse, error) {
+	user := authinterceptor.UserClaimsFromContext(ctx)
+	if user == nil {
+		return nil, stacktrace.NewError("user claims unexpectedly missing")
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if len(r.Reason) > 512 {
+		return nil, status.Error(codes.InvalidArgument, "reason too long")
+	}
+
+	report, err := s.chat.ReportDirectMessageConversation(ctx, user, auth.NewAddressOnlyUser(r.OtherPartyAddress), r.Reason)
+	if err != nil {
+		if errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "conversation not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return &proto.ReportDirectMessageConversationResponse{
+		ReportId: report.ID,
+	}, nil
+}
+
+func (s *grpcServer) DirectMessageReports(ctxCtx context.Context, r *proto.DirectMessageReportsRequest) (*proto.DirectMessageReportsResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	reports, total, err := types.GetDirectMessageReports(ctx, r.UnreviewedOnly, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoReports := make([]*proto.DirectMessageReport, len(reports))
+	for i, report := range reports {
+		protoReports[i] = convertDirectMessageReport(ctx, report, s.userSerializer)
+	}
+
+	return &proto.DirectMessageReportsResponse{
+		Reports: protoReports,
+		Offset:  readOffset(r),
+		Total:   total,
+	}, nil
+}
+
+func convertDirectMessageReport(ctx context.Context, orig *types.DirectMessageReport, userSerializer auth.APIUserSerializer) *proto.DirectMessageReport {
+	report := &proto.DirectMessageReport{
+		Id:         orig.ID,
+		ReportedAt: timestamppb.New(orig.ReportedAt),
+		Reporter:   userSerializer(ctx, auth.NewAddressOnlyUser(orig.Reporter)),
+		Reported:   userSerializer(ctx, auth.NewAddressOnlyUser(orig.Reported)),
+		Reason:     orig.Reason,
+	}
+	if orig.ReviewedAt.Valid {
+		report.ReviewedAt = timestamppb.New(orig.ReviewedAt.Time)
+	}
+	if orig.ReviewedBy != nil {
+		report.ReviewedBy = userSerializer(ctx, auth.NewAddressOnlyUser(*orig.ReviewedBy))
+	}
+	return report
+}
+
+func (s *grpcServer) ReportedDirectMessageConversation(ctx context.Context, r *proto.ReportedDirectMessageConversationRequest) (*proto.ReportedDirectMessageConversationResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	report, messages, err := s.chat.LoadReportedDirectMessageConversation(ctx, r.ReportId, moderator)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrDirectMessageReportNotFound) {
+			return nil, status.Error(codes.NotFound, "report not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Direct message conversation reported in report %s reviewed by %s (remote address %s)", report.ID, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Direct message conversation reported in report `%s` reviewed by moderator: %s (%s)",
+				report.ID,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	protoMessages := make([]*proto.ChatMessage, len(messages))
+	for i := range messages {
+		protoMessages[i] = messages[i].SerializeForAPI(ctx, s.userSerializer)
+	}
+	return &proto.ReportedDirectMessageConversationResponse{
+		Report:   convertDirectMessageReport(ctx, report, s.userSerializer),
+		Messages: protoMessages,
+	}, nil
+}
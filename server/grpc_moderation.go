@@ -12,6 +12,7 @@ import (
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/components/chatmanager"
 	"github.com/tnyim/jungletv/server/components/pointsmanager"
+	"github.com/tnyim/jungletv/server/components/rewards"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
 	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -495,9 +496,29 @@ func (s *grpcServer) SpectatorInfo(ctx context.Context, r *proto.SpectatorInfoRe
 	if activityChallenge != nil {
 		ps.ActivityChallenge = activityChallenge.SerializeForAPI()
 	}
+	if risk, ok := s.rewardsHandler.SpectatorRiskAssessment(ctx, r.RewardsAddress); ok {
+		ps.RiskAssessment = serializeSpectatorRiskAssessment(risk)
+	}
 	return ps, nil
 }
 
+func serializeSpectatorRiskAssessment(risk rewards.RiskAssessment) *proto.SpectatorRiskAssessment {
+	signals := make([]*proto.SpectatorRiskSignal, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = &proto.SpectatorRiskSignal{
+			Name:        signal.Name,
+			Score:       int32(signal.Score),
+			Explanation: signal.Explanation,
+		}
+	}
+	return &proto.SpectatorRiskAssessment{
+		Score:                int32(risk.Score),
+		EligibilityThreshold: rewards.RiskScoreEligibilityThreshold,
+		Eligible:             risk.Eligible(),
+		Signals:              signals,
+	}
+}
+
 func (s *grpcServer) ResetSpectatorStatus(ctx context.Context, r *proto.ResetSpectatorStatusRequest) (*proto.ResetSpectatorStatusResponse, error) {
 	moderator := authinterceptor.UserClaimsFromContext(ctx)
 	if moderator == nil {
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func serializeActivityChallengeSelectionPolicies() []*proto.ActivityChallengeSelectionPolicy {
+	policies := rewards.ActivityChallengeSelectionPolicies()
+	protoPolicies := make([]*proto.ActivityChallengeSelectionPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = &proto.ActivityChallengeSelectionPolicy{
+			Name:        policy.Name(),
+			Description: policy.Description(),
+		}
+	}
+	return protoPolicies
+}
+
+func (s *grpcServer) SetActivityChallengeSelectionPolicy(ctx context.Context, r *proto.SetActivityChallengeSelectionPolicyRequest) (*proto.SetActivityChallengeSelectionPolicyResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	err := s.rewardsHandler.SetActivityChallengeSelectionPolicy(r.Policy)
+	if err != nil {
+		if errors.Is(err, rewards.ErrUnknownActivityChallengeSelectionPolicy) {
+			return nil, status.Error(codes.InvalidArgument, "unknown activity challenge selection policy")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Activity challenge selection policy set to %s by %s (remote address %s)", r.Policy, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Activity challenge selection policy set to `%s` by moderator: %s (%s)",
+				r.Policy,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetActivityChallengeSelectionPolicyResponse{}, nil
+}
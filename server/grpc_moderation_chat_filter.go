@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var chatFilterRuleTypeToProto = map[types.ChatFilterRuleType]proto.ChatFilterRuleType{
+	types.ChatFilterRuleTypeRegex:          proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_REGEX,
+	types.ChatFilterRuleTypeWordList:       proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_WORD_LIST,
+	types.ChatFilterRuleTypeLinkDomain:     proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_LINK_DOMAIN,
+	types.ChatFilterRuleTypeMentionSpam:    proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_MENTION_SPAM,
+	types.ChatFilterRuleTypeCharacterFlood: proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_CHARACTER_FLOOD,
+	types.ChatFilterRuleTypeScamAddress:    proto.ChatFilterRuleType_CHAT_FILTER_RULE_TYPE_SCAM_ADDRESS,
+}
+
+var chatFilterActionToProto = map[types.ChatFilterAction]proto.ChatFilterAction{
+	types.ChatFilterActionReject: proto.ChatFilterAction_CHAT_FILTER_ACTION_REJECT,
+	types.ChatFilterActionHide:   proto.ChatFilterAction_CHAT_FILTER_ACTION_HIDE,
+	types.ChatFilterActionFlag:   proto.ChatFilterAction_CHAT_FILTER_ACTION_FLAG,
+	types.ChatFilterActionMute:   proto.ChatFilterAction_CHAT_FILTER_ACTION_MUTE,
+}
+
+func (s *grpcServer) ChatFilterRules(ctxCtx context.Context, r *proto.ChatFilterRulesRequest) (*proto.ChatFilterRulesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	rules, total, err := types.GetChatFilterRules(ctx, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoRules := make([]*proto.ChatFilterRule, len(rules))
+	for i, rule := range rules {
+		protoRules[i] = convertChatFilterRule(ctx, rule, s.userSerializer)
+	}
+
+	return &proto.ChatFilterRulesResponse{
+		Rules:  protoRules,
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func convertChatFilterRule(ctx context.Context, orig *types.ChatFilterRule, userSerializer auth.APIUserSerializer) *proto.ChatFilterRule {
+	return &proto.ChatFilterRule{
+		Id:          orig.ID,
+		RuleType:    chatFilterRuleTypeToProto[orig.RuleType],
+		Parameter:   orig.Parameter,
+		Action:      chatFilterActionToProto[orig.Action],
+		Description: orig.Description,
+		Enabled:     orig.Enabled,
+		UpdatedAt:   timestamppb.New(orig.UpdatedAt),
+		UpdatedBy:   userSerializer(ctx, auth.NewAddressOnlyUser(orig.UpdatedBy)),
+	}
+}
+
+func (s *grpcServer) SetChatFilterRule(ctx context.Context, r *proto.ChatFilterRule) (*proto.SetChatFilterRuleResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	rule := &types.ChatFilterRule{
+		ID:          r.Id,
+		Parameter:   r.Parameter,
+		Description: r.Description,
+		Enabled:     r.Enabled,
+	}
+	for ruleType, protoRuleType := range chatFilterRuleTypeToProto {
+		if protoRuleType == r.RuleType {
+			rule.RuleType = ruleType
+		}
+	}
+	for action, protoAction := range chatFilterActionToProto {
+		if protoAction == r.Action {
+			rule.Action = action
+		}
+	}
+
+	err := s.chat.SaveContentFilterRule(ctx, rule, moderator)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrContentFilterRuleNotFound) {
+			return nil, status.Error(codes.NotFound, "rule not found")
+		} else if errors.Is(err, chatmanager.ErrInvalidContentFilterRule) {
+			return nil, status.Error(codes.InvalidArgument, stacktrace.RootCause(err).Error())
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	verb := "updated"
+	if r.Id == "" {
+		verb = "created"
+	}
+
+	s.log.Printf("Chat filter rule %s (%s, action %s, enabled %v) %s by %s (remote address %s)", rule.ID, rule.RuleType, rule.Action, rule.Enabled, verb, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Chat filter rule `%s` (\"%s\", type %s, action %s, enabled: %v) %s by moderator: %s (%s)",
+				rule.ID,
+				rule.Description,
+				rule.RuleType,
+				rule.Action,
+				rule.Enabled,
+				verb,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetChatFilterRuleResponse{
+		Id: rule.ID,
+	}, nil
+}
+
+func (s *grpcServer) RemoveChatFilterRule(ctx context.Context, r *proto.RemoveChatFilterRuleRequest) (*proto.RemoveChatFilterRuleResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	rule, err := s.chat.RemoveContentFilterRule(ctx, r.Id)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrContentFilterRuleNotFound) {
+			return nil, status.Error(codes.NotFound, "rule not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Chat filter rule %s (%s) removed by %s (remote address %s)", rule.ID, rule.RuleType, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("Chat filter rule `%s` (\"%s\", type %s) removed by moderator: %s (%s)",
+				rule.ID,
+				rule.Description,
+				rule.RuleType,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.RemoveChatFilterRuleResponse{}, nil
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/server/components/chatmanager"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var chatMuteSourceToProto = map[types.ChatMuteSource]proto.ChatMuteSource{
+	types.ChatMuteSourceModerator:     proto.ChatMuteSource_CHAT_MUTE_SOURCE_MODERATOR,
+	types.ChatMuteSourceContentFilter: proto.ChatMuteSource_CHAT_MUTE_SOURCE_CONTENT_FILTER,
+	types.ChatMuteSourceApplication:   proto.ChatMuteSource_CHAT_MUTE_SOURCE_APPLICATION,
+}
+
+func (s *grpcServer) ChatMutes(ctxCtx context.Context, r *proto.ChatMutesRequest) (*proto.ChatMutesResponse, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	searchQuery := ""
+	if len(r.SearchQuery) >= 3 {
+		searchQuery = r.SearchQuery
+	}
+	mutes, total, err := types.GetChatMutes(ctx, searchQuery, r.ActiveOnly, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoMutes := make([]*proto.ChatMute, len(mutes))
+	for i, mute := range mutes {
+		protoMutes[i] = convertChatMute(ctx, mute, s.userSerializer)
+	}
+
+	return &proto.ChatMutesResponse{
+		Mutes:  protoMutes,
+		Offset: readOffset(r),
+		Total:  total,
+	}, nil
+}
+
+func convertChatMute(ctx context.Context, orig *types.ChatMute, userSerializer auth.APIUserSerializer) *proto.ChatMute {
+	m := &proto.ChatMute{
+		Id:         orig.ID,
+		Address:    orig.Address,
+		MutedAt:    timestamppb.New(orig.MutedAt),
+		MutedUntil: timestamppb.New(orig.MutedUntil),
+		Source:     chatMuteSourceToProto[orig.Source],
+		Reason:     orig.Reason,
+	}
+	if orig.ModeratorAddress != "" {
+		m.MutedBy = userSerializer(ctx, auth.NewAddressOnlyUser(orig.ModeratorAddress))
+	}
+	if orig.LiftedAt.Valid {
+		m.LiftedAt = timestamppb.New(orig.LiftedAt.Time)
+		m.LiftReason = &orig.LiftReason
+	}
+	if orig.LiftedBy != nil {
+		m.LiftedBy = userSerializer(ctx, auth.NewAddressOnlyUser(*orig.LiftedBy))
+	}
+	return m
+}
+
+func (s *grpcServer) MuteChatUser(ctx context.Context, r *proto.MuteChatUserRequest) (*proto.MuteChatUserResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing reward address")
+	}
+
+	var duration time.Duration
+	if r.Duration != nil {
+		duration = r.Duration.AsDuration()
+	}
+
+	mute, err := s.chat.MuteUser(ctx, r.Address, duration, types.ChatMuteSourceModerator, r.Reason, moderator, moderator.ModeratorName())
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrInvalidMuteDuration) {
+			return nil, status.Error(codes.InvalidArgument, "invalid mute duration")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	actualDuration := mute.MutedUntil.Sub(mute.MutedAt)
+	s.log.Printf("Chat mute ID %s (%s) added by %s (remote address %s) with reason %s", mute.ID, actualDuration, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx), r.Reason)
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("**Added chat mute with ID `%s`**\n\nUser: %s\nDuration: %s\nReason: %s\nBy moderator: %s (%s)",
+				mute.ID,
+				r.Address,
+				actualDuration,
+				r.Reason,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.MuteChatUserResponse{
+		Mute: convertChatMute(ctx, mute, s.userSerializer),
+	}, nil
+}
+
+func (s *grpcServer) UnmuteChatUser(ctx context.Context, r *proto.UnmuteChatUserRequest) (*proto.UnmuteChatUserResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing reward address")
+	}
+
+	lifted, err := s.chat.UnmuteUser(ctx, r.Address, r.Reason, moderator)
+	if err != nil {
+		if errors.Is(err, chatmanager.ErrUserNotMuted) {
+			return nil, status.Error(codes.FailedPrecondition, "user not muted")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("%d chat mute(s) of %s lifted by %s (remote address %s) with reason %s", len(lifted), r.Address, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx), r.Reason)
+
+	if s.modLogWebhook != nil {
+		_, err = s.modLogWebhook.SendContent(
+			fmt.Sprintf("**Lifted chat mute of %s**\n\nReason: %s\nBy moderator: %s (%s)",
+				r.Address,
+				r.Reason,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.UnmuteChatUserResponse{}, nil
+}
+
+// chatMuteStatusError returns a gRPC error informing the user of how long they are muted for, if err was caused by
+// the user being muted, or nil otherwise
+func chatMuteStatusError(err error) error {
+	var mutedErr *chatmanager.MutedError
+	if errors.As(err, &mutedErr) {
+		return status.Errorf(codes.FailedPrecondition, "you are muted from chat for %s", time.Until(mutedErr.Until).Round(time.Second))
+	}
+	return nil
+}
+
+func serializeChatMuteUpdate(mute *types.ChatMute) *proto.ChatUpdateEvent {
+	event := &proto.ChatMuteUpdatedEvent{}
+	if mute != nil {
+		event.MutedUntil = timestamppb.New(mute.MutedUntil)
+		event.Reason = mute.Reason
+	}
+	return &proto.ChatUpdateEvent{
+		Event: &proto.ChatUpdateEvent_MuteUpdated{
+			MuteUpdated: event,
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/stores/chat"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultChatMessageContextWindow = 2 * time.Minute
+const maxChatMessageContextWindow = 30 * time.Minute
+
+func (s *grpcServer) SearchChatMessages(ctx context.Context, r *proto.SearchChatMessagesRequest) (*proto.SearchChatMessagesResponse, error) {
+	params := chat.SearchParams{
+		Query:        r.Query,
+		Shadowbanned: r.Shadowbanned,
+	}
+	if r.AuthorAddress != nil {
+		params.Author = *r.AuthorAddress
+	}
+	if r.AttachmentType != nil {
+		params.AttachmentType = *r.AttachmentType
+	}
+	if r.ChannelId != nil {
+		params.Channel = *r.ChannelId
+	}
+	if r.Since != nil {
+		since := r.Since.AsTime()
+		params.Since = &since
+	}
+	if r.Until != nil {
+		until := r.Until.AsTime()
+		params.Until = &until
+	}
+
+	messages, total, err := s.chat.SearchMessages(ctx, params, readPaginationParameters(r))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoMessages := make([]*proto.ChatMessage, len(messages))
+	for i := range messages {
+		protoMessages[i] = messages[i].SerializeForAPI(ctx, s.userSerializer)
+	}
+
+	return &proto.SearchChatMessagesResponse{
+		Messages: protoMessages,
+		Offset:   readOffset(r),
+		Total:    total,
+	}, nil
+}
+
+func (s *grpcServer) ChatMessageContext(ctx context.Context, r *proto.ChatMessageContextRequest) (*proto.ChatMessageContextResponse, error) {
+	window := defaultChatMessageContextWindow
+	if r.Window != nil {
+		window = r.Window.AsDuration()
+	}
+	if window <= 0 || window > maxChatMessageContextWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive and at most %s", maxChatMessageContextWindow)
+	}
+
+	messages, err := s.chat.LoadMessageContext(ctx, snowflake.ParseInt64(r.MessageId), window)
+	if err != nil {
+		if errors.Is(err, chat.ErrChatMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "message not found")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	protoMessages := make([]*proto.ChatMessage, len(messages))
+	for i := range messages {
+		protoMessages[i] = messages[i].SerializeForAPI(ctx, s.userSerializer)
+	}
+
+	return &proto.ChatMessageContextResponse{
+		Messages: protoMessages,
+	}, nil
+}
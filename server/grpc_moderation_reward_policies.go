@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func serializeRewardPolicy(policy rewards.RewardPolicy) *proto.RewardDistributionPolicy {
+	return &proto.RewardDistributionPolicy{
+		Name:        policy.Name(),
+		Description: policy.Description(),
+	}
+}
+
+func serializeRewardPolicies() []*proto.RewardDistributionPolicy {
+	policies := rewards.RewardPolicies()
+	protoPolicies := make([]*proto.RewardDistributionPolicy, len(policies))
+	for i, policy := range policies {
+		protoPolicies[i] = serializeRewardPolicy(policy)
+	}
+	return protoPolicies
+}
+
+func (s *grpcServer) SetRewardDistributionPolicy(ctx context.Context, r *proto.SetRewardDistributionPolicyRequest) (*proto.SetRewardDistributionPolicyResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	err := s.rewardsHandler.SetRewardPolicy(r.Policy)
+	if err != nil {
+		if errors.Is(err, rewards.ErrUnknownRewardPolicy) {
+			return nil, status.Error(codes.InvalidArgument, "unknown reward distribution policy")
+		}
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	s.log.Printf("Reward distribution policy set to %s by %s (remote address %s)", r.Policy, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Reward distribution policy set to `%s` by moderator: %s (%s)",
+				r.Policy,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetRewardDistributionPolicyResponse{}, nil
+}
+
+func (s *grpcServer) SimulateRewardDistribution(ctx context.Context, r *proto.SimulateRewardDistributionRequest) (*proto.SimulateRewardDistributionResponse, error) {
+	_, playing := s.mediaQueue.CurrentlyPlaying()
+
+	budget, eligibleCount, simulations, err := s.rewardsHandler.SimulateRewardDistribution(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	activePolicy := s.rewardsHandler.RewardPolicy().Name()
+	protoSimulations := make([]*proto.RewardDistributionPolicySimulation, len(simulations))
+	for i, simulation := range simulations {
+		protoRewards := make([]*proto.SimulatedReward, 0, len(simulation.Rewards))
+		distributed := payment.NewAmount()
+		for address, amount := range simulation.Rewards {
+			protoRewards = append(protoRewards, &proto.SimulatedReward{
+				RewardsAddress: address,
+				Amount:         amount.SerializeForAPI(),
+			})
+			distributed.Add(distributed.Int, amount.Int)
+		}
+		sort.Slice(protoRewards, func(i, j int) bool {
+			return protoRewards[i].RewardsAddress < protoRewards[j].RewardsAddress
+		})
+
+		protoSimulations[i] = &proto.RewardDistributionPolicySimulation{
+			Policy:            serializeRewardPolicy(simulation.Policy),
+			Active:            simulation.Policy.Name() == activePolicy,
+			RequesterReward:   simulation.RequesterReward.SerializeForAPI(),
+			DistributedAmount: distributed.SerializeForAPI(),
+			Rewards:           protoRewards,
+		}
+	}
+
+	return &proto.SimulateRewardDistributionResponse{
+		MediaPlaying:       playing,
+		Budget:             budget.SerializeForAPI(),
+		EligibleSpectators: uint32(eligibleCount),
+		Simulations:        protoSimulations,
+	}, nil
+}
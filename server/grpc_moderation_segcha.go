@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/segcha"
+	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (s *grpcServer) SegchaPuzzleStatistics(ctx context.Context, r *proto.SegchaPuzzleStatisticsRequest) (*proto.SegchaPuzzleStatisticsResponse, error) {
+	period := segchaAdaptiveWindow
+	if r.Period != nil {
+		period = r.Period.AsDuration()
+		if period <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid period")
+		}
+	}
+
+	statistics, err := s.segchaStepStatisticsSince(ctx, time.Now().Add(-period))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	configuredProbabilities := s.segchaFamilyWeights.PickProbabilities()
+	effectiveProbabilities := s.segchaFamilyWeightsInEffect().PickProbabilities()
+
+	families := []*proto.SegchaPuzzleFamilyStatistics{}
+	for _, name := range segcha.PuzzleFamilyNames() {
+		f := statistics[name]
+		category, _ := segcha.PuzzleFamilyPictureCategory(name)
+		families = append(families, &proto.SegchaPuzzleFamilyStatistics{
+			Name:                      name,
+			PictureCategory:           category,
+			Answered:                  uint32(f.Answered),
+			Correct:                   uint32(f.Correct),
+			FailureRate:               f.FailureRate(),
+			LegitimateAnswered:        uint32(f.LegitimateAnswered),
+			LegitimateCorrect:         uint32(f.LegitimateCorrect),
+			LegitimateFailureRate:     f.LegitimateFailureRate(),
+			AverageSolveTime:          durationpb.New(f.AverageSolveTime()),
+			ConfiguredPickProbability: configuredProbabilities[name],
+			EffectivePickProbability:  effectiveProbabilities[name],
+		})
+	}
+
+	return &proto.SegchaPuzzleStatisticsResponse{
+		Families:                  families,
+		AdaptiveDifficultyEnabled: s.segchaAdaptiveDifficultyEnabled(),
+	}, nil
+}
+
+func (s *grpcServer) SetSegchaAdaptiveDifficultyEnabled(ctx context.Context, r *proto.SetSegchaAdaptiveDifficultyEnabledRequest) (*proto.SetSegchaAdaptiveDifficultyEnabledResponse, error) {
+	moderator := authinterceptor.UserClaimsFromContext(ctx)
+	if moderator == nil {
+		// this should never happen, as the auth interceptors should have taken care of this for us
+		return nil, status.Error(codes.Unauthenticated, "missing user claims")
+	}
+
+	if r.Enabled {
+		// compute the weights right away instead of waiting for the worker
+		err := s.updateSegchaAdaptedFamilyWeights(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+	}
+
+	func() {
+		s.segchaAdaptiveMutex.Lock()
+		defer s.segchaAdaptiveMutex.Unlock()
+		s.segchaAdaptiveDifficulty = r.Enabled
+	}()
+
+	action := "disabled"
+	if r.Enabled {
+		action = "enabled"
+	}
+
+	s.log.Printf("Segcha adaptive difficulty %s by %s (remote address %s)", action, moderator.ModeratorName(), authinterceptor.RemoteAddressFromContext(ctx))
+
+	if s.modLogWebhook != nil {
+		_, err := s.modLogWebhook.SendContent(
+			fmt.Sprintf("Segcha adaptive difficulty %s by moderator: %s (%s)",
+				action,
+				moderator.Address()[:14],
+				moderator.ModeratorName()))
+		if err != nil {
+			s.log.Println("Failed to send mod log webhook:", err)
+		}
+	}
+
+	return &proto.SetSegchaAdaptiveDifficultyEnabledResponse{}, nil
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultSpectatorAnalyticsPeriod = 30 * 24 * time.Hour
+
+func (s *grpcServer) SpectatorActivity(ctxCtx context.Context, r *proto.SpectatorActivityRequest) (*proto.SpectatorActivityResponse, error) {
+	until := time.Now()
+	if r.Until != nil {
+		until = r.Until.AsTime()
+	}
+	since := until.Add(-defaultSpectatorAnalyticsPeriod)
+	if r.Since != nil {
+		since = r.Since.AsTime()
+	}
+	if !since.Before(until) {
+		return nil, status.Error(codes.InvalidArgument, "invalid interval")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	activity, err := types.GetDailySpectatorActivity(ctx, since, until)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	days := make([]*proto.DailySpectatorActivity, len(activity))
+	for i, a := range activity {
+		days[i] = &proto.DailySpectatorActivity{
+			Day:                  timestamppb.New(a.Day),
+			ActiveWatchers:       uint32(a.ActiveWatchers),
+			Sessions:             uint32(a.Sessions),
+			AverageSessionLength: durationpb.New(a.AverageSessionLength),
+			MediaWatched:         uint32(a.MediaWatched),
+			ChallengesPassed:     uint32(a.ChallengesPassed),
+			ChallengesFailed:     uint32(a.ChallengesFailed),
+			RewardsEarned:        payment.NewAmountFromDecimal(a.RewardsEarned).SerializeForAPI(),
+		}
+	}
+
+	return &proto.SpectatorActivityResponse{
+		Days: days,
+	}, nil
+}
+
+func (s *grpcServer) SpectatorRetention(ctxCtx context.Context, r *proto.SpectatorRetentionRequest) (*proto.SpectatorRetentionResponse, error) {
+	since := time.Now().Add(-defaultSpectatorAnalyticsPeriod)
+	if r.Since != nil {
+		since = r.Since.AsTime()
+	}
+
+	var granularity types.SpectatorActivityGranularity
+	switch r.Granularity {
+	case proto.SpectatorActivityGranularity_SPECTATOR_ACTIVITY_DAILY:
+		granularity = types.SpectatorActivityGranularityDay
+	case proto.SpectatorActivityGranularity_SPECTATOR_ACTIVITY_WEEKLY:
+		granularity = types.SpectatorActivityGranularityWeek
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid granularity")
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	cohorts, err := types.GetSpectatorRetentionCohorts(ctx, since, granularity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	churn, err := types.GetSpectatorChurn(ctx, since, granularity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	response := &proto.SpectatorRetentionResponse{
+		Cohorts: make([]*proto.SpectatorRetentionCohort, len(cohorts)),
+		Churn:   make([]*proto.SpectatorChurn, len(churn)),
+	}
+	for i, c := range cohorts {
+		activeInPeriod := make([]uint32, len(c.ActiveInPeriod))
+		for j, active := range c.ActiveInPeriod {
+			activeInPeriod[j] = uint32(active)
+		}
+		response.Cohorts[i] = &proto.SpectatorRetentionCohort{
+			Start:          timestamppb.New(c.Start),
+			ActiveInPeriod: activeInPeriod,
+		}
+	}
+	for i, c := range churn {
+		churnRate := 0.0
+		if c.PreviouslyActive > 0 {
+			churnRate = float64(c.Churned) / float64(c.PreviouslyActive)
+		}
+		response.Churn[i] = &proto.SpectatorChurn{
+			Period:           timestamppb.New(c.Period),
+			PreviouslyActive: uint32(c.PreviouslyActive),
+			Churned:          uint32(c.Churned),
+			ChurnRate:        churnRate,
+		}
+	}
+	return response, nil
+}
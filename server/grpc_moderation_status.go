@@ -21,6 +21,9 @@ func (s *grpcServer) MonitorModerationStatus(r *proto.MonitorModerationStatusReq
 	onVersionHashChanged, versionHashChangedU := s.versionHashChanged.Subscribe(event.BufferFirst)
 	defer versionHashChangedU()
 
+	onReportQueueUpdated, reportQueueUpdatedU := s.chat.OnReportQueueUpdated().Subscribe(event.BufferFirst)
+	defer reportQueueUpdatedU()
+
 	send := func() error {
 		users := s.staffActivityManager.ActivelyModerating()
 		protoUsers := make([]*proto.User, len(users))
@@ -49,18 +52,29 @@ func (s *grpcServer) MonitorModerationStatus(r *proto.MonitorModerationStatusReq
 			return s.allowMediaEnqueuing
 		}()
 
+		reportQueue, err := s.serializeChatMessageReportQueue(stream.Context())
+		if err != nil {
+			return stacktrace.Propagate(err, "")
+		}
+
 		overview := &proto.ModerationStatusOverview{
-			AllowedMediaEnqueuing:               allowMediaEnqueuing,
-			EnqueuingPricesMultiplier:           int32(s.pricer.FinalPricesMultiplier()),
-			CrowdfundedSkippingEnabled:          s.skipManager.CrowdfundedSkippingEnabled(),
-			CrowdfundedSkippingPricesMultiplier: int32(s.pricer.SkipPriceMultiplier()),
-			NewEntriesAlwaysUnskippable:         s.enqueueManager.NewEntriesAlwaysUnskippableForFree(),
-			OwnEntryRemovalEnabled:              s.mediaQueue.RemovalOfOwnEntriesAllowed(),
-			AllSkippingEnabled:                  s.mediaQueue.SkippingEnabled(),
-			MinimumPricesMultiplier:             int32(s.pricer.MinimumPricesMultiplier()),
-			ActivelyModerating:                  protoUsers,
-			AllowEntryReordering:                s.mediaQueue.EntryReorderingAllowed(),
-			VipUsers:                            protoVipUsers,
+			AllowedMediaEnqueuing:                       allowMediaEnqueuing,
+			EnqueuingPricesMultiplier:                   int32(s.pricer.FinalPricesMultiplier()),
+			CrowdfundedSkippingEnabled:                  s.skipManager.CrowdfundedSkippingEnabled(),
+			CrowdfundedSkippingPricesMultiplier:         int32(s.pricer.SkipPriceMultiplier()),
+			NewEntriesAlwaysUnskippable:                 s.enqueueManager.NewEntriesAlwaysUnskippableForFree(),
+			OwnEntryRemovalEnabled:                      s.mediaQueue.RemovalOfOwnEntriesAllowed(),
+			AllSkippingEnabled:                          s.mediaQueue.SkippingEnabled(),
+			MinimumPricesMultiplier:                     int32(s.pricer.MinimumPricesMultiplier()),
+			ActivelyModerating:                          protoUsers,
+			AllowEntryReordering:                        s.mediaQueue.EntryReorderingAllowed(),
+			VipUsers:                                    protoVipUsers,
+			ChatMessageReportQueue:                      reportQueue,
+			RewardDistributionPolicy:                    s.rewardsHandler.RewardPolicy().Name(),
+			AvailableRewardDistributionPolicies:         serializeRewardPolicies(),
+			ActivityChallengeSelectionPolicy:            s.rewardsHandler.ActivityChallengeSelectionPolicy().Name(),
+			AvailableActivityChallengeSelectionPolicies: serializeActivityChallengeSelectionPolicies(),
+			SegchaAdaptiveDifficultyEnabled:             s.segchaAdaptiveDifficultyEnabled(),
 		}
 		queueInsertCursor, hasQueueInsertCursor := s.mediaQueue.InsertCursor()
 		if hasQueueInsertCursor {
@@ -77,6 +91,8 @@ func (s *grpcServer) MonitorModerationStatus(r *proto.MonitorModerationStatusReq
 		select {
 		case <-heartbeat.C:
 			err = send()
+		case <-onReportQueueUpdated:
+			err = send()
 		case <-onVersionHashChanged:
 			return nil
 		case <-stream.Context().Done():
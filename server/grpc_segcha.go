@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/palantir/stacktrace"
 	uuid "github.com/satori/go.uuid"
@@ -11,15 +12,26 @@ import (
 	"github.com/tnyim/jungletv/segcha"
 	"github.com/tnyim/jungletv/server/components/rewards"
 	authinterceptor "github.com/tnyim/jungletv/server/interceptors/auth"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var segchaChallengeSteps = 4
 var segchaWrongAnswersTolerance = 1
-var segchaPremadeQueueSize = 150
+var segchaPoolBatchSize = 25
 var latestGeneratedChallenge *segcha.Challenge
 
+// segchaAdaptiveWindow is how far back the step results used for adaptive difficulty go
+var segchaAdaptiveWindow = 7 * 24 * time.Hour
+
+type segchaChallengeAnswers struct {
+	answers    []int
+	families   []string
+	producedAt time.Time
+}
+
 func (s *grpcServer) ProduceSegchaChallenge(ctx context.Context, r *proto.ProduceSegchaChallengeRequest) (*proto.ProduceSegchaChallengeResponse, error) {
 	user := authinterceptor.UserClaimsFromContext(ctx)
 
@@ -37,16 +49,16 @@ func (s *grpcServer) ProduceSegchaChallenge(ctx context.Context, r *proto.Produc
 
 	challenge := latestGeneratedChallenge
 	challengeID := uuid.NewV4().String()
-	select {
-	case challenge = <-s.captchaChallengesQueue:
+	if pooledChallenge, ok := s.segchaPool.Take(); ok {
+		challenge = pooledChallenge
 		challengeID = challenge.ID()
-		break
-	default:
+	} else {
+		go s.statsClient.Count("segcha_pool_depleted", 1)
 		if challenge == nil {
 			func() {
 				s.captchaGenerationMutex.Lock()
 				defer s.captchaGenerationMutex.Unlock()
-				challenge, err = segcha.NewChallenge(segchaChallengeSteps, s.captchaImageDB, s.captchaFontPath)
+				challenge, err = segcha.NewChallenge(segchaChallengeSteps, s.captchaImageDB, s.captchaFontPath, s.segchaFamilyWeightsInEffect())
 				latestGeneratedChallenge = challenge
 				challengeID = challenge.ID()
 			}()
@@ -61,7 +73,11 @@ func (s *grpcServer) ProduceSegchaChallenge(ctx context.Context, r *proto.Produc
 
 	pictures := challenge.Pictures()
 
-	s.captchaAnswers.SetDefault(challengeID, challenge.Answers())
+	s.captchaAnswers.SetDefault(challengeID, segchaChallengeAnswers{
+		answers:    challenge.Answers(),
+		families:   challenge.Families(),
+		producedAt: time.Now(),
+	})
 
 	steps := make([]*proto.SegchaChallengeStep, len(pictures))
 	for i := range pictures {
@@ -79,28 +95,221 @@ func (s *grpcServer) ProduceSegchaChallenge(ctx context.Context, r *proto.Produc
 func (s *grpcServer) segchaResponseValid(ctx context.Context, _ *rewards.ActivityChallenge, segchaResponse string) (bool, error) {
 	parts := strings.Split(segchaResponse, ",")
 
-	correctAnswers, present := s.captchaAnswers.Get(parts[0])
+	challengeAnswers, present := s.captchaAnswers.Get(parts[0])
 	if !present {
 		return false, nil
 	}
 	s.captchaAnswers.Delete(parts[0])
+	correctAnswers := challengeAnswers.answers
 
 	if len(parts)-1 != len(correctAnswers) {
 		return false, nil
 	}
 
-	gotRight := 0
+	userAnswers := make([]int, len(correctAnswers))
 	for i := range correctAnswers {
-		userAnswer, err := strconv.Atoi(parts[i+1])
+		var err error
+		userAnswers[i], err = strconv.Atoi(parts[i+1])
 		if err != nil {
 			return false, nil
 		}
-		if userAnswer == correctAnswers[i] {
+	}
+
+	gotRight := 0
+	for i := range correctAnswers {
+		if userAnswers[i] == correctAnswers[i] {
 			gotRight++
 		}
 	}
+	passed := gotRight >= len(correctAnswers)-segchaWrongAnswersTolerance
+
+	// users who pass the challenge as a whole are considered legitimate: when they fail a step, it's more likely that
+	// the step is too hard than that they are guessing
+	stepSolveTime := time.Since(challengeAnswers.producedAt) / time.Duration(len(correctAnswers))
+	for i := range correctAnswers {
+		// challenges generated by older segcha servers may not include the families
+		if i < len(challengeAnswers.families) {
+			s.recordSegchaStepResult(challengeAnswers.families[i], userAnswers[i] == correctAnswers[i], passed, stepSolveTime)
+		}
+	}
+
+	return passed, nil
+}
+
+func (s *grpcServer) recordSegchaStepResult(family string, correct, legitimate bool, solveTime time.Duration) {
+	s.segchaStatistics.RecordStep(family, correct, legitimate, solveTime)
+	s.segchaPendingStatistics.RecordStep(family, correct, legitimate, solveTime)
+	if correct {
+		go s.statsClient.Increment("segcha_step_correct." + family)
+	} else {
+		go s.statsClient.Increment("segcha_step_incorrect." + family)
+	}
+}
+
+func (s *grpcServer) logSegchaStatistics() {
+	statistics := s.segchaStatistics.Snapshot()
+	for _, family := range segcha.PuzzleFamilyNames() {
+		if familyStatistics, ok := statistics[family]; ok {
+			s.log.Printf("segcha puzzle family %s: %d steps answered, %.1f%% failure rate",
+				family, familyStatistics.Answered, familyStatistics.FailureRate()*100)
+		}
+	}
+}
+
+// flushSegchaStatistics adds the step results recorded since the last flush to the database
+func (s *grpcServer) flushSegchaStatistics(ctxCtx context.Context) error {
+	pending := s.segchaPendingStatistics.Flush()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	statistics := make([]*types.SegchaStepStatistics, 0, len(pending))
+	for family, f := range pending {
+		category, _ := segcha.PuzzleFamilyPictureCategory(family)
+		statistics = append(statistics, &types.SegchaStepStatistics{
+			Day:                day,
+			PuzzleFamily:       family,
+			PictureCategory:    category,
+			Answered:           f.Answered,
+			Correct:            f.Correct,
+			LegitimateAnswered: f.LegitimateAnswered,
+			LegitimateCorrect:  f.LegitimateCorrect,
+			TotalSolveTimeMs:   f.TotalSolveTime.Milliseconds(),
+		})
+	}
+
+	err = types.AddToSegchaStepStatistics(ctx, statistics)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// segchaStepStatisticsSince returns the statistics of each puzzle family since the specified instant, as stored in the
+// database
+func (s *grpcServer) segchaStepStatisticsSince(ctxCtx context.Context, since time.Time) (map[string]segcha.FamilyStatistics, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	stored, err := types.SumSegchaStepStatisticsSince(ctx, since)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	statistics := make(map[string]segcha.FamilyStatistics, len(stored))
+	for family, f := range stored {
+		statistics[family] = segcha.FamilyStatistics{
+			Answered:           f.Answered,
+			Correct:            f.Correct,
+			LegitimateAnswered: f.LegitimateAnswered,
+			LegitimateCorrect:  f.LegitimateCorrect,
+			TotalSolveTime:     time.Duration(f.TotalSolveTimeMs) * time.Millisecond,
+		}
+	}
+	return statistics, nil
+}
+
+// updateSegchaAdaptedFamilyWeights recomputes the puzzle family weights used when adaptive difficulty is enabled
+func (s *grpcServer) updateSegchaAdaptedFamilyWeights(ctx context.Context) error {
+	statistics, err := s.segchaStepStatisticsSince(ctx, time.Now().Add(-segchaAdaptiveWindow))
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	adapted := segcha.AdaptFamilyWeights(s.segchaFamilyWeights, statistics, segcha.DefaultAdaptiveWeightsParams)
+
+	s.segchaAdaptiveMutex.Lock()
+	defer s.segchaAdaptiveMutex.Unlock()
+	s.segchaAdaptedFamilyWeights = adapted
+	return nil
+}
+
+// segchaFamilyWeightsInEffect returns the puzzle family weights to use when generating new challenges
+func (s *grpcServer) segchaFamilyWeightsInEffect() segcha.FamilyWeights {
+	s.segchaAdaptiveMutex.RLock()
+	defer s.segchaAdaptiveMutex.RUnlock()
+	if s.segchaAdaptiveDifficulty && s.segchaAdaptedFamilyWeights != nil {
+		return s.segchaAdaptedFamilyWeights
+	}
+	return s.segchaFamilyWeights
+}
+
+func (s *grpcServer) segchaAdaptiveDifficultyEnabled() bool {
+	s.segchaAdaptiveMutex.RLock()
+	defer s.segchaAdaptiveMutex.RUnlock()
+	return s.segchaAdaptiveDifficulty
+}
+
+func (s *grpcServer) refillSegchaPool(ctx context.Context) error {
+	if expired := s.segchaPool.RemoveExpired(); expired > 0 {
+		go s.statsClient.Count("segcha_pool_expired", expired)
+	}
+
+	missing := s.segchaPool.Missing()
+	if missing <= 0 {
+		return nil
+	}
 
-	return gotRight >= len(correctAnswers)-segchaWrongAnswersTolerance, nil
+	if s.segchaClient != nil {
+		ctxT, cancelFn := context.WithTimeout(ctx, 2*time.Minute)
+		added, err := segcha.FillPoolUsingClient(ctxT, segchaChallengeSteps, s.segchaFamilyWeightsInEffect(),
+			min(missing, segchaPoolBatchSize), s.segchaClient, s.segchaPool)
+		cancelFn()
+		if added > 0 {
+			s.log.Printf("added %d remotely generated segcha challenges to pool (%d in pool)", added, s.segchaPool.Len())
+		}
+		if err == nil && added > 0 {
+			return nil
+		}
+		if err != nil {
+			s.log.Printf("remote segcha challenge batch creation failed: %v", err)
+		}
+		// fall through to local generation
+	}
+
+	s.captchaGenerationMutex.Lock()
+	defer s.captchaGenerationMutex.Unlock()
+	challenge, err := segcha.NewChallenge(segchaChallengeSteps, s.captchaImageDB, s.captchaFontPath, s.segchaFamilyWeightsInEffect())
+	if err != nil {
+		return stacktrace.Propagate(err, "failed to locally create segcha challenge")
+	}
+	latestGeneratedChallenge = challenge
+	if s.segchaPool.Put(challenge) {
+		s.log.Printf("added locally generated segcha challenge to pool (%d in pool)", s.segchaPool.Len())
+	}
+	return nil
+}
+
+func (s *grpcServer) restoreSegchaPool() {
+	if s.segchaPoolFile == "" {
+		return
+	}
+	restored, err := s.segchaPool.LoadFromFile(s.segchaPoolFile)
+	if err != nil {
+		s.log.Printf("error restoring segcha pool from file: %v", err)
+		return
+	}
+	s.log.Printf("restored %d segcha challenges from file", restored)
+}
+
+func (s *grpcServer) persistSegchaPool() {
+	if s.segchaPoolFile == "" {
+		return
+	}
+	err := s.segchaPool.SaveToFile(s.segchaPoolFile)
+	if err != nil {
+		s.log.Printf("error writing segcha pool to file: %v", err)
+	}
 }
 
 func (s *grpcServer) turnstileResponseValid(ctx context.Context, challenge *rewards.ActivityChallenge, challengeResponse string) (bool, error) {
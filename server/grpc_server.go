@@ -92,12 +92,21 @@ type grpcServer struct {
 
 	captchaImageDB         *segcha.ImageDatabase
 	captchaFontPath        string
-	captchaAnswers         *cache.Cache[string, []int]
-	captchaChallengesQueue chan *segcha.Challenge
+	captchaAnswers         *cache.Cache[string, segchaChallengeAnswers]
+	segchaPool             *segcha.Pool
+	segchaPoolFile         string
 	captchaGenerationMutex sync.Mutex
 	segchaClient           segchaproto.SegchaClient
+	segchaFamilyWeights    segcha.FamilyWeights
+	segchaStatistics       *segcha.Statistics
 	turnstileClient        *turnstileclient.Turnstile
 
+	// segchaPendingStatistics holds the step results not yet added to the database
+	segchaPendingStatistics    *segcha.Statistics
+	segchaAdaptiveMutex        sync.RWMutex
+	segchaAdaptiveDifficulty   bool
+	segchaAdaptedFamilyWeights segcha.FamilyWeights
+
 	allowMediaEnqueuingMutex            sync.RWMutex
 	allowMediaEnqueuing                 proto.AllowedMediaEnqueuingType
 	allowMediaEnqueuingChanged          event.Event[allowedMediaEnqueuingChangedEventArgs]
@@ -164,18 +173,27 @@ type Options struct {
 	AuthInterceptor *authinterceptor.Interceptor
 
 	TicketCheckPeriod time.Duration
-	IPCheckEndpoint   string
 	YoutubeAPIkey     string
 	RaffleSecretKey   string
 
+	SpectatorSessionHashKey []byte
+
+	IPReputationProviders       []ipreputation.WeightedProvider
+	IPReputationCacheExpiration time.Duration
+
 	ModLogWebhook string
 
-	SegchaClient    segchaproto.SegchaClient
-	CaptchaImageDB  *segcha.ImageDatabase
-	CaptchaFontPath string
+	SegchaClient        segchaproto.SegchaClient
+	SegchaFamilyWeights segcha.FamilyWeights
+	SegchaPoolSize      int
+	SegchaPoolMaxAge    time.Duration
+	SegchaPoolFile      string
+	CaptchaImageDB      *segcha.ImageDatabase
+	CaptchaFontPath     string
 
-	AppRunner     *apprunner.AppRunner
-	ConfigManager *configurationmanager.Manager
+	AppRunner        *apprunner.AppRunner
+	ConfigManager    *configurationmanager.Manager
+	AppBundleOptions appeditor.BundleOptions
 
 	AutoEnqueueVideoListFile string
 	QueueFile                string
@@ -191,6 +209,8 @@ type Options struct {
 
 	TurnstileSecretKey string
 
+	ProofOfWorkChallengesEnabled bool
+
 	PrivilegedLabUserSecretKey string
 }
 
@@ -200,6 +220,17 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RewardInfo", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Withdraw", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SendChatMessage", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/EditChatMessage", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MarkChatRepliesAsRead", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ConsumeDirectMessages", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SendDirectMessage", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/DirectMessageConversation", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetDirectMessagesEnabled", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ReportDirectMessageConversation", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AddChatMessageReaction", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ReportChatMessage", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/VoteInChatPoll", auth.UserPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveChatMessageReaction", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetChatNickname", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RewardHistory", auth.UserPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/WithdrawalHistory", auth.UserPermissionLevel)
@@ -232,10 +263,19 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UserBans", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/BanUser", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveBan", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ChatMutes", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/MuteChatUser", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UnmuteChatUser", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ResolveChatMessageReports", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CreateChatPoll", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UserVerifications", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/VerifyUser", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveUserVerification", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UserChatMessages", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SearchChatMessages", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ChatMessageContext", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/DirectMessageReports", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ReportedDirectMessageConversation", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/DisallowedVideos", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/AddDisallowedVideo", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveDisallowedVideo", auth.AdminPermissionLevel)
@@ -245,6 +285,13 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetMinimumPricesMultiplier", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetCrowdfundedSkippingEnabled", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetSkipPriceMultiplier", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetRewardDistributionPolicy", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetActivityChallengeSelectionPolicy", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SegchaPuzzleStatistics", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetSegchaAdaptiveDifficultyEnabled", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SpectatorActivity", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SpectatorRetention", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SimulateRewardDistribution", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ConfirmRaffleWinner", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/CompleteRaffle", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RedrawRaffle", auth.AdminPermissionLevel)
@@ -268,6 +315,11 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetMulticurrencyPaymentsEnabled", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Documents", auth.AdminPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/InvalidateUserAuthTokens", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ChatFilterRules", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetChatFilterRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveChatFilterRule", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/SetChatChannel", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/RemoveChatChannel", auth.AdminPermissionLevel)
 
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/Applications", auth.AppEditorPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/GetApplication", auth.AppEditorPermissionLevel)
@@ -287,7 +339,17 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/EvaluateExpressionOnApplication", auth.AppEditorPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ExportApplication", auth.AppEditorPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ImportApplication", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ExportApplicationBundle", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/PreviewApplicationBundleImport", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ImportApplicationBundle", auth.AppEditorPermissionLevel)
 	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/TypeScriptTypeDefinitions", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/DebugApplication", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ApplicationDebuggerCommand", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/StartApplicationProfiler", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/StopApplicationProfiler", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ApplicationHeapSnapshot", auth.AppEditorPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/ApplicationSettings", auth.AdminPermissionLevel)
+	authInterceptor.SetMinimumPermissionLevelForMethod("/jungletv.JungleTV/UpdateApplicationSetting", auth.AdminPermissionLevel)
 
 	ytClient, err := youtubeapi.NewService(ctx, option.WithAPIKey(options.YoutubeAPIkey))
 	if err != nil {
@@ -331,7 +393,7 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		autoEnqueueVideos:          options.AutoEnqueueVideoListFile != "",
 		allowMediaEnqueuing:        proto.AllowedMediaEnqueuingType_ENABLED,
 		allowMediaEnqueuingChanged: event.New[allowedMediaEnqueuingChangedEventArgs](),
-		ipReputationChecker:        ipreputation.NewChecker(ctx, options.Log, options.IPCheckEndpoint),
+		ipReputationChecker:        ipreputation.NewChecker(ctx, options.Log, options.IPReputationProviders, options.IPReputationCacheExpiration),
 		ticketCheckPeriod:          options.TicketCheckPeriod,
 		staffActivityManager:       staffactivitymanager.New(options.StatsClient),
 		moderationStore:            modStore,
@@ -343,12 +405,16 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 
 		oauthManager: options.OAuthManager,
 
-		captchaAnswers:         cache.New[string, []int](1*time.Hour, 5*time.Minute),
-		captchaImageDB:         options.CaptchaImageDB,
-		captchaFontPath:        options.CaptchaFontPath,
-		captchaChallengesQueue: make(chan *segcha.Challenge, segchaPremadeQueueSize),
-		segchaClient:           options.SegchaClient,
-		turnstileClient:        turnstileclient.New(options.TurnstileSecretKey, 10*time.Second),
+		captchaAnswers:          cache.New[string, segchaChallengeAnswers](1*time.Hour, 5*time.Minute),
+		captchaImageDB:          options.CaptchaImageDB,
+		captchaFontPath:         options.CaptchaFontPath,
+		segchaPool:              segcha.NewPool(options.SegchaPoolSize, options.SegchaPoolMaxAge),
+		segchaPoolFile:          options.SegchaPoolFile,
+		segchaClient:            options.SegchaClient,
+		segchaFamilyWeights:     options.SegchaFamilyWeights,
+		segchaStatistics:        segcha.NewStatistics(),
+		segchaPendingStatistics: segcha.NewStatistics(),
+		turnstileClient:         turnstileclient.New(options.TurnstileSecretKey, 10*time.Second),
 
 		mediaProviders:     mediaProviders,
 		soundCloudProvider: soundCloudProvider.(*soundcloud.TrackProvider),
@@ -367,7 +433,7 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 		privilegedLabUserSecretKey: options.PrivilegedLabUserSecretKey,
 	}
 	s.thirdPartyAuthorizer = auth.NewThirdPartyAuthorizer(s.jwtManager)
-	s.appEditor = appeditor.New(s.log, s.appRunner)
+	s.appEditor = appeditor.New(s.log, s.appRunner, options.AppBundleOptions)
 	s.userSerializer = s.serializeUserForAPI
 
 	s.snowflakeNode, err = snowflake.NewNode(1)
@@ -458,22 +524,30 @@ func NewServer(ctx context.Context, options Options) (*grpcServer, error) {
 
 	chatStore := chat.NewStoreDatabase(s.log, s.nicknameCache)
 	s.chat, err = chatmanager.New(s.log, s.statsClient, chatStore, s.moderationStore,
-		blockeduser.NewStoreDatabase(), s.userSerializer, s.pointsManager, s.snowflakeNode, options.TenorAPIKey)
+		blockeduser.NewStoreDatabase(), s.userSerializer, s.pointsManager, s.snowflakeNode, options.TenorAPIKey, s.modLogWebhook)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
 
 	s.withdrawalHandler = withdrawalhandler.New(s.log, s.statsClient, s.collectorAccountQueue, &s.wallet.RPC, s.modLogWebhook)
 
-	challengeCheckers := map[rewards.ActivityChallengeType]rewards.ChallengeCheckFunction{
-		rewards.ActivityChallengeTypeSegcha:    s.segchaResponseValid,
-		rewards.ActivityChallengeTypeTurnstile: s.turnstileResponseValid,
+	challengeProviders := []rewards.ActivityChallengeProvider{
+		rewards.NewCheckedActivityChallengeProvider(rewards.ActivityChallengeTypeSegcha, 2*time.Minute, s.segchaResponseValid),
+		/*
+			Turnstile challenges temporarily disabled until pass rate issues for mobile users can be investigated
+
+			rewards.NewCheckedActivityChallengeProvider(rewards.ActivityChallengeTypeTurnstile, 2*time.Minute, s.turnstileResponseValid),
+		*/
+	}
+
+	if options.ProofOfWorkChallengesEnabled {
+		challengeProviders = append(challengeProviders, rewards.NewProofOfWorkActivityChallengeProvider())
 	}
 
 	s.rewardsHandler, err = rewards.NewHandler(
 		s.log, options.StatsClient, s.mediaQueue, s.ipReputationChecker, s.withdrawalHandler, options.Wallet,
 		s.collectorAccountQueue, s.skipManager, s.chat, s.pointsManager, s.paymentAccountPool, s.moderationStore,
-		s.staffActivityManager, challengeCheckers, options.VersionHash)
+		s.staffActivityManager, challengeProviders, options.SpectatorSessionHashKey, options.VersionHash)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
 	}
@@ -8,7 +8,6 @@ import (
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/buildconfig"
 	"github.com/tnyim/jungletv/proto"
-	"github.com/tnyim/jungletv/segcha"
 	"github.com/tnyim/jungletv/server/media"
 	"github.com/tnyim/jungletv/utils/event"
 	"github.com/tnyim/jungletv/utils/transaction"
@@ -123,51 +122,56 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 		}
 	}(ctx)
 
-	// challenge creation is unfortunately slower than it should, so we attempt to use a remote worker
-	// to cache challenges in a queue so they can be used later
+	// challenge creation is unfortunately slower than it should, so we keep a pool of pre-generated challenges,
+	// preferably generated in batches by a remote worker, which is persisted between restarts
 	go func(ctx context.Context) {
-		makeChallenge := func() *segcha.Challenge {
-			for {
-				if s.segchaClient != nil {
-					ctxT, cancelFn := context.WithDeadline(ctx, time.Now().Add(10*time.Second))
-					challenge, err := segcha.NewChallengeUsingClient(ctxT, segchaChallengeSteps, s.segchaClient)
-					cancelFn()
-					if err != nil {
-						s.log.Printf("remote segcha challenge creation failed: %v", err)
-						// fall through to local generation
-					} else {
-						return challenge
-					}
-				}
-				challenge, err := segcha.NewChallenge(segchaChallengeSteps, s.captchaImageDB, s.captchaFontPath)
-				if err != nil {
-					errChan <- stacktrace.Propagate(err, "failed to locally create segcha challenge")
-				} else {
-					return challenge
-				}
-			}
-		}
+		s.restoreSegchaPool()
 
 		t := time.NewTicker(5 * time.Second)
 		defer t.Stop()
 
+		persistTicker := time.NewTicker(5 * time.Minute)
+		defer persistTicker.Stop()
+
+		statisticsTicker := time.NewTicker(1 * time.Hour)
+		defer statisticsTicker.Stop()
+
+		flushStatisticsTicker := time.NewTicker(1 * time.Minute)
+		defer flushStatisticsTicker.Stop()
+
+		adaptiveTicker := time.NewTicker(10 * time.Minute)
+		defer adaptiveTicker.Stop()
+
 		for {
 			select {
+			case <-statisticsTicker.C:
+				s.logSegchaStatistics()
+			case <-flushStatisticsTicker.C:
+				err := s.flushSegchaStatistics(ctx)
+				if err != nil {
+					errChan <- stacktrace.Propagate(err, "")
+				}
+			case <-adaptiveTicker.C:
+				if s.segchaAdaptiveDifficultyEnabled() {
+					err := s.updateSegchaAdaptedFamilyWeights(ctx)
+					if err != nil {
+						errChan <- stacktrace.Propagate(err, "")
+					}
+				}
+			case <-persistTicker.C:
+				s.persistSegchaPool()
 			case <-t.C:
-				inCache := len(s.captchaChallengesQueue)
-				if inCache < segchaPremadeQueueSize {
-					func() {
-						s.captchaGenerationMutex.Lock()
-						defer s.captchaGenerationMutex.Unlock()
-						c := makeChallenge()
-						s.captchaChallengesQueue <- c
-						latestGeneratedChallenge = c
-						inCache++
-						s.log.Printf("generated cached segcha challenge (%d in cache)", inCache)
-					}()
+				err := s.refillSegchaPool(ctx)
+				if err != nil {
+					errChan <- stacktrace.Propagate(err, "")
 				}
-				go s.statsClient.Gauge("segcha_cached", inCache)
+				go s.statsClient.Gauge("segcha_cached", s.segchaPool.Len())
 			case <-ctx.Done():
+				s.persistSegchaPool()
+				err := s.flushSegchaStatistics(ctx)
+				if err != nil {
+					s.log.Printf("error flushing segcha statistics: %v", err)
+				}
 				s.log.Println("segcha challenge creator worker done")
 				return
 			}
@@ -177,6 +181,7 @@ func (s *grpcServer) Worker(ctx context.Context, errorCb func(error)) {
 	go s.mediaQueue.ProcessQueueWorker(ctx)
 	go s.staffActivityManager.StatsWorker(ctx)
 	go s.ipReputationChecker.Worker(ctx)
+	go s.chat.PollClosingWorker(ctx)
 
 	go func() {
 		for {
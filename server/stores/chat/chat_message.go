@@ -10,6 +10,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// MainChannelID is the ID of the chat channel that always exists, to which messages belong by default
+const MainChannelID = "main"
+
 // Message represents a single chat message
 type Message struct {
 	ID              snowflake.ID
@@ -17,15 +20,27 @@ type Message struct {
 	Author          auth.User
 	Content         string
 	Reference       *Message // may be nil
+	Channel         string   // ID of the chat channel the message belongs to
 	Shadowbanned    bool
 	Attachments     []MessageAttachmentStorage
 	AttachmentsView []MessageAttachmentView
+	Reactions       []MessageReaction
+	EditedAt        *time.Time // nil if the message was never edited
+	// Revisions contains the previous versions of the message content, oldest first. Only loaded for moderation purposes
+	Revisions []MessageRevision
+}
+
+// MessageRevision represents a previous version of the content of a chat message
+type MessageRevision struct {
+	Content      string
+	SupersededAt time.Time
 }
 
 func (m *Message) SerializeForAPI(ctx context.Context, userSerializer auth.APIUserSerializer) *proto.ChatMessage {
 	msg := &proto.ChatMessage{
 		Id:        m.ID.Int64(),
 		CreatedAt: timestamppb.New(m.CreatedAt),
+		ChannelId: m.Channel,
 	}
 	if m.Author != nil {
 		msg.Message = &proto.ChatMessage_UserMessage{
@@ -47,5 +62,17 @@ func (m *Message) SerializeForAPI(ctx context.Context, userSerializer auth.APIUs
 	for _, a := range m.AttachmentsView {
 		msg.Attachments = append(msg.Attachments, a.SerializeForAPI(ctx))
 	}
+	if m.EditedAt != nil {
+		msg.EditedAt = timestamppb.New(*m.EditedAt)
+	}
+	for _, r := range m.Revisions {
+		msg.Revisions = append(msg.Revisions, &proto.ChatMessageRevision{
+			Content:      r.Content,
+			SupersededAt: timestamppb.New(r.SupersededAt),
+		})
+	}
+	for _, r := range m.Reactions {
+		msg.Reactions = append(msg.Reactions, r.SerializeForAPI())
+	}
 	return msg
 }
@@ -0,0 +1,136 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/jmoiron/sqlx"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/proto"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// MessageReaction represents the aggregate of the reactions of a given kind to a chat message
+type MessageReaction struct {
+	Reaction string
+	Count    int
+	// ReactedBySelf is true when the user for whom the reactions were loaded is among those who reacted
+	ReactedBySelf bool
+}
+
+// SerializeForAPI serializes the reaction for the API
+func (r MessageReaction) SerializeForAPI() *proto.ChatMessageReaction {
+	return &proto.ChatMessageReaction{
+		Reaction:      r.Reaction,
+		Count:         uint32(r.Count),
+		ReactedBySelf: r.ReactedBySelf,
+	}
+}
+
+// AddReaction adds a reaction by the given user to the message with the given ID.
+// Returns false if the user had already reacted to the message with the same reaction
+func (s *ChatStoreDatabase) AddReaction(ctxCtx context.Context, messageID snowflake.ID, reactor auth.User, reaction string) (bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	result, err := ctx.ExecContext(ctx, `
+		INSERT INTO chat_message_reaction (message_id, reactor, reaction, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`,
+		messageID.Int64(), reactor.Address(), reaction, time.Now())
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return affected > 0, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// RemoveReaction removes a reaction by the given user from the message with the given ID.
+// Returns false if the user had not reacted to the message with the given reaction
+func (s *ChatStoreDatabase) RemoveReaction(ctxCtx context.Context, messageID snowflake.ID, reactor auth.User, reaction string) (bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	result, err := ctx.ExecContext(ctx, `
+		DELETE FROM chat_message_reaction
+		WHERE message_id = $1 AND reactor = $2 AND reaction = $3`,
+		messageID.Int64(), reactor.Address(), reaction)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	return affected > 0, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// LoadReactions loads the reactions to the messages with the given IDs, ordered by when each reaction was first used.
+// viewer may be nil, in which case ReactedBySelf will always be false
+func (s *ChatStoreDatabase) LoadReactions(ctxCtx context.Context, messageIDs []snowflake.ID, viewer auth.User) (map[snowflake.ID][]MessageReaction, error) {
+	result := make(map[snowflake.ID][]MessageReaction)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	viewerAddress := "<matches nobody>"
+	if viewer != nil && !viewer.IsUnknown() {
+		viewerAddress = viewer.Address()
+	}
+
+	ids := make([]int64, len(messageIDs))
+	for i := range messageIDs {
+		ids[i] = messageIDs[i].Int64()
+	}
+
+	var rows []struct {
+		MessageID     snowflake.ID `db:"message_id"`
+		Reaction      string       `db:"reaction"`
+		Count         int          `db:"count"`
+		ReactedBySelf bool         `db:"reacted_by_self"`
+	}
+	query, args, err := sqlx.In(`
+		SELECT
+			message_id,
+			reaction,
+			COUNT(*) AS count,
+			BOOL_OR(reactor = ?) AS reacted_by_self
+		FROM chat_message_reaction
+		WHERE message_id IN (?)
+		GROUP BY message_id, reaction
+		ORDER BY MIN(created_at) ASC`, viewerAddress, ids)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	query = ctx.Rebind(query)
+	err = ctx.Tx().SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	for _, row := range rows {
+		result[row.MessageID] = append(result[row.MessageID], MessageReaction{
+			Reaction:      row.Reaction,
+			Count:         row.Count,
+			ReactedBySelf: row.ReactedBySelf,
+		})
+	}
+	return result, nil
+}
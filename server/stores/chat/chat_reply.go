@@ -0,0 +1,123 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// LoadReplies loads the messages that reply to the message with the given ID, oldest first.
+// Shadowbanned replies are only included if they were authored by includeShadowbanned
+func (s *ChatStoreDatabase) LoadReplies(ctxCtx context.Context, includeShadowbanned auth.User, messageID snowflake.ID, num int) ([]*Message, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var messages []dbChatMsgWithReference
+
+	author := "<matches nobody>"
+	if includeShadowbanned != nil {
+		author = includeShadowbanned.Address()
+	}
+
+	err = ctx.Tx().SelectContext(ctx, &messages, `
+		SELECT
+			a.id AS id,
+			a.created_at AS created_at,
+			a.author AS author,
+			a.content AS content,
+			a.reference AS reference,
+			a.shadowbanned AS shadowbanned,
+			a.attachments AS attachments,
+			a.edited_at AS edited_at,
+			a.channel AS channel,
+			b.id AS reference_id,
+			b.created_at AS reference_created_at,
+			b.author AS reference_author,
+			b.content AS reference_content,
+			u.address AS address,
+			u.permission_level AS permission_level,
+			u.application_id AS application_id,
+			u.nickname AS nickname,
+			v.nickname AS reference_nickname
+		FROM
+			chat_message a
+			LEFT JOIN chat_message b ON a.reference = b.id
+			LEFT JOIN chat_user u ON a.author = u.address
+			LEFT JOIN chat_user v ON b.author = v.address
+		WHERE
+			a.reference = $1 AND
+			(a.shadowbanned = false OR a.author = $2)
+		ORDER BY a.created_at ASC
+		LIMIT $3
+	`, messageID.Int64(), author, num)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	chatMessages := make([]*Message, len(messages))
+	for i, message := range messages {
+		chatMessages[i] = s.dbMsgWithReferenceToChatMessage(ctx, message)
+	}
+
+	return chatMessages, nil
+}
+
+// CountUnreadReplies counts the visible replies by other users to messages authored by the given user, that were
+// sent after the user last marked their replies as read, and never earlier than notBefore
+func (s *ChatStoreDatabase) CountUnreadReplies(ctxCtx context.Context, user auth.User, notBefore time.Time) (int, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var count int
+	err = ctx.Tx().GetContext(ctx, &count, `
+		SELECT COUNT(*)
+		FROM
+			chat_message a
+			INNER JOIN chat_message b ON a.reference = b.id
+		WHERE
+			b.author = $1 AND
+			a.author <> $1 AND
+			a.shadowbanned = false AND
+			a.created_at > GREATEST($2, COALESCE((SELECT replies_read_at FROM chat_user WHERE "address" = $1), $2))
+	`, user.Address(), notBefore)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
+// MarkRepliesAsRead records that the given user has seen all replies sent until the specified moment
+func (s *ChatStoreDatabase) MarkRepliesAsRead(ctxCtx context.Context, user auth.User, until time.Time) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	var applicationID *string
+	if id := user.ApplicationID(); id != "" {
+		applicationID = &id
+	}
+
+	_, err = ctx.ExecContext(ctx, `
+		INSERT INTO chat_user ("address", permission_level, nickname, application_id, replies_read_at)
+		VALUES ($1, $2, NULL, $3, $4)
+		ON CONFLICT ("address") DO UPDATE SET replies_read_at = GREATEST(chat_user.replies_read_at, $4)`,
+		user.Address(), user.PermissionLevel(), applicationID, until,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
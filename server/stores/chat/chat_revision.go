@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/jmoiron/sqlx"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// EditMessage replaces the content of the message with the given ID, keeping the previous content as a revision.
+// The message is shadowbanned if shadowbanned is true, but a shadowbanned message is never un-shadowbanned
+func (s *ChatStoreDatabase) EditMessage(ctxCtx context.Context, id snowflake.ID, content string, shadowbanned bool) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	now := time.Now()
+	result, err := ctx.ExecContext(ctx, `
+		INSERT INTO chat_message_revision (message_id, superseded_at, content)
+		SELECT id, $2, content FROM chat_message WHERE id = $1`,
+		id.Int64(), now)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if affected == 0 {
+		return stacktrace.Propagate(ErrChatMessageNotFound, "")
+	}
+
+	_, err = ctx.ExecContext(ctx, `
+		UPDATE chat_message
+		SET content = $2, edited_at = $3, shadowbanned = shadowbanned OR $4
+		WHERE id = $1`,
+		id.Int64(), content, now, shadowbanned)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// LoadMessageRevisions loads the previous versions of the content of the messages with the given IDs, oldest first
+func (s *ChatStoreDatabase) LoadMessageRevisions(ctxCtx context.Context, messageIDs []snowflake.ID) (map[snowflake.ID][]MessageRevision, error) {
+	result := make(map[snowflake.ID][]MessageRevision)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	ids := make([]int64, len(messageIDs))
+	for i := range messageIDs {
+		ids[i] = messageIDs[i].Int64()
+	}
+
+	var rows []struct {
+		MessageID    snowflake.ID `db:"message_id"`
+		SupersededAt time.Time    `db:"superseded_at"`
+		Content      string       `db:"content"`
+	}
+	query, args, err := sqlx.In(`
+		SELECT message_id, superseded_at, content
+		FROM chat_message_revision
+		WHERE message_id IN (?)
+		ORDER BY superseded_at ASC`, ids)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	query = ctx.Rebind(query)
+	err = ctx.Tx().SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	for _, row := range rows {
+		result[row.MessageID] = append(result[row.MessageID], MessageRevision{
+			Content:      row.Content,
+			SupersededAt: row.SupersededAt,
+		})
+	}
+	return result, nil
+}
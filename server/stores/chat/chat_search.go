@@ -0,0 +1,114 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/types"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// SearchParams contains the criteria for a chat message search. Empty/nil fields do not restrict the results
+type SearchParams struct {
+	// Query is a full-text search query in the syntax accepted by PostgreSQL's websearch_to_tsquery
+	Query          string
+	Author         string
+	Since          *time.Time
+	Until          *time.Time
+	AttachmentType string
+	Shadowbanned   *bool
+	Channel        string
+}
+
+var sqlBuilder = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+// SearchMessages returns the messages matching the search parameters, newest first, along with the total number of
+// matching messages
+func (s *ChatStoreDatabase) SearchMessages(ctxCtx context.Context, params SearchParams, pagParams *types.PaginationParams) ([]*Message, uint64, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	conditions := sq.And{}
+	if params.Query != "" {
+		// must match the expression of the full-text index on chat_message
+		conditions = append(conditions, sq.Expr("to_tsvector('simple', a.content) @@ websearch_to_tsquery('simple', ?)", params.Query))
+	}
+	if params.Author != "" {
+		conditions = append(conditions, sq.Eq{"a.author": params.Author})
+	}
+	if params.Since != nil {
+		conditions = append(conditions, sq.GtOrEq{"a.created_at": *params.Since})
+	}
+	if params.Until != nil {
+		conditions = append(conditions, sq.Lt{"a.created_at": *params.Until})
+	}
+	if params.AttachmentType != "" {
+		conditions = append(conditions, sq.Expr("EXISTS (SELECT 1 FROM UNNEST(a.attachments) AS att WHERE att LIKE ? || ':%')", params.AttachmentType))
+	}
+	if params.Shadowbanned != nil {
+		conditions = append(conditions, sq.Eq{"a.shadowbanned": *params.Shadowbanned})
+	}
+	if params.Channel != "" {
+		conditions = append(conditions, sq.Eq{"a.channel": params.Channel})
+	}
+
+	countQuery, countArgs, err := sqlBuilder.Select("COUNT(*)").From("chat_message a").Where(conditions).ToSql()
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+	var total uint64
+	err = ctx.Tx().GetContext(ctx, &total, countQuery, countArgs...)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+
+	q := sqlBuilder.Select(
+		"a.id AS id",
+		"a.created_at AS created_at",
+		"a.author AS author",
+		"a.content AS content",
+		"a.reference AS reference",
+		"a.shadowbanned AS shadowbanned",
+		"a.attachments AS attachments",
+		"a.edited_at AS edited_at",
+		"a.channel AS channel",
+		"b.id AS reference_id",
+		"b.created_at AS reference_created_at",
+		"b.author AS reference_author",
+		"b.content AS reference_content",
+		"u.address AS address",
+		"u.permission_level AS permission_level",
+		"u.application_id AS application_id",
+		"u.nickname AS nickname",
+		"v.nickname AS reference_nickname").
+		From("chat_message a").
+		LeftJoin("chat_message b ON a.reference = b.id").
+		LeftJoin("chat_user u ON a.author = u.address").
+		LeftJoin("chat_user v ON b.author = v.address").
+		Where(conditions).
+		OrderBy("a.created_at DESC")
+	if pagParams != nil {
+		q = q.Offset(pagParams.Offset).Limit(pagParams.Limit)
+	}
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+
+	var messages []dbChatMsgWithReference
+	err = ctx.Tx().SelectContext(ctx, &messages, query, args...)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "")
+	}
+
+	chatMessages := make([]*Message, len(messages))
+	for i, message := range messages {
+		chatMessages[i] = s.dbMsgWithReferenceToChatMessage(ctx, message)
+	}
+	return chatMessages, total, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/palantir/stacktrace"
 	"github.com/tnyim/jungletv/server/auth"
 	"github.com/tnyim/jungletv/server/usercache"
+	"github.com/tnyim/jungletv/types"
 	"github.com/tnyim/jungletv/utils/transaction"
 )
 
@@ -25,14 +26,30 @@ var ErrChatMessageNotFound = errors.New("chat message not found")
 type Store interface {
 	StoreMessage(context.Context, *Message) (*string, error)
 	DeleteMessage(context.Context, snowflake.ID) (*Message, error)
-	LoadMessagesBetween(context.Context, auth.User, time.Time, time.Time) ([]*Message, error)
-	LoadNumLatestMessages(context.Context, auth.User, int) ([]*Message, error)
+	LoadMessagesBetween(ctx context.Context, channel string, includeShadowbanned auth.User, since, until time.Time) ([]*Message, error)
+	LoadNumLatestMessages(ctx context.Context, channel string, includeShadowbanned auth.User, num int) ([]*Message, error)
 	LoadNumLatestMessagesFromUser(context.Context, auth.User, int) ([]*Message, error)
 	LoadMessage(context.Context, snowflake.ID) (*Message, error)
 	SetUserNickname(context.Context, auth.User, *string) error
 	GetUserNickname(context.Context, auth.User) *string
 	SetAttachmentLoaderForType(attachmentType string, attachmentLoader AttachmentLoader)
 	LoadAttachment(ctx context.Context, attachmentString string) (MessageAttachmentView, error)
+	EditMessage(ctx context.Context, id snowflake.ID, content string, shadowbanned bool) error
+	ShadowbanMessage(ctx context.Context, id snowflake.ID) error
+	UnshadowbanMessage(ctx context.Context, id snowflake.ID) error
+	LoadMessageRevisions(ctx context.Context, messageIDs []snowflake.ID) (map[snowflake.ID][]MessageRevision, error)
+	LoadReplies(ctx context.Context, includeShadowbanned auth.User, messageID snowflake.ID, num int) ([]*Message, error)
+	CountUnreadReplies(ctx context.Context, user auth.User, notBefore time.Time) (int, error)
+	MarkRepliesAsRead(ctx context.Context, user auth.User, until time.Time) error
+	SearchMessages(ctx context.Context, params SearchParams, pagParams *types.PaginationParams) ([]*Message, uint64, error)
+	StoreDirectMessage(ctx context.Context, m *Message, recipient string) (*string, error)
+	LoadDirectMessages(ctx context.Context, participantA, participantB string, viewer auth.User, before time.Time, num int) ([]*Message, error)
+	LoadDirectMessageConversations(ctx context.Context, user auth.User, num int) ([]DirectMessageConversation, error)
+	SetDirectMessagesEnabled(ctx context.Context, user auth.User, enabled bool) error
+	DirectMessagesEnabled(ctx context.Context, address string) (bool, error)
+	AddReaction(ctx context.Context, messageID snowflake.ID, reactor auth.User, reaction string) (bool, error)
+	RemoveReaction(ctx context.Context, messageID snowflake.ID, reactor auth.User, reaction string) (bool, error)
+	LoadReactions(ctx context.Context, messageIDs []snowflake.ID, viewer auth.User) (map[snowflake.ID][]MessageReaction, error)
 }
 
 // AttachmentLoader transforms a DB-serialized attachment storage model into the respective view model
@@ -69,6 +86,7 @@ type dbChatMsg struct {
 	Reference    *snowflake.ID  `db:"reference"`
 	Shadowbanned bool           `db:"shadowbanned"`
 	Attachments  pq.StringArray `db:"attachments"`
+	Channel      string         `db:"channel"`
 }
 
 type dbChatMsgWithReference struct {
@@ -79,6 +97,8 @@ type dbChatMsgWithReference struct {
 	Reference          *snowflake.ID  `db:"reference"`
 	Shadowbanned       bool           `db:"shadowbanned"`
 	Attachments        pq.StringArray `db:"attachments"`
+	EditedAt           *time.Time     `db:"edited_at"`
+	Channel            string         `db:"channel"`
 	ReferenceID        *snowflake.ID  `db:"reference_id"`
 	ReferenceCreatedAt *time.Time     `db:"reference_created_at"`
 	ReferenceAuthor    *string        `db:"reference_author"`
@@ -103,6 +123,10 @@ func (s *ChatStoreDatabase) StoreMessage(ctxCtx context.Context, m *Message) (*s
 		Content:      m.Content,
 		Shadowbanned: m.Shadowbanned,
 		Attachments:  pq.StringArray{},
+		Channel:      m.Channel,
+	}
+	if message.Channel == "" {
+		message.Channel = MainChannelID
 	}
 
 	for _, attachment := range m.Attachments {
@@ -144,8 +168,8 @@ func (s *ChatStoreDatabase) StoreMessage(ctxCtx context.Context, m *Message) (*s
 		message.Reference = &m.Reference.ID
 	}
 	_, err = ctx.Tx().NamedExecContext(ctx, `
-		INSERT INTO chat_message (id, created_at, author, content, reference, shadowbanned, attachments)
-		VALUES (:id, :created_at, :author, :content, :reference, :shadowbanned, :attachments)`,
+		INSERT INTO chat_message (id, created_at, author, content, reference, shadowbanned, attachments, channel)
+		VALUES (:id, :created_at, :author, :content, :reference, :shadowbanned, :attachments, :channel)`,
 		message,
 	)
 	if err != nil {
@@ -170,7 +194,7 @@ func (s *ChatStoreDatabase) DeleteMessage(ctxCtx context.Context, id snowflake.I
 
 	err = ctx.Tx().GetContext(ctx, &deletedMsg, `
 		DELETE FROM chat_message WHERE id = $1
-			RETURNING id, created_at, author, content, reference, shadowbanned, attachments
+			RETURNING id, created_at, author, content, reference, shadowbanned, attachments, channel
 	`, id.Int64())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -196,10 +220,57 @@ func (s *ChatStoreDatabase) DeleteMessage(ctxCtx context.Context, id snowflake.I
 		Content:         deletedMsg.Content,
 		Shadowbanned:    deletedMsg.Shadowbanned,
 		AttachmentsView: attachments,
+		Channel:         deletedMsg.Channel,
 	}, stacktrace.Propagate(ctx.Commit(), "")
 }
 
-func (s *ChatStoreDatabase) LoadMessagesBetween(ctxCtx context.Context, includeShadowbanned auth.User, since, until time.Time) ([]*Message, error) {
+// ShadowbanMessage makes an existing message only visible to its author
+func (s *ChatStoreDatabase) ShadowbanMessage(ctxCtx context.Context, id snowflake.ID) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	result, err := ctx.ExecContext(ctx, `UPDATE chat_message SET shadowbanned = TRUE WHERE id = $1`, id.Int64())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if affected == 0 {
+		return stacktrace.Propagate(ErrChatMessageNotFound, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// UnshadowbanMessage makes an existing message visible to everyone again
+func (s *ChatStoreDatabase) UnshadowbanMessage(ctxCtx context.Context, id snowflake.ID) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	result, err := ctx.ExecContext(ctx, `UPDATE chat_message SET shadowbanned = FALSE WHERE id = $1`, id.Int64())
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	if affected == 0 {
+		return stacktrace.Propagate(ErrChatMessageNotFound, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+func (s *ChatStoreDatabase) LoadMessagesBetween(ctxCtx context.Context, channel string, includeShadowbanned auth.User, since, until time.Time) ([]*Message, error) {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -222,6 +293,8 @@ func (s *ChatStoreDatabase) LoadMessagesBetween(ctxCtx context.Context, includeS
 		a.reference AS reference,
 		a.shadowbanned AS shadowbanned,
 		a.attachments AS attachments,
+		a.edited_at AS edited_at,
+		a.channel AS channel,
 		b.id AS reference_id,
 		b.created_at AS reference_created_at,
 		b.author AS reference_author,
@@ -237,11 +310,12 @@ func (s *ChatStoreDatabase) LoadMessagesBetween(ctxCtx context.Context, includeS
 			LEFT JOIN chat_user u ON a.author = u.address
 			LEFT JOIN chat_user v ON b.author = v.address
 		WHERE
-			a.created_at > $1 AND
-			a.created_at < $2 AND
-			(a.shadowbanned = false OR a.author = $3)
+			a.channel = $1 AND
+			a.created_at > $2 AND
+			a.created_at < $3 AND
+			(a.shadowbanned = false OR a.author = $4)
 		ORDER BY a.created_at ASC
-	`, since, until, author)
+	`, channel, since, until, author)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrChatMessageNotFound
@@ -257,7 +331,7 @@ func (s *ChatStoreDatabase) LoadMessagesBetween(ctxCtx context.Context, includeS
 	return chatMessages, nil
 }
 
-func (s *ChatStoreDatabase) LoadNumLatestMessages(ctxCtx context.Context, includeShadowbanned auth.User, num int) ([]*Message, error) {
+func (s *ChatStoreDatabase) LoadNumLatestMessages(ctxCtx context.Context, channel string, includeShadowbanned auth.User, num int) ([]*Message, error) {
 	ctx, err := transaction.Begin(ctxCtx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "")
@@ -280,6 +354,8 @@ func (s *ChatStoreDatabase) LoadNumLatestMessages(ctxCtx context.Context, includ
 			a.reference AS reference,
 			a.shadowbanned AS shadowbanned,
 			a.attachments AS attachments,
+			a.edited_at AS edited_at,
+			a.channel AS channel,
 			b.id AS reference_id,
 			b.created_at AS reference_created_at,
 			b.author AS reference_author,
@@ -294,10 +370,10 @@ func (s *ChatStoreDatabase) LoadNumLatestMessages(ctxCtx context.Context, includ
 			LEFT JOIN chat_message b ON a.reference = b.id
 			LEFT JOIN chat_user u ON a.author = u.address
 			LEFT JOIN chat_user v ON b.author = v.address
-		WHERE a.shadowbanned = false OR a.author = $1
+		WHERE a.channel = $1 AND (a.shadowbanned = false OR a.author = $2)
 		ORDER BY a.created_at DESC
-		LIMIT $2
-	`, author, num)
+		LIMIT $3
+	`, channel, author, num)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrChatMessageNotFound
@@ -335,6 +411,8 @@ func (s *ChatStoreDatabase) LoadNumLatestMessagesFromUser(ctxCtx context.Context
 			a.reference AS reference,
 			a.shadowbanned AS shadowbanned,
 			a.attachments AS attachments,
+			a.edited_at AS edited_at,
+			a.channel AS channel,
 			b.id AS reference_id,
 			b.created_at AS reference_created_at,
 			b.author AS reference_author,
@@ -388,6 +466,8 @@ func (s *ChatStoreDatabase) LoadMessage(ctxCtx context.Context, id snowflake.ID)
 			a.reference AS reference,
 			a.shadowbanned AS shadowbanned,
 			a.attachments AS attachments,
+			a.edited_at AS edited_at,
+			a.channel AS channel,
 			b.id AS reference_id,
 			b.created_at AS reference_created_at,
 			b.author AS reference_author,
@@ -414,9 +494,12 @@ func (s *ChatStoreDatabase) LoadMessage(ctxCtx context.Context, id snowflake.ID)
 
 func (s *ChatStoreDatabase) dbMsgWithReferenceToChatMessage(ctx context.Context, message dbChatMsgWithReference) *Message {
 	chatMessage := &Message{
-		ID:        message.ID,
-		CreatedAt: message.CreatedAt,
-		Content:   message.Content,
+		ID:           message.ID,
+		CreatedAt:    message.CreatedAt,
+		Content:      message.Content,
+		Shadowbanned: message.Shadowbanned,
+		EditedAt:     message.EditedAt,
+		Channel:      message.Channel,
 	}
 	if message.Author != nil {
 		chatMessage.Author = auth.BuildNonAuthorizedUser(*message.Author, auth.PermissionLevel(*message.PermissionLevel), message.Nickname, message.ApplicationID)
@@ -0,0 +1,244 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/auth"
+	"github.com/tnyim/jungletv/utils/transaction"
+)
+
+// DirectMessageConversation represents a direct message conversation from the perspective of one of its participants
+type DirectMessageConversation struct {
+	OtherParty    auth.User
+	LatestMessage *Message
+}
+
+type dbDirectMsg struct {
+	ID              snowflake.ID `db:"id"`
+	CreatedAt       time.Time    `db:"created_at"`
+	Author          string       `db:"author"`
+	Recipient       string       `db:"recipient"`
+	Content         string       `db:"content"`
+	Shadowbanned    bool         `db:"shadowbanned"`
+	PermissionLevel *string      `db:"permission_level"`
+	Nickname        *string      `db:"nickname"`
+	ApplicationID   *string      `db:"application_id"`
+}
+
+func (m dbDirectMsg) toChatMessage() *Message {
+	permissionLevel := auth.UserPermissionLevel
+	if m.PermissionLevel != nil {
+		permissionLevel = auth.PermissionLevel(*m.PermissionLevel)
+	}
+	return &Message{
+		ID:           m.ID,
+		CreatedAt:    m.CreatedAt,
+		Author:       auth.BuildNonAuthorizedUser(m.Author, permissionLevel, m.Nickname, m.ApplicationID),
+		Content:      m.Content,
+		Shadowbanned: m.Shadowbanned,
+	}
+}
+
+// StoreDirectMessage stores a direct message sent to the given recipient. Returns the nickname of the author
+func (s *ChatStoreDatabase) StoreDirectMessage(ctxCtx context.Context, m *Message, recipient string) (*string, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	var returning struct {
+		Nickname      *string `db:"nickname"`
+		ApplicationID *string `db:"application_id"`
+	}
+
+	var applicationID *string
+	if id := m.Author.ApplicationID(); id != "" {
+		applicationID = &id
+	}
+
+	err = ctx.Tx().GetContext(ctx, &returning, `
+		INSERT INTO chat_user ("address", permission_level, nickname, application_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT ("address") DO UPDATE SET permission_level = $2
+		RETURNING nickname, application_id`,
+		m.Author.Address(), m.Author.PermissionLevel(), nil, applicationID,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	_, err = ctx.ExecContext(ctx, `
+		INSERT INTO direct_message (id, created_at, author, recipient, content, shadowbanned)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		m.ID.Int64(), m.CreatedAt, m.Author.Address(), recipient, m.Content, m.Shadowbanned,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	return returning.Nickname, stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// LoadDirectMessages loads up to num messages exchanged between the two participants before the given instant,
+// oldest first.
+// Shadowbanned messages are only included if authored by viewer. If viewer is nil, all shadowbanned messages are
+// included (this is meant for moderation purposes)
+func (s *ChatStoreDatabase) LoadDirectMessages(ctxCtx context.Context, participantA, participantB string, viewer auth.User, before time.Time, num int) ([]*Message, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	includeAllShadowbanned := viewer == nil
+	author := "<matches nobody>"
+	if viewer != nil {
+		author = viewer.Address()
+	}
+
+	var messages []dbDirectMsg
+	err = ctx.Tx().SelectContext(ctx, &messages, `
+		SELECT
+			m.id AS id,
+			m.created_at AS created_at,
+			m.author AS author,
+			m.recipient AS recipient,
+			m.content AS content,
+			m.shadowbanned AS shadowbanned,
+			u.permission_level AS permission_level,
+			u.nickname AS nickname,
+			u.application_id AS application_id
+		FROM
+			direct_message m
+			LEFT JOIN chat_user u ON m.author = u.address
+		WHERE
+			LEAST(m.author, m.recipient) = LEAST($1, $2) AND
+			GREATEST(m.author, m.recipient) = GREATEST($1, $2) AND
+			m.created_at < $3 AND
+			(m.shadowbanned = false OR m.author = $4 OR $5)
+		ORDER BY m.created_at DESC
+		LIMIT $6
+	`, participantA, participantB, before, author, includeAllShadowbanned, num)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	chatMessages := make([]*Message, len(messages))
+	for i, message := range messages {
+		chatMessages[len(messages)-1-i] = message.toChatMessage()
+	}
+	return chatMessages, nil
+}
+
+// LoadDirectMessageConversations loads the direct message conversations the user participates in, along with the
+// latest message of each, most recently active first
+func (s *ChatStoreDatabase) LoadDirectMessageConversations(ctxCtx context.Context, user auth.User, num int) ([]DirectMessageConversation, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var rows []struct {
+		dbDirectMsg
+		OtherParty                string  `db:"other_party"`
+		OtherPartyPermissionLevel *string `db:"other_party_permission_level"`
+		OtherPartyNickname        *string `db:"other_party_nickname"`
+		OtherPartyApplicationID   *string `db:"other_party_application_id"`
+	}
+	err = ctx.Tx().SelectContext(ctx, &rows, `
+		SELECT
+			c.*,
+			u.permission_level AS permission_level,
+			u.nickname AS nickname,
+			u.application_id AS application_id,
+			o.permission_level AS other_party_permission_level,
+			o.nickname AS other_party_nickname,
+			o.application_id AS other_party_application_id
+		FROM (
+			SELECT DISTINCT ON (other_party)
+				m.id AS id,
+				m.created_at AS created_at,
+				m.author AS author,
+				m.recipient AS recipient,
+				m.content AS content,
+				m.shadowbanned AS shadowbanned,
+				CASE WHEN m.author = $1 THEN m.recipient ELSE m.author END AS other_party
+			FROM direct_message m
+			WHERE
+				(m.author = $1 OR m.recipient = $1) AND
+				(m.shadowbanned = false OR m.author = $1)
+			ORDER BY other_party, m.created_at DESC
+		) c
+			LEFT JOIN chat_user u ON c.author = u.address
+			LEFT JOIN chat_user o ON c.other_party = o.address
+		ORDER BY c.created_at DESC
+		LIMIT $2
+	`, user.Address(), num)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+
+	conversations := make([]DirectMessageConversation, len(rows))
+	for i, row := range rows {
+		permissionLevel := auth.UserPermissionLevel
+		if row.OtherPartyPermissionLevel != nil {
+			permissionLevel = auth.PermissionLevel(*row.OtherPartyPermissionLevel)
+		}
+		conversations[i] = DirectMessageConversation{
+			OtherParty:    auth.BuildNonAuthorizedUser(row.OtherParty, permissionLevel, row.OtherPartyNickname, row.OtherPartyApplicationID),
+			LatestMessage: row.dbDirectMsg.toChatMessage(),
+		}
+	}
+	return conversations, nil
+}
+
+// SetDirectMessagesEnabled sets whether the user accepts direct messages
+func (s *ChatStoreDatabase) SetDirectMessagesEnabled(ctxCtx context.Context, user auth.User, enabled bool) error {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer ctx.Rollback()
+
+	var applicationID *string
+	if id := user.ApplicationID(); id != "" {
+		applicationID = &id
+	}
+
+	_, err = ctx.ExecContext(ctx, `
+		INSERT INTO chat_user ("address", permission_level, nickname, application_id, direct_messages_enabled)
+		VALUES ($1, $2, NULL, $3, $4)
+		ON CONFLICT ("address") DO UPDATE SET direct_messages_enabled = $4`,
+		user.Address(), user.PermissionLevel(), applicationID, enabled,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(ctx.Commit(), "")
+}
+
+// DirectMessagesEnabled returns whether the user with the given address accepts direct messages
+func (s *ChatStoreDatabase) DirectMessagesEnabled(ctxCtx context.Context, address string) (bool, error) {
+	ctx, err := transaction.Begin(ctxCtx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer ctx.Commit() // read-only tx
+
+	var enabled bool
+	err = ctx.Tx().GetContext(ctx, &enabled, `SELECT direct_messages_enabled FROM chat_user WHERE "address" = $1`, address)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "")
+	}
+	return enabled, nil
+}
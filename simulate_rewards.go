@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/palantir/stacktrace"
+	"github.com/tnyim/jungletv/server/components/payment"
+	"github.com/tnyim/jungletv/server/components/pricer"
+	"github.com/tnyim/jungletv/server/components/rewards"
+	"github.com/tnyim/jungletv/server/components/rewardsimulator"
+)
+
+// simulateRewardsCommand is the name of the subcommand that replays historical reward distributions
+const simulateRewardsCommand = "simulate-rewards"
+
+func parseSimulationTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.ParseInLocation("2006-01-02", value, time.Local)
+	return t, stacktrace.Propagate(err, "invalid time %s, expected YYYY-MM-DD or RFC 3339", value)
+}
+
+func formatBanano(amount payment.Amount) string {
+	return new(big.Rat).SetFrac(amount.Int, pricer.BananoUnit).FloatString(2)
+}
+
+func formatBananoDifference(amount payment.Amount) string {
+	if amount.Sign() > 0 {
+		return "+" + formatBanano(amount)
+	}
+	return formatBanano(amount)
+}
+
+func runRewardSimulation(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet(simulateRewardsCommand, flag.ExitOnError)
+	sinceFlag := flags.String("since", time.Now().AddDate(0, 0, -7).Format("2006-01-02"), "start of the time range to replay (YYYY-MM-DD or RFC 3339)")
+	untilFlag := flags.String("until", time.Now().Format(time.RFC3339), "end of the time range to replay (YYYY-MM-DD or RFC 3339)")
+	policyFlag := flags.String("policy", rewards.DefaultRewardPolicyName, "reward policy to simulate")
+	pricesMultiplierFlag := flags.Int("prices-multiplier", 100, "final prices multiplier to simulate")
+	minimumPricesMultiplierFlag := flags.Int("minimum-prices-multiplier", 25, "minimum prices multiplier to simulate")
+	usersFlag := flags.Int("users", 50, "maximum number of users to list, ordered by decreasing difference in rewards (0 lists all)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s %s [options]\n\n", os.Args[0], simulateRewardsCommand)
+		fmt.Fprintln(flags.Output(), "Replays the history of played media, received rewards and crowdfunded transactions under different")
+		fmt.Fprintln(flags.Output(), "pricing and reward rules, without moving any funds. Available reward policies:")
+		for _, policy := range rewards.RewardPolicies() {
+			fmt.Fprintf(flags.Output(), "  %s: %s\n", policy.Name(), policy.Description())
+		}
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	err := flags.Parse(args)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	options := rewardsimulator.Options{
+		FinalPricesMultiplier:   *pricesMultiplierFlag,
+		MinimumPricesMultiplier: *minimumPricesMultiplierFlag,
+	}
+	options.Since, err = parseSimulationTime(*sinceFlag)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	options.Until, err = parseSimulationTime(*untilFlag)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	var ok bool
+	options.Policy, ok = rewards.RewardPolicyWithName(*policyFlag)
+	if !ok {
+		return stacktrace.NewError("unknown reward policy %s", *policyFlag)
+	}
+
+	mainLog.Printf("Replaying rewards between %s and %s using the %s reward policy", options.Since.Format(time.RFC3339), options.Until.Format(time.RFC3339), options.Policy.Name())
+	result, err := rewardsimulator.Run(ctx, options)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "Replayed queue entries:\t%d\t\t\t\n", result.MediaCount)
+	fmt.Fprintln(w, "\tActual\tSimulated\tDifference\t")
+	aggregate := func(label string, actual, simulated payment.Amount) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", label, formatBanano(actual), formatBanano(simulated),
+			formatBananoDifference(payment.NewAmount(new(big.Int).Sub(simulated.Int, actual.Int))))
+	}
+	aggregate("Request costs (BAN)", result.ActualRequestCosts, result.SimulatedRequestCosts)
+	aggregate("Spectator rewards (BAN)", result.ActualRewards, result.SimulatedRewards)
+	aggregate("Requester rewards (BAN)", result.ActualRequesterRewards, result.SimulatedRequesterRewards)
+	err = w.Flush()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	gaining, losing := 0, 0
+	for _, u := range result.Users {
+		switch u.RewardsDifference().Sign() {
+		case 1:
+			gaining++
+		case -1:
+			losing++
+		}
+	}
+	fmt.Printf("\n%d users involved, %d would receive more and %d would receive less\n\n", len(result.Users), gaining, losing)
+
+	users := result.Users
+	if *usersFlag > 0 && len(users) > *usersFlag {
+		users = users[:*usersFlag]
+	}
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Address\tActual rewards\tSimulated rewards\tDifference\tActual costs\tSimulated costs\tDifference\t")
+	for _, u := range users {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			u.RewardsAddress,
+			formatBanano(u.ActualRewards),
+			formatBanano(u.SimulatedRewards),
+			formatBananoDifference(u.RewardsDifference()),
+			formatBanano(u.ActualRequestCosts),
+			formatBanano(u.SimulatedRequestCosts),
+			formatBananoDifference(u.RequestCostsDifference()))
+	}
+	return stacktrace.Propagate(w.Flush(), "")
+}
@@ -141,6 +141,12 @@ func (obj *Application) deleteExtra(node sqalx.Node, preSelf bool) error {
 		return stacktrace.Propagate(err, "")
 	}
 
+	// delete settings
+	err = ClearApplicationSettingsForApplication(node, obj.ID)
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
 	// delete all other versions of the application
 	builder = sdb.Delete("application").Where(sq.Eq{"application.id": obj.ID})
 	logger.Println(builder.ToSql())
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// ApplicationSetting represents a value set by an administrator for one of the settings declared by an application
+type ApplicationSetting struct {
+	ApplicationID string `dbKey:"true"`
+	Key           string `dbKey:"true"`
+	Value         string // JSON-encoded
+	UpdatedAt     time.Time
+	UpdatedBy     string
+}
+
+// GetApplicationSettingsForApplication returns the setting values for the specified application, indexed by key
+func GetApplicationSettingsForApplication(node sqalx.Node, applicationID string) (map[string]*ApplicationSetting, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"application_setting.application_id": applicationID})
+	items, err := GetWithSelect[*ApplicationSetting](node, s)
+	if err != nil {
+		return map[string]*ApplicationSetting{}, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*ApplicationSetting, len(items))
+	for i := range items {
+		result[items[i].Key] = items[i]
+	}
+	return result, nil
+}
+
+// ClearApplicationSettingsForApplication clears all the setting values for the specified application
+func ClearApplicationSettingsForApplication(node sqalx.Node, applicationID string) error {
+	builder := sdb.Delete("application_setting").Where(sq.Eq{"application_setting.application_id": applicationID})
+	logger.Println(builder.ToSql())
+	_, err := builder.RunWith(node).Exec()
+	return stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the ApplicationSetting
+func (obj *ApplicationSetting) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
+
+// Delete deletes the ApplicationSetting
+func (obj *ApplicationSetting) Delete(node sqalx.Node) error {
+	return Delete(node, obj)
+}
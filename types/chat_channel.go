@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// ChatChannel is a named chat channel (room) with its own settings
+type ChatChannel struct {
+	ID       string `dbKey:"true"`
+	Title    string
+	Enabled  bool
+	Slowmode bool
+	// RequiredPermissionLevel is the minimum permission level users must have to read and write in the channel
+	RequiredPermissionLevel string
+	// ApplicationID is the ID of the application that owns the channel, if any
+	ApplicationID *string
+	UpdatedAt     time.Time
+	UpdatedBy     string
+}
+
+// GetChatChannels returns all chat channels in the database
+func GetChatChannels(node sqalx.Node) ([]*ChatChannel, error) {
+	s := sdb.Select().
+		OrderBy("chat_channel.id")
+	items, err := GetWithSelect[*ChatChannel](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetChatChannelsWithIDs returns the chat channels with the specified IDs
+func GetChatChannelsWithIDs(node sqalx.Node, ids []string) (map[string]*ChatChannel, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_channel.id": ids})
+	items, err := GetWithSelect[*ChatChannel](node, s)
+	if err != nil {
+		return map[string]*ChatChannel{}, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*ChatChannel, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// ChatChannelHasMessages returns whether any chat messages were sent in the specified channel
+func ChatChannelHasMessages(node sqalx.Node, channelID string) (bool, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	var hasMessages bool
+	err = sdb.Select().
+		Column(sq.Expr("EXISTS (SELECT 1 FROM chat_message WHERE chat_message.channel = ?)", channelID)).
+		RunWith(tx).QueryRow().Scan(&hasMessages)
+	return hasMessages, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the ChatChannel
+func (obj *ChatChannel) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
+
+// Delete deletes the ChatChannel
+func (obj *ChatChannel) Delete(node sqalx.Node) error {
+	return Delete(node, obj)
+}
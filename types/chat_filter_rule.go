@@ -0,0 +1,100 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// ChatFilterRuleType identifies the kind of check performed by a chat filter rule
+type ChatFilterRuleType string
+
+// ChatFilterRuleTypeRegex matches messages against a regular expression
+const ChatFilterRuleTypeRegex ChatFilterRuleType = "regex"
+
+// ChatFilterRuleTypeWordList matches messages containing any of a list of words
+const ChatFilterRuleTypeWordList ChatFilterRuleType = "word_list"
+
+// ChatFilterRuleTypeLinkDomain matches messages containing links to any of a list of domains
+const ChatFilterRuleTypeLinkDomain ChatFilterRuleType = "link_domain"
+
+// ChatFilterRuleTypeMentionSpam matches messages mentioning too many users
+const ChatFilterRuleTypeMentionSpam ChatFilterRuleType = "mention_spam"
+
+// ChatFilterRuleTypeCharacterFlood matches messages with long runs of the same character
+const ChatFilterRuleTypeCharacterFlood ChatFilterRuleType = "character_flood"
+
+// ChatFilterRuleTypeScamAddress matches messages containing addresses that match known scam address patterns
+const ChatFilterRuleTypeScamAddress ChatFilterRuleType = "scam_address"
+
+// ChatFilterAction is the action taken when a chat message matches a chat filter rule
+type ChatFilterAction string
+
+// ChatFilterActionReject prevents the message from being sent
+const ChatFilterActionReject ChatFilterAction = "reject"
+
+// ChatFilterActionHide makes the message visible only to its author, like messages of users banned from chat
+const ChatFilterActionHide ChatFilterAction = "hide"
+
+// ChatFilterActionFlag lets the message through, only bringing it to the attention of moderators
+const ChatFilterActionFlag ChatFilterAction = "flag"
+
+// ChatFilterActionMute prevents the message from being sent and temporarily mutes its author
+const ChatFilterActionMute ChatFilterAction = "mute"
+
+// ChatFilterRule is a rule of the automated chat content filter
+type ChatFilterRule struct {
+	ID          string `dbKey:"true"`
+	RuleType    ChatFilterRuleType
+	Parameter   string
+	Action      ChatFilterAction
+	Description string
+	Enabled     bool
+	UpdatedAt   time.Time
+	UpdatedBy   string
+}
+
+// GetChatFilterRules returns all chat filter rules in the database
+func GetChatFilterRules(node sqalx.Node, pagParams *PaginationParams) ([]*ChatFilterRule, uint64, error) {
+	s := sdb.Select().
+		OrderBy("chat_filter_rule.updated_at DESC")
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*ChatFilterRule](node, s)
+}
+
+// GetEnabledChatFilterRules returns the enabled chat filter rules
+func GetEnabledChatFilterRules(node sqalx.Node) ([]*ChatFilterRule, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_filter_rule.enabled": true}).
+		OrderBy("chat_filter_rule.id")
+	items, err := GetWithSelect[*ChatFilterRule](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetChatFilterRulesWithIDs returns the chat filter rules with the specified IDs
+func GetChatFilterRulesWithIDs(node sqalx.Node, ids []string) (map[string]*ChatFilterRule, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_filter_rule.id": ids})
+	items, err := GetWithSelect[*ChatFilterRule](node, s)
+	if err != nil {
+		return map[string]*ChatFilterRule{}, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*ChatFilterRule, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// Update updates or inserts the ChatFilterRule
+func (obj *ChatFilterRule) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
+
+// Delete deletes the ChatFilterRule
+func (obj *ChatFilterRule) Delete(node sqalx.Node) error {
+	return Delete(node, obj)
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// ChatMessageReportReason is the reason given by an user for reporting a chat message
+type ChatMessageReportReason string
+
+// ChatMessageReportReasonSpam is used for messages that are spam or advertising
+const ChatMessageReportReasonSpam ChatMessageReportReason = "spam"
+
+// ChatMessageReportReasonHarassment is used for messages that harass or threaten other users
+const ChatMessageReportReasonHarassment ChatMessageReportReason = "harassment"
+
+// ChatMessageReportReasonHateSpeech is used for messages containing hate speech
+const ChatMessageReportReasonHateSpeech ChatMessageReportReason = "hate_speech"
+
+// ChatMessageReportReasonScam is used for messages that attempt to scam other users
+const ChatMessageReportReasonScam ChatMessageReportReason = "scam"
+
+// ChatMessageReportReasonInappropriate is used for messages with otherwise inappropriate content
+const ChatMessageReportReasonInappropriate ChatMessageReportReason = "inappropriate"
+
+// ChatMessageReportReasonOther is used for messages reported for reasons not covered by the other reasons
+const ChatMessageReportReasonOther ChatMessageReportReason = "other"
+
+// ChatMessageReportResolution is the outcome of the moderator review of the reports of a chat message
+type ChatMessageReportResolution string
+
+// ChatMessageReportResolutionDismissed is used when a moderator found no problem with the reported message
+const ChatMessageReportResolutionDismissed ChatMessageReportResolution = "dismissed"
+
+// ChatMessageReportResolutionDeleted is used when a moderator deleted the reported message
+const ChatMessageReportResolutionDeleted ChatMessageReportResolution = "deleted"
+
+// ChatMessageReportResolutionBanned is used when a moderator deleted the reported message and banned its author
+const ChatMessageReportResolutionBanned ChatMessageReportResolution = "banned"
+
+// ChatMessageReport is a report of a chat message by an user.
+// The content and author of the message are kept so that reports remain meaningful after the message is deleted
+type ChatMessageReport struct {
+	ID              string `dbKey:"true"`
+	MessageID       int64
+	MessageAuthor   string
+	MessageContent  string
+	Reporter        string
+	ReportedAt      time.Time
+	Reason          ChatMessageReportReason
+	Comment         string
+	TrustedReporter bool
+	// CausedHiding is set on the unresolved reports of a message at the time it is automatically hidden for having
+	// been reported by enough trusted users
+	CausedHiding bool
+	ResolvedAt   sql.NullTime
+	ResolvedBy   *string
+	Resolution   *string
+}
+
+// GetUnresolvedChatMessageReports returns all the chat message reports that were not yet resolved, oldest first
+func GetUnresolvedChatMessageReports(node sqalx.Node) ([]*ChatMessageReport, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_message_report.resolved_at": nil}).
+		OrderBy("chat_message_report.reported_at ASC")
+	items, err := GetWithSelect[*ChatMessageReport](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// GetChatMessageReportsForMessage returns the reports of the chat message with the specified ID, oldest first
+func GetChatMessageReportsForMessage(node sqalx.Node, messageID int64, unresolvedOnly bool) ([]*ChatMessageReport, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_message_report.message_id": messageID}).
+		OrderBy("chat_message_report.reported_at ASC")
+	if unresolvedOnly {
+		s = s.Where(sq.Eq{"chat_message_report.resolved_at": nil})
+	}
+	items, err := GetWithSelect[*ChatMessageReport](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// LockUnresolvedChatMessageReportsForMessage returns the unresolved reports of the chat message with the specified
+// ID, oldest first, locking them until the end of the transaction
+func LockUnresolvedChatMessageReportsForMessage(node sqalx.Node, messageID int64) ([]*ChatMessageReport, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_message_report.message_id": messageID}).
+		Where(sq.Eq{"chat_message_report.resolved_at": nil}).
+		OrderBy("chat_message_report.reported_at ASC").
+		Suffix("FOR UPDATE")
+	items, err := GetWithSelect[*ChatMessageReport](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the ChatMessageReport
+func (obj *ChatMessageReport) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
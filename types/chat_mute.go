@@ -0,0 +1,97 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// ChatMuteSource identifies what caused a chat mute to be applied
+type ChatMuteSource string
+
+// ChatMuteSourceModerator is used for mutes applied manually by a moderator
+const ChatMuteSourceModerator ChatMuteSource = "moderator"
+
+// ChatMuteSourceContentFilter is used for mutes applied automatically by the chat content filter
+const ChatMuteSourceContentFilter ChatMuteSource = "content_filter"
+
+// ChatMuteSourceApplication is used for mutes applied by a JAF application
+const ChatMuteSourceApplication ChatMuteSource = "application"
+
+// ChatMute is a temporary prohibition for an user to participate in chat
+type ChatMute struct {
+	ID               string `dbKey:"true"`
+	Address          string
+	MutedAt          time.Time
+	MutedUntil       time.Time
+	Source           ChatMuteSource
+	Reason           string
+	ModeratorAddress string
+	ModeratorName    string
+	LiftedAt         sql.NullTime
+	LiftedBy         *string
+	LiftReason       string
+}
+
+// Active returns whether the mute is in effect at the specified instant
+func (obj *ChatMute) Active(instant time.Time) bool {
+	return !obj.LiftedAt.Valid && !obj.MutedAt.After(instant) && obj.MutedUntil.After(instant)
+}
+
+// GetChatMutes returns chat mutes, starting with the most recent one
+func GetChatMutes(node sqalx.Node, filter string, activeOnly bool, pagParams *PaginationParams) ([]*ChatMute, uint64, error) {
+	s := sdb.Select().
+		OrderBy("chat_mute.muted_at DESC, chat_mute.id ASC")
+	if filter != "" {
+		s = s.Where(sq.Or{
+			sq.Eq{"chat_mute.id": filter},
+			sq.Expr("UPPER(chat_mute.address) LIKE '%' || UPPER(?) || '%'", filter),
+			sq.Expr("UPPER(chat_mute.reason) LIKE '%' || UPPER(?) || '%'", filter),
+		})
+	}
+	if activeOnly {
+		s = s.Where(sq.Eq{"chat_mute.lifted_at": nil}).
+			Where(sq.Gt{"chat_mute.muted_until": time.Now()})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*ChatMute](node, s)
+}
+
+// GetActiveChatMutes returns the chat mutes in effect at the specified instant
+func GetActiveChatMutes(node sqalx.Node, instant time.Time) ([]*ChatMute, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_mute.lifted_at": nil}).
+		Where(sq.LtOrEq{"chat_mute.muted_at": instant}).
+		Where(sq.Gt{"chat_mute.muted_until": instant})
+	items, err := GetWithSelect[*ChatMute](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// CountChatMutesOfAddressSince returns how many times the user with the specified address was muted since the
+// specified instant, including mutes that were lifted early
+func CountChatMutesOfAddressSince(node sqalx.Node, address string, since time.Time) (int, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	var count int
+	err = sdb.Select("COUNT(*)").
+		From("chat_mute").
+		Where(sq.Eq{"chat_mute.address": address}).
+		Where(sq.GtOrEq{"chat_mute.muted_at": since}).
+		RunWith(tx).QueryRow().Scan(&count)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
+// Update updates or inserts the ChatMute
+func (obj *ChatMute) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
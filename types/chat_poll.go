@@ -0,0 +1,154 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/lib/pq"
+	"github.com/palantir/stacktrace"
+)
+
+// ChatPollVotingMode determines how votes are cast in a chat poll
+type ChatPollVotingMode string
+
+// ChatPollVotingModeSingle is used for polls where each user can cast a single vote
+const ChatPollVotingModeSingle ChatPollVotingMode = "single"
+
+// ChatPollVotingModePoints is used for polls where users can cast as many votes as they want, spending points on each
+const ChatPollVotingModePoints ChatPollVotingMode = "points"
+
+// ChatPoll is a poll attached to a chat message
+type ChatPoll struct {
+	ID            string `dbKey:"true"`
+	CreatedAt     time.Time
+	CreatedBy     string
+	Channel       string
+	MessageID     *int64
+	Question      string
+	Options       pq.StringArray
+	VotingMode    ChatPollVotingMode
+	PointsPerVote int
+	ClosesAt      time.Time
+	Closed        bool
+}
+
+// GetChatPollsWithIDs returns the chat polls with the specified IDs
+func GetChatPollsWithIDs(node sqalx.Node, ids []string) (map[string]*ChatPoll, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_poll.id": ids})
+	items, err := GetWithSelect[*ChatPoll](node, s)
+	if err != nil {
+		return map[string]*ChatPoll{}, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*ChatPoll, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// GetChatPollsToClose returns the chat polls that are not yet closed but whose closing time has passed
+func GetChatPollsToClose(node sqalx.Node, instant time.Time) ([]*ChatPoll, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_poll.closed": false}).
+		Where(sq.LtOrEq{"chat_poll.closes_at": instant})
+	items, err := GetWithSelect[*ChatPoll](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// Update updates or inserts the ChatPoll
+func (obj *ChatPoll) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
+
+// ChatPollVote represents the votes cast by an user on an option of a chat poll
+type ChatPollVote struct {
+	PollID      string `dbKey:"true"`
+	Voter       string `dbKey:"true"`
+	OptionIndex int    `dbKey:"true"`
+	Votes       int
+	UpdatedAt   time.Time
+	// SingleVote is set on the votes of polls where each user can only vote once, and is enforced by an unique index
+	SingleVote bool
+}
+
+// LockChatPollVotesOfVoter returns the votes cast by the specified user in the specified chat poll, locking them until
+// the end of the transaction
+func LockChatPollVotesOfVoter(node sqalx.Node, pollID, voter string) ([]*ChatPollVote, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"chat_poll_vote.poll_id": pollID}).
+		Where(sq.Eq{"chat_poll_vote.voter": voter}).
+		Suffix("FOR UPDATE")
+	items, err := GetWithSelect[*ChatPollVote](node, s)
+	return items, stacktrace.Propagate(err, "")
+}
+
+// AddChatPollVotes adds the votes in the passed ChatPollVote to those already cast by the same user on the same
+// option. For single-vote polls, nothing is added if the user already voted in the poll, and false is returned
+func AddChatPollVotes(node sqalx.Node, vote *ChatPollVote) (bool, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	defer tx.Rollback()
+
+	builder := sdb.Insert("chat_poll_vote").
+		Columns("poll_id", "voter", "option_index", "votes", "updated_at", "single_vote").
+		Values(vote.PollID, vote.Voter, vote.OptionIndex, vote.Votes, vote.UpdatedAt, vote.SingleVote)
+	if vote.SingleVote {
+		builder = builder.Suffix("ON CONFLICT DO NOTHING")
+	} else {
+		builder = builder.Suffix(`
+			ON CONFLICT (poll_id, voter, option_index)
+			DO UPDATE SET votes = chat_poll_vote.votes + EXCLUDED.votes, updated_at = EXCLUDED.updated_at`)
+	}
+	result, err := builder.RunWith(tx).Exec()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "")
+	}
+
+	return affected > 0, stacktrace.Propagate(tx.Commit(), "")
+}
+
+// GetChatPollTally returns the total number of votes cast on each option of the specified chat poll, indexed by option
+func GetChatPollTally(node sqalx.Node, pollID string, numOptions int) ([]int, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	rows, err := sdb.Select("option_index", "SUM(votes)").
+		From("chat_poll_vote").
+		Where(sq.Eq{"chat_poll_vote.poll_id": pollID}).
+		GroupBy("option_index").
+		RunWith(tx).Query()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	tally := make([]int, numOptions)
+	for rows.Next() {
+		var optionIndex, votes int
+		err = rows.Scan(&optionIndex, &votes)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if optionIndex >= 0 && optionIndex < numOptions {
+			tally[optionIndex] = votes
+		}
+	}
+	return tally, stacktrace.Propagate(rows.Err(), "")
+}
+
+// Update updates or inserts the ChatPollVote
+func (obj *ChatPollVote) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
@@ -44,6 +44,15 @@ func SumCrowdfundedTransactionsFromAddressSince(node sqalx.Node, address string,
 	return totalAmount, nil
 }
 
+// GetCrowdfundedTransactionsForMedia returns the crowdfunded transactions received during the media with the specified
+// IDs
+func GetCrowdfundedTransactionsForMedia(node sqalx.Node, mediaIDs []string) ([]*CrowdfundedTransaction, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"crowdfunded_transaction.for_media": mediaIDs})
+	values, err := GetWithSelect[*CrowdfundedTransaction](node, s)
+	return values, stacktrace.Propagate(err, "")
+}
+
 // InsertCrowdfundedTransactions inserts the passed received rewards in the database
 func InsertCrowdfundedTransactions(node sqalx.Node, items []*CrowdfundedTransaction) error {
 	c := make([]interface{}, len(items))
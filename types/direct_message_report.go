@@ -0,0 +1,54 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// DirectMessageReport is a report, by one of its participants, of a direct message conversation.
+// Moderators can only review direct message conversations that have been reported
+type DirectMessageReport struct {
+	ID         string `dbKey:"true"`
+	ReportedAt time.Time
+	Reporter   string
+	Reported   string
+	Reason     string
+	ReviewedAt sql.NullTime
+	ReviewedBy *string
+}
+
+// GetDirectMessageReports returns direct message reports, starting with the most recent one
+func GetDirectMessageReports(node sqalx.Node, unreviewedOnly bool, pagParams *PaginationParams) ([]*DirectMessageReport, uint64, error) {
+	s := sdb.Select().
+		OrderBy("direct_message_report.reported_at DESC")
+	if unreviewedOnly {
+		s = s.Where(sq.Eq{"direct_message_report.reviewed_at": nil})
+	}
+	s = applyPaginationParameters(s, pagParams)
+	return GetWithSelectAndCount[*DirectMessageReport](node, s)
+}
+
+// GetDirectMessageReportsWithIDs returns the direct message reports with the specified IDs
+func GetDirectMessageReportsWithIDs(node sqalx.Node, ids []string) (map[string]*DirectMessageReport, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"direct_message_report.id": ids})
+	items, err := GetWithSelect[*DirectMessageReport](node, s)
+	if err != nil {
+		return map[string]*DirectMessageReport{}, stacktrace.Propagate(err, "")
+	}
+
+	result := make(map[string]*DirectMessageReport, len(items))
+	for i := range items {
+		result[items[i].ID] = items[i]
+	}
+	return result, nil
+}
+
+// Update updates or inserts the DirectMessageReport
+func (obj *DirectMessageReport) Update(node sqalx.Node) error {
+	return Update(node, obj)
+}
@@ -70,6 +70,18 @@ func GetPlayedMediaWithIDs(node sqalx.Node, ids []string) (map[string]*PlayedMed
 	return result, nil
 }
 
+// GetPlayedMediaStartedBetween returns the played media that started playing in the specified time range and which has
+// finished playing, ordered by the time it started playing
+func GetPlayedMediaStartedBetween(node sqalx.Node, onOrAfter time.Time, before time.Time) ([]*PlayedMedia, error) {
+	s := sdb.Select().
+		Where(sq.GtOrEq{"played_media.started_at": onOrAfter}).
+		Where(sq.Lt{"played_media.started_at": before}).
+		Where(sq.NotEq{"played_media.ended_at": nil}).
+		OrderBy("played_media.started_at ASC")
+	m, err := GetWithSelect[*PlayedMedia](node, s)
+	return m, stacktrace.Propagate(err, "")
+}
+
 // GetPlayedMediaRequestedBySince returns the played media that had been requested by the given address and which is
 // playing or has finished playing since the specified moment
 func GetPlayedMediaRequestedBySince(node sqalx.Node, requestedBy string, since time.Time) ([]*PlayedMedia, error) {
@@ -117,4 +117,5 @@ const (
 	PointsTxTypeSkipThresholdIncrease       PointsTxType = 11
 	PointsTxTypeConcealedEntryEnqueuing     PointsTxType = 12
 	PointsTxTypeApplicationDefined          PointsTxType = 13
+	PointsTxTypeChatPollVote                PointsTxType = 14
 )
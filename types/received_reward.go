@@ -19,6 +19,8 @@ type ReceivedReward struct {
 	ReceivedAt     time.Time
 	Amount         decimal.Decimal
 	Media          string
+	// Policy is the name of the reward policy under which the reward was distributed
+	Policy string
 }
 
 // getReceivedRewardWithSelect returns a slice with all received rewards that match the conditions in sbuilder
@@ -104,6 +106,34 @@ func GetReceivedRewardsForAddress(node sqalx.Node, address string, pagParams *Pa
 	return values, totalCount, nil
 }
 
+// GetReceivedRewardsForMedia returns the rewards received for the media with the specified IDs
+func GetReceivedRewardsForMedia(node sqalx.Node, mediaIDs []string) ([]*ReceivedReward, error) {
+	s := sdb.Select().
+		Where(sq.Eq{"received_reward.media": mediaIDs})
+	values, err := GetWithSelect[*ReceivedReward](node, s)
+	return values, stacktrace.Propagate(err, "")
+}
+
+// GetFirstRewardReceivedAt returns when the specified address received its first reward.
+// The second return value is false if the address never received a reward
+func GetFirstRewardReceivedAt(node sqalx.Node, address string) (time.Time, bool, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	var receivedAt sql.NullTime
+	err = sdb.Select("MIN(received_reward.received_at)").
+		From("received_reward").
+		Where(sq.Eq{"received_reward.rewards_address": address}).
+		RunWith(tx).QueryRow().Scan(&receivedAt)
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+	return receivedAt.Time, receivedAt.Valid, nil
+}
+
 // InsertReceivedRewards inserts the passed received rewards in the database
 func InsertReceivedRewards(node sqalx.Node, items []*ReceivedReward) error {
 	c := make([]interface{}, len(items))
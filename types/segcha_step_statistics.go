@@ -0,0 +1,90 @@
+package types
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+)
+
+// SegchaStepStatistics aggregates how users performed on the segcha challenge steps created by a puzzle family,
+// over the course of a day
+type SegchaStepStatistics struct {
+	Day                time.Time `dbKey:"true"`
+	PuzzleFamily       string    `dbKey:"true"`
+	PictureCategory    string
+	Answered           int
+	Correct            int
+	LegitimateAnswered int
+	LegitimateCorrect  int
+	TotalSolveTimeMs   int64
+}
+
+// AddToSegchaStepStatistics adds the values of the provided statistics to those already stored for the same day and
+// puzzle family
+func AddToSegchaStepStatistics(node sqalx.Node, statistics []*SegchaStepStatistics) error {
+	if len(statistics) == 0 {
+		return nil
+	}
+	tx, err := node.Beginx()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+	defer tx.Rollback()
+
+	builder := sdb.Insert("segcha_step_statistics").Columns("day", "puzzle_family", "picture_category", "answered",
+		"correct", "legitimate_answered", "legitimate_correct", "total_solve_time_ms")
+	for _, s := range statistics {
+		builder = builder.Values(s.Day, s.PuzzleFamily, s.PictureCategory, s.Answered,
+			s.Correct, s.LegitimateAnswered, s.LegitimateCorrect, s.TotalSolveTimeMs)
+	}
+	_, err = builder.Suffix(`
+		ON CONFLICT (day, puzzle_family)
+		DO UPDATE SET
+			picture_category = EXCLUDED.picture_category,
+			answered = segcha_step_statistics.answered + EXCLUDED.answered,
+			correct = segcha_step_statistics.correct + EXCLUDED.correct,
+			legitimate_answered = segcha_step_statistics.legitimate_answered + EXCLUDED.legitimate_answered,
+			legitimate_correct = segcha_step_statistics.legitimate_correct + EXCLUDED.legitimate_correct,
+			total_solve_time_ms = segcha_step_statistics.total_solve_time_ms + EXCLUDED.total_solve_time_ms`).
+		RunWith(tx).Exec()
+	if err != nil {
+		return stacktrace.Propagate(err, "")
+	}
+
+	return stacktrace.Propagate(tx.Commit(), "")
+}
+
+// SumSegchaStepStatisticsSince returns, for each puzzle family, the sum of its statistics since the day of the
+// specified instant. The Day of the returned statistics is the earliest day with recorded statistics
+func SumSegchaStepStatisticsSince(node sqalx.Node, since time.Time) (map[string]*SegchaStepStatistics, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	rows, err := sdb.Select("MIN(day)", "puzzle_family", "MAX(picture_category)", "SUM(answered)", "SUM(correct)",
+		"SUM(legitimate_answered)", "SUM(legitimate_correct)", "SUM(total_solve_time_ms)").
+		From("segcha_step_statistics").
+		Where(sq.GtOrEq{"segcha_step_statistics.day": since.Truncate(24 * time.Hour)}).
+		GroupBy("puzzle_family").
+		RunWith(tx).Query()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := make(map[string]*SegchaStepStatistics)
+	for rows.Next() {
+		s := &SegchaStepStatistics{}
+		err := rows.Scan(&s.Day, &s.PuzzleFamily, &s.PictureCategory, &s.Answered, &s.Correct,
+			&s.LegitimateAnswered, &s.LegitimateCorrect, &s.TotalSolveTimeMs)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		result[s.PuzzleFamily] = s
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
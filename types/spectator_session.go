@@ -0,0 +1,238 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gbl08ma/sqalx"
+	"github.com/palantir/stacktrace"
+	"github.com/shopspring/decimal"
+)
+
+// SpectatorSession represents a period during which a rewards address was watching, which may span multiple
+// connections and reconnections
+type SpectatorSession struct {
+	ID             string `dbKey:"true"`
+	RewardsAddress string
+	// RemoteAddressHash is the HMAC-SHA256, with a server-side key, of the uniquified remote address the session
+	// started from
+	RemoteAddressHash string
+	StartedAt         time.Time
+	EndedAt           time.Time
+	MediaWatched      int
+	ChallengesPassed  int
+	ChallengesFailed  int
+	RewardsEarned     decimal.Decimal
+}
+
+// UpdateSpectatorSessions updates or inserts the passed spectator sessions in the database
+func UpdateSpectatorSessions(node sqalx.Node, items []*SpectatorSession) error {
+	c := make([]interface{}, len(items))
+	for i := range items {
+		c[i] = items[i]
+	}
+	return stacktrace.Propagate(Update(node, c...), "")
+}
+
+// GetFirstSpectatorSessionStartedAt returns when the first recorded spectator session of the specified address started.
+// The second return value is false if the address has no recorded sessions
+func GetFirstSpectatorSessionStartedAt(node sqalx.Node, address string) (time.Time, bool, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	var startedAt sql.NullTime
+	err = sdb.Select("MIN(spectator_session.started_at)").
+		From("spectator_session").
+		Where(sq.Eq{"spectator_session.rewards_address": address}).
+		RunWith(tx).QueryRow().Scan(&startedAt)
+	if err != nil {
+		return time.Time{}, false, stacktrace.Propagate(err, "")
+	}
+	return startedAt.Time, startedAt.Valid, nil
+}
+
+// SpectatorActivityGranularity is the length of the periods into which spectator activity is grouped
+type SpectatorActivityGranularity string
+
+// SpectatorActivityGranularityDay groups spectator activity by UTC day
+const SpectatorActivityGranularityDay SpectatorActivityGranularity = "day"
+
+// SpectatorActivityGranularityWeek groups spectator activity by UTC week, starting on Mondays
+const SpectatorActivityGranularityWeek SpectatorActivityGranularity = "week"
+
+func (g SpectatorActivityGranularity) periodExpr(column string) string {
+	return "date_trunc('" + string(g) + "', " + column + " AT TIME ZONE 'UTC')"
+}
+
+func (g SpectatorActivityGranularity) interval() string {
+	return "1 " + string(g)
+}
+
+// DailySpectatorActivity summarizes the spectator sessions started on a day
+type DailySpectatorActivity struct {
+	Day                  time.Time
+	ActiveWatchers       int
+	Sessions             int
+	AverageSessionLength time.Duration
+	MediaWatched         int
+	ChallengesPassed     int
+	ChallengesFailed     int
+	RewardsEarned        decimal.Decimal
+}
+
+// GetDailySpectatorActivity returns a summary of the spectator sessions started on each UTC day within the specified
+// interval, ordered by day
+func GetDailySpectatorActivity(node sqalx.Node, since, until time.Time) ([]*DailySpectatorActivity, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	day := SpectatorActivityGranularityDay.periodExpr("started_at")
+	rows, err := sdb.Select(day, "COUNT(DISTINCT rewards_address)", "COUNT(*)",
+		"AVG(EXTRACT(EPOCH FROM ended_at - started_at))", "SUM(media_watched)", "SUM(challenges_passed)",
+		"SUM(challenges_failed)", "SUM(rewards_earned)").
+		From("spectator_session").
+		Where(sq.GtOrEq{"spectator_session.started_at": since}).
+		Where(sq.Lt{"spectator_session.started_at": until}).
+		GroupBy(day).
+		OrderBy(day).
+		RunWith(tx).Query()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := []*DailySpectatorActivity{}
+	for rows.Next() {
+		a := &DailySpectatorActivity{}
+		var averageSeconds float64
+		err := rows.Scan(&a.Day, &a.ActiveWatchers, &a.Sessions, &averageSeconds, &a.MediaWatched,
+			&a.ChallengesPassed, &a.ChallengesFailed, &a.RewardsEarned)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		a.AverageSessionLength = time.Duration(averageSeconds * float64(time.Second))
+		result = append(result, a)
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
+
+// SpectatorRetentionCohort groups the rewards addresses whose first recorded session started in the same period
+type SpectatorRetentionCohort struct {
+	Start time.Time
+	// ActiveInPeriod contains, for each period since the start of the cohort, how many members of the cohort watched
+	// during that period. The first element is the size of the cohort
+	ActiveInPeriod []int
+}
+
+// GetSpectatorRetentionCohorts returns the retention cohorts that started since the specified instant, ordered by
+// their start
+func GetSpectatorRetentionCohorts(node sqalx.Node, since time.Time, granularity SpectatorActivityGranularity) ([]*SpectatorRetentionCohort, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	rows, err := sdb.Select("first_seen.cohort", "activity.period", "COUNT(*)").
+		Prefix(`WITH first_seen AS (
+			SELECT rewards_address, `+granularity.periodExpr("MIN(started_at)")+` AS cohort
+			FROM spectator_session GROUP BY rewards_address
+		), activity AS (
+			SELECT DISTINCT rewards_address, `+granularity.periodExpr("started_at")+` AS period
+			FROM spectator_session WHERE `+granularity.periodExpr("started_at")+` >= `+granularity.periodExpr("?::timestamptz")+`
+		)`, since).
+		From("first_seen").
+		Join("activity ON activity.rewards_address = first_seen.rewards_address").
+		Where(sq.Expr("first_seen.cohort >= "+granularity.periodExpr("?::timestamptz"), since)).
+		GroupBy("first_seen.cohort", "activity.period").
+		OrderBy("first_seen.cohort", "activity.period").
+		RunWith(tx).Query()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	periodLength := 24 * time.Hour
+	if granularity == SpectatorActivityGranularityWeek {
+		periodLength = 7 * 24 * time.Hour
+	}
+
+	result := []*SpectatorRetentionCohort{}
+	var cohort *SpectatorRetentionCohort
+	for rows.Next() {
+		var start, period time.Time
+		var count int
+		err := rows.Scan(&start, &period, &count)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		if cohort == nil || !cohort.Start.Equal(start) {
+			cohort = &SpectatorRetentionCohort{
+				Start: start,
+			}
+			result = append(result, cohort)
+		}
+		// periods are truncated in UTC, so they always have the same length
+		offset := int(period.Sub(start) / periodLength)
+		for len(cohort.ActiveInPeriod) <= offset {
+			cohort.ActiveInPeriod = append(cohort.ActiveInPeriod, 0)
+		}
+		cohort.ActiveInPeriod[offset] = count
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
+
+// SpectatorChurn describes how many of the rewards addresses that watched during a period did not watch during the
+// following one
+type SpectatorChurn struct {
+	// Period is the start of the period in which churned addresses did not watch
+	Period           time.Time
+	PreviouslyActive int
+	Churned          int
+}
+
+// GetSpectatorChurn returns the churn for each complete period since the specified instant, ordered by period
+func GetSpectatorChurn(node sqalx.Node, since time.Time, granularity SpectatorActivityGranularity) ([]*SpectatorChurn, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	nextPeriod := "prev_activity.period + CAST('" + granularity.interval() + "' AS INTERVAL)"
+	rows, err := sdb.Select(nextPeriod, "COUNT(*)", "COUNT(*) FILTER (WHERE next_activity.rewards_address IS NULL)").
+		Prefix(`WITH activity AS (
+			SELECT DISTINCT rewards_address, `+granularity.periodExpr("started_at")+` AS period
+			FROM spectator_session WHERE started_at >= ?::timestamptz - CAST('`+granularity.interval()+`' AS INTERVAL)
+		)`, since).
+		From("activity AS prev_activity").
+		LeftJoin("activity AS next_activity ON next_activity.rewards_address = prev_activity.rewards_address AND next_activity.period = " + nextPeriod).
+		Where(sq.Expr(nextPeriod+" >= "+granularity.periodExpr("?::timestamptz"), since)).
+		// the current period is still ongoing, so churn can't be determined yet
+		Where(sq.Expr(nextPeriod + " < " + granularity.periodExpr("NOW()"))).
+		GroupBy(nextPeriod).
+		OrderBy(nextPeriod).
+		RunWith(tx).Query()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "")
+	}
+	defer rows.Close()
+
+	result := []*SpectatorChurn{}
+	for rows.Next() {
+		c := &SpectatorChurn{}
+		err := rows.Scan(&c.Period, &c.PreviouslyActive, &c.Churned)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "")
+		}
+		result = append(result, c)
+	}
+	return result, stacktrace.Propagate(rows.Err(), "")
+}
@@ -114,6 +114,26 @@ func SumWithdrawalsToAddressSince(node sqalx.Node, address string, since time.Ti
 	return totalAmount, nil
 }
 
+// CountWithdrawalsForAddressSince returns the number of withdrawals to an address started since the specified time
+func CountWithdrawalsForAddressSince(node sqalx.Node, address string, since time.Time) (int, error) {
+	tx, err := node.Beginx()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	defer tx.Commit() // read-only tx
+
+	var count int
+	err = sdb.Select("COUNT(*)").
+		From("withdrawal").
+		Where(sq.Eq{"withdrawal.rewards_address": address}).
+		Where(sq.Gt{"withdrawal.started_at": since}).
+		RunWith(tx).QueryRow().Scan(&count)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "")
+	}
+	return count, nil
+}
+
 // Insert inserts the Withdrawal
 func (obj *Withdrawal) Insert(node sqalx.Node) error {
 	return Insert(node, obj)